@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/usecases/migrate_product_category"
+	"product-catalog-service/internal/app/product/usecases/refresh_listing_price"
+	"product-catalog-service/internal/services"
+)
+
+const (
+	defaultSpanner = "projects/test-project/instances/test-instance/databases/product-catalog"
+
+	batchSize = 100
+)
+
+// task rewrites rows written before a migration. Tasks are idempotent, so a
+// failed or interrupted run is resumed by running it again.
+type task func(ctx context.Context, container *services.Container) error
+
+var tasks = map[string]task{
+	// migrations/011_categories.sql
+	"categories": backfillCategories,
+
+	// migrations/017_product_effective_price.sql
+	"effective-prices": backfillEffectivePrices,
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: backfill <task>\n\nTasks: %s\n", strings.Join(taskNames(), ", "))
+	}
+	flag.Parse()
+
+	run, ok := tasks[flag.Arg(0)]
+	if flag.NArg() != 1 || !ok {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	spannerDB := getEnv("SPANNER_DATABASE", defaultSpanner)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Initialize Spanner client
+	client, err := spanner.NewClient(ctx, spannerDB)
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer client.Close()
+
+	// Build dependency injection container
+	container := services.NewContainer(client)
+
+	log.Printf("Backfill %s starting", flag.Arg(0))
+	log.Printf("Spanner database: %s", spannerDB)
+
+	if err := run(ctx, container); err != nil {
+		log.Fatalf("Backfill %s failed: %v", flag.Arg(0), err)
+	}
+
+	log.Printf("Backfill %s finished", flag.Arg(0))
+}
+
+// backfillCategories moves products holding a free-text category name into
+// the root category of that name, creating the categories as needed
+func backfillCategories(ctx context.Context, container *services.Container) error {
+	var migrated, created, failed int
+
+	after := ""
+	for {
+		productIDs, err := container.ProductRepo.FindUncategorized(ctx, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(productIDs) == 0 {
+			break
+		}
+
+		for _, productID := range productIDs {
+			resp, err := container.MigrateProductCategoryInteractor.Execute(ctx, migrate_product_category.Request{ProductID: productID})
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				// Skip the product; running the backfill again retries it
+				log.Printf("Failed to migrate category of product %s: %v", productID, err)
+				failed++
+				continue
+			}
+
+			migrated++
+			if resp.CreatedCategory {
+				created++
+			}
+		}
+
+		after = productIDs[len(productIDs)-1]
+	}
+
+	log.Printf("Migrated %d products, created %d categories, %d failed", migrated, created, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d products were not migrated", failed)
+	}
+	return nil
+}
+
+// backfillEffectivePrices stores every product's effective price with the
+// discount active now, so listings sort and filter products last written
+// before the price was stored by their actual price
+func backfillEffectivePrices(ctx context.Context, container *services.Container) error {
+	var refreshed, failed int
+
+	after := ""
+	for {
+		productIDs, err := container.ProductRepo.ListProductIDs(ctx, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(productIDs) == 0 {
+			break
+		}
+
+		for _, productID := range productIDs {
+			if _, err := container.RefreshListingPriceInteractor.Execute(ctx, refresh_listing_price.Request{ProductID: productID}); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				// Skip the product; running the backfill again retries it
+				log.Printf("Failed to refresh effective price of product %s: %v", productID, err)
+				failed++
+				continue
+			}
+			refreshed++
+		}
+
+		after = productIDs[len(productIDs)-1]
+	}
+
+	log.Printf("Refreshed %d products, %d failed", refreshed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d products were not refreshed", failed)
+	}
+	return nil
+}
+
+func taskNames() []string {
+	names := make([]string, 0, len(tasks))
+	for name := range tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
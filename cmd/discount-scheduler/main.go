@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/services"
+)
+
+const (
+	defaultSpanner = "projects/test-project/instances/test-instance/databases/product-catalog"
+)
+
+func main() {
+	spannerDB := getEnv("SPANNER_DATABASE", defaultSpanner)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Initialize Spanner client
+	client, err := spanner.NewClient(ctx, spannerDB)
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer client.Close()
+
+	// Build dependency injection container
+	container := services.NewContainer(client)
+
+	log.Printf("Discount scheduler starting")
+	log.Printf("Spanner database: %s", spannerDB)
+
+	if err := container.DiscountScheduler.Run(ctx); err != nil && err != context.Canceled {
+		log.Fatalf("Discount scheduler failed: %v", err)
+	}
+
+	log.Printf("Discount scheduler stopped")
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/services"
+)
+
+const (
+	defaultSpanner = "projects/test-project/instances/test-instance/databases/product-catalog"
+)
+
+func main() {
+	spannerDB := getEnv("SPANNER_DATABASE", defaultSpanner)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Initialize Spanner client
+	client, err := spanner.NewClient(ctx, spannerDB)
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer client.Close()
+
+	// Build dependency injection container
+	container := services.NewContainer(client)
+
+	log.Printf("Outbox relay starting")
+	log.Printf("Spanner database: %s", spannerDB)
+
+	if err := container.OutboxRelay.Run(ctx); err != nil && err != context.Canceled {
+		log.Fatalf("Outbox relay failed: %v", err)
+	}
+
+	log.Printf("Outbox relay stopped")
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -8,13 +8,13 @@ import (
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc"
-	productv1 "product-catalog-service/proto/product/v1"
 	"product-catalog-service/internal/services"
 	"product-catalog-service/internal/transport/grpc/product"
+	productv1 "product-catalog-service/proto/product/v1"
 )
 
 const (
-	defaultPort   = "50051"
+	defaultPort    = "50051"
 	defaultSpanner = "projects/test-project/instances/test-instance/databases/product-catalog"
 )
 
@@ -22,6 +22,21 @@ func main() {
 	port := getEnv("PORT", defaultPort)
 	spannerDB := getEnv("SPANNER_DATABASE", defaultSpanner)
 
+	// Replicas accept each other's page tokens only if they share the signing
+	// key; a random key per process is tolerated on the emulator alone
+	if os.Getenv("PAGE_TOKEN_SECRET") == "" {
+		if os.Getenv("SPANNER_EMULATOR_HOST") == "" {
+			log.Fatalf("PAGE_TOKEN_SECRET must be set outside the Spanner emulator")
+		}
+		log.Printf("WARNING: PAGE_TOKEN_SECRET is not set; page tokens are signed with a random key that only this process accepts")
+	}
+
+	// The in-process search index rescans every product on each search, which
+	// only a development catalog on the emulator can afford
+	if os.Getenv("SEARCH_INDEX") == "memory" && os.Getenv("SPANNER_EMULATOR_HOST") == "" {
+		log.Fatalf("SEARCH_INDEX=memory is only supported on the Spanner emulator")
+	}
+
 	// Initialize Spanner client
 	ctx := context.Background()
 	client, err := spanner.NewClient(ctx, spannerDB)
@@ -33,6 +48,32 @@ func main() {
 	// Build dependency injection container
 	container := services.NewContainer(client)
 
+	// Start the outbox relay next to the server unless it runs as its own command
+	if getEnv("OUTBOX_RELAY_ENABLED", "true") == "true" {
+		relayCtx, stopRelay := context.WithCancel(ctx)
+		defer stopRelay()
+
+		go func() {
+			if err := container.OutboxRelay.Run(relayCtx); err != nil && err != context.Canceled {
+				log.Printf("Outbox relay stopped: %v", err)
+			}
+		}()
+		log.Printf("Outbox relay started")
+	}
+
+	// Start the discount scheduler next to the server unless it runs as its own command
+	if getEnv("DISCOUNT_SCHEDULER_ENABLED", "true") == "true" {
+		schedulerCtx, stopScheduler := context.WithCancel(ctx)
+		defer stopScheduler()
+
+		go func() {
+			if err := container.DiscountScheduler.Run(schedulerCtx); err != nil && err != context.Canceled {
+				log.Printf("Discount scheduler stopped: %v", err)
+			}
+		}()
+		log.Printf("Discount scheduler started")
+	}
+
 	// Create gRPC server
 	server := grpc.NewServer()
 
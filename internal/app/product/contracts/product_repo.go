@@ -3,6 +3,7 @@ package contracts
 import (
 	"cloud.google.com/go/spanner"
 	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
 )
 
 // ProductRepository defines the interface for product persistence
@@ -13,6 +14,12 @@ type ProductRepository interface {
 	// UpdateMut returns a mutation to update a product (does not apply)
 	UpdateMut(product *domain.Product) *spanner.Mutation
 
+	// VersionPrecondition returns the optimistic concurrency check for an update
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+
+	// PriceHistoryMuts returns mutations recording a base price, discount or schedule change (does not apply)
+	PriceHistoryMuts(product *domain.Product) []*spanner.Mutation
+
 	// FindByID retrieves a product by ID
 	FindByID(ctx interface{}, productID string) (*domain.Product, error)
 
@@ -2,51 +2,510 @@ package contracts
 
 import (
 	"context"
+	"math/big"
+	"time"
 )
 
 // ProductReadModel defines the interface for product queries
 type ProductReadModel interface {
 	// GetProduct retrieves a product by ID with effective price
-	GetProduct(ctx context.Context, productID string) (*ProductDTO, error)
+	GetProduct(ctx context.Context, productID string, opts ReadOptions) (*ProductDTO, error)
 
 	// ListProducts retrieves a paginated list of products
 	ListProducts(ctx context.Context, filter ListProductsFilter) (*PaginatedProductsDTO, error)
+
+	// GetPriceHistory retrieves the price snapshots of a product that took effect before to
+	GetPriceHistory(ctx context.Context, productID string, to time.Time) ([]*PriceSnapshotDTO, error)
+
+	// ListDiscounts retrieves the scheduled discounts of a product ordered by start date
+	ListDiscounts(ctx context.Context, productID string) ([]*ScheduledDiscountDTO, error)
+
+	// ListAttributeDefinitions retrieves the attribute definitions of a category ordered by name
+	ListAttributeDefinitions(ctx context.Context, category string) ([]*AttributeDefinitionDTO, error)
+
+	// GetCategory retrieves a category by ID
+	GetCategory(ctx context.Context, categoryID string) (*CategoryDTO, error)
+
+	// ListCategories retrieves the children of a category, or the whole taxonomy
+	ListCategories(ctx context.Context, filter ListCategoriesFilter) ([]*CategoryDTO, error)
+
+	// GetCatalogFacets counts the products matching a listing filter by category,
+	// status, active discount and price band
+	GetCatalogFacets(ctx context.Context, filter CatalogFacetsFilter) (*CatalogFacetsDTO, error)
+}
+
+// ReadOptions controls the instant at which products are evaluated
+type ReadOptions struct {
+	// AsOf is the instant at which discounts and effective prices are evaluated
+	AsOf time.Time
+
+	// ReadStoredState reads the stored product state as it was at AsOf rather than
+	// the latest state. Queries set it only for past instants within the
+	// database's version retention period, see domain.ResolveStoredStateRead.
+	ReadStoredState bool
+
+	// Region selects the tax rates used to add net, tax and gross amounts to
+	// products, e.g. "DE" or "US-CA". Empty adds no tax amounts.
+	Region string
+
+	// PriceListID selects the price list whose prices replace products' default
+	// base prices. Products the list does not price, or a list not valid at
+	// AsOf, fall back to the default prices. Empty reads the default prices.
+	PriceListID string
 }
 
 // ProductDTO represents a product in the read model
 type ProductDTO struct {
-	ProductID          string
-	Name               string
-	Description        string
-	Category           string
-	BasePriceNumerator int64
+	ProductID            string
+	Name                 string
+	Description          string
+	Category             string
+	BasePriceNumerator   int64
 	BasePriceDenominator int64
+	Currency             string // ISO-4217 code of all prices
+	PriceListID          string // Set only if the base price comes from a price list
+	TaxClass             string // Selects the product's tax rate in each region
+
+	// Prices rendered as decimals in the currency's minor units, e.g. "19.99"
+	BasePriceDecimal      string
+	EffectivePriceDecimal string
 
 	// Effective price after discount
 	EffectivePriceNumerator   int64
 	EffectivePriceDenominator int64
 
 	// Discount information (if active)
-	HasDiscount       bool
-	DiscountPercent   *int64
-	DiscountStartDate *int64
-	DiscountEndDate   *int64
+	HasDiscount               bool
+	DiscountKind              string
+	DiscountPercent           *big.Rat // Set only for percentage discounts; exact, e.g. 25/2 for 12.5%
+	DiscountAmountNumerator   *int64   // Set only for fixed-amount discounts
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *int64
+	DiscountEndDate           *int64
+
+	Status       string
+	CreatedAtSec int64
+	UpdatedAtSec int64
+
+	// Variants ordered by creation, including retired ones
+	Variants []*VariantDTO
+
+	// Tax on the effective price in the requested region; nil if no region was requested
+	Tax *TaxDTO
+
+	// Price tiers ordered by minimum quantity. Quantities below the first tier
+	// sell at the base price.
+	PriceTiers []*PriceTierDTO
+
+	// Attribute values ordered by name
+	Attributes []*AttributeDTO
+
+	// Availability is "in_stock", "low_stock" or "out_of_stock", derived from the
+	// available quantity and the product's low stock threshold. Bundles derive it
+	// from their components and are "unavailable" while any component is not active.
+	Availability      string
+	AvailableQuantity int64 // On-hand minus reserved units; for bundles, whole bundles
+
+	// ProductType is "standard" or "bundle". Bundle prices above are derived from
+	// the components' effective prices at the time of reading.
+	ProductType string
+	Bundle      *BundleDTO // Set only for bundles
+}
+
+// BundleDTO represents the definition of a bundle product in the read model
+type BundleDTO struct {
+	Components []*BundleComponentDTO // Ordered by product ID
+
+	// At most one pricing rule is set
+	DiscountPercent           *big.Rat
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	PriceOverrideNumerator    *int64
+	PriceOverrideDenominator  *int64
+}
+
+// BundleComponentDTO represents a component product of a bundle
+type BundleComponentDTO struct {
+	ProductID string
+	Quantity  int64
+}
+
+// AttributeDTO represents an attribute value of a product in the read model
+type AttributeDTO struct {
+	Name  string
+	Type  string
+	Value string // Canonical text, e.g. "15.6" or "true"
+}
+
+// AttributeDefinitionDTO represents an attribute defined for a category
+type AttributeDefinitionDTO struct {
+	Category      string
+	Name          string
+	Type          string
+	Unit          string
+	Required      bool
+	AllowedValues []string
+}
+
+// VariantDTO represents a product variant in the read model
+type VariantDTO struct {
+	VariantID string
+	SKU       string
+	Options   map[string]string
+
+	// Price before discount: the variant's override or the product's base price
+	HasPriceOverride bool
+	PriceNumerator   int64
+	PriceDenominator int64
+
+	// Price after the product's discount
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+
+	// Prices rendered as decimals in the product's currency
+	PriceDecimal          string
+	EffectivePriceDecimal string
+
+	Status       string
+	CreatedAtSec int64
+	UpdatedAtSec int64
+}
+
+// PriceTierDTO represents a quantity price tier of a product in the read model
+type PriceTierDTO struct {
+	MinQuantity int64
+
+	// Unit price from MinQuantity units, before and after the product's discount
+	PriceNumerator            int64
+	PriceDenominator          int64
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+
+	// Prices rendered as decimals in the product's currency
+	PriceDecimal          string
+	EffectivePriceDecimal string
+}
+
+// QuantityPriceDTO represents the price of a quantity of a product
+type QuantityPriceDTO struct {
+	ProductID       string
+	Quantity        int64
+	TierMinQuantity int64 // Minimum quantity of the applied tier, 1 for the base price
+	Currency        string
+
+	// Unit price of the tier before discount
+	UnitPriceNumerator   int64
+	UnitPriceDenominator int64
+
+	// Unit price after discount
+	EffectiveUnitPriceNumerator   int64
+	EffectiveUnitPriceDenominator int64
+
+	// Effective unit price times the quantity
+	LineTotalNumerator   int64
+	LineTotalDenominator int64
+
+	// Prices rendered as decimals in the currency's minor units
+	UnitPriceDecimal          string
+	EffectiveUnitPriceDecimal string
+	LineTotalDecimal          string
+
+	// Tax on the line total in the requested region; nil if no region was requested
+	Tax *TaxDTO
+
+	// Discount information (if active)
+	HasDiscount               bool
+	DiscountKind              string
+	DiscountPercent           *big.Rat
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *int64
+	DiscountEndDate           *int64
+}
+
+// QuoteDTO represents the price quote of a cart
+type QuoteDTO struct {
+	Lines []*QuoteLineDTO // In request order
 
-	Status        string
-	CreatedAtSec  int64
-	UpdatedAtSec  int64
+	// Sum of the priced lines' totals; zero with an empty currency if every
+	// line was rejected
+	Currency         string
+	TotalNumerator   int64
+	TotalDenominator int64
+	TotalDecimal     string
+}
+
+// QuoteLineDTO represents a priced or rejected line of a quote
+type QuoteLineDTO struct {
+	ProductID string
+	Quantity  int64
+
+	// RejectionReason is empty if the line was priced, otherwise one of
+	// "not_found", "inactive", "archived", "bundle_unavailable",
+	// "invalid_quantity", "currency_mismatch", "amount_overflow" or
+	// "price_unavailable".
+	// Rejected lines have no prices or discount.
+	RejectionReason string
+
+	// Minimum quantity of the applied tier, 1 for the base price
+	TierMinQuantity int64
+
+	// Tier unit price before discount
+	UnitPriceNumerator   int64
+	UnitPriceDenominator int64
+
+	// Unit price after discount
+	EffectiveUnitPriceNumerator   int64
+	EffectiveUnitPriceDenominator int64
+
+	// Effective unit price times the quantity
+	LineTotalNumerator   int64
+	LineTotalDenominator int64
+
+	UnitPriceDecimal          string
+	EffectiveUnitPriceDecimal string
+	LineTotalDecimal          string
+
+	// Discount information (if active)
+	HasDiscount               bool
+	DiscountKind              string
+	DiscountPercent           *big.Rat
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *int64
+	DiscountEndDate           *int64
 }
 
 // PaginatedProductsDTO represents a paginated list of products
 type PaginatedProductsDTO struct {
-	Products     []*ProductDTO
+	Products      []*ProductDTO
 	NextPageToken string
 }
 
 // ListProductsFilter represents filters for listing products
 type ListProductsFilter struct {
-	Category  string // Optional filter by category
+	Category  string // Optional filter by category ID
 	PageSize  int
 	PageToken string
-	Status    string // Optional filter by status
+
+	// Statuses restricts products to any of the statuses. Without statuses,
+	// archived products are only listed if IncludeArchived is set.
+	Statuses        []string
+	IncludeArchived bool
+
+	// IncludeSubcategories extends the category filter to the category's whole subtree
+	IncludeSubcategories bool
+
+	// AttributeFilters restricts products to those whose attributes match every filter
+	AttributeFilters []AttributeFilter
+
+	// SortBy orders the products, by product ID if empty. Ties are broken by
+	// product ID in ascending order. Sorting by effective price leaves out
+	// bundles and uses the price stored at each product's last write, not the
+	// price at ReadOptions.AsOf.
+	SortBy     ProductSort
+	Descending bool
+
+	// MinPrice and MaxPrice bound the stored effective price inclusively, in
+	// PriceCurrency. Products priced in other currencies and bundles do not
+	// match.
+	MinPrice      *big.Rat
+	MaxPrice      *big.Rat
+	PriceCurrency string
+
+	ReadOptions ReadOptions
+}
+
+// ProductSort is the sort order of a product listing
+type ProductSort string
+
+const (
+	SortByProductID      ProductSort = ""
+	SortByName           ProductSort = "name"
+	SortByCreatedAt      ProductSort = "created_at"
+	SortByUpdatedAt      ProductSort = "updated_at"
+	SortByEffectivePrice ProductSort = "effective_price" // The price stored with the product, see domain.Product.ListingPrice
+)
+
+// AttributeFilter matches products by an attribute value. Equals matches the
+// value exactly, numerically for number attributes; Min and Max bound number
+// attributes inclusively. Empty bounds are ignored.
+type AttributeFilter struct {
+	Name   string
+	Equals string
+	Min    string
+	Max    string
+}
+
+// CatalogFacetsFilter represents the products counted by catalog facets
+type CatalogFacetsFilter struct {
+	// Products selects the counted products; paging and sort order are ignored
+	Products ListProductsFilter
+
+	// PriceBandBounds are the ascending bounds between price bands, in
+	// PriceBandCurrency. No bounds count no price bands.
+	PriceBandBounds   []*big.Rat
+	PriceBandCurrency string
+}
+
+// CatalogFacetsDTO represents the product counts of a catalog listing. The
+// status and price band counts ignore the listing's own status and price
+// range filters, so they count the products each choice would list.
+type CatalogFacetsDTO struct {
+	Total             int64            // Products matching the whole filter
+	Categories        []*FacetCountDTO // By category ID
+	Statuses          []*FacetCountDTO // By status
+	HasActiveDiscount []*FacetCountDTO // By "true" or "false"
+	PriceBands        []*PriceBandDTO  // In ascending order, including empty bands
+}
+
+// FacetCountDTO represents the number of products sharing a facet value
+type FacetCountDTO struct {
+	Value string
+	Count int64
+}
+
+// PriceBandDTO represents the number of products whose stored effective price
+// lies within [Min, Max). The first band has no Min and the last no Max.
+type PriceBandDTO struct {
+	Currency string
+
+	MinNumerator   *int64
+	MinDenominator *int64
+	MinDecimal     string
+	MaxNumerator   *int64
+	MaxDenominator *int64
+	MaxDecimal     string
+
+	Count int64
+}
+
+// SearchProductsFilter represents a full-text search of products
+type SearchProductsFilter struct {
+	// Terms are the distinct lower-cased words of the query; products must
+	// contain every term in their name or description
+	Terms []string
+
+	// Products restricts the matches like a listing; the page size, page
+	// token and read options apply, the sort order does not
+	Products ListProductsFilter
+}
+
+// SearchResultsDTO represents a page of search results, best match first
+type SearchResultsDTO struct {
+	Results       []*SearchResultDTO
+	NextPageToken string
+}
+
+// SearchResultDTO represents a product matching a search
+type SearchResultDTO struct {
+	Product *ProductDTO
+	Score   float64 // Relevance, only comparable within one search
+
+	// HTML-escaped name, and excerpt of the description around its first
+	// match, with the matched words in <em> tags. DescriptionHighlight is
+	// empty if only the name matches.
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+// CategoryDTO represents a category of the taxonomy
+type CategoryDTO struct {
+	CategoryID   string
+	Name         string
+	ParentID     string   // Empty for root categories
+	AncestorIDs  []string // From the root down to the parent
+	Status       string
+	CreatedAtSec int64
+	UpdatedAtSec int64
+}
+
+// ListCategoriesFilter represents filters for listing categories
+type ListCategoriesFilter struct {
+	ParentID        string // Optional, lists only the direct children of this category
+	IncludeArchived bool
+}
+
+// PriceSnapshotDTO represents a recorded pricing state of a product
+type PriceSnapshotDTO struct {
+	EffectiveFrom        time.Time
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	Currency             string
+
+	// Discount information (if any)
+	DiscountKind              string
+	DiscountPercent           *big.Rat
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *time.Time
+	DiscountEndDate           *time.Time
+
+	// Scheduled discounts, ordered by start date
+	Schedule []*PriceSnapshotDiscountDTO
+}
+
+// PriceSnapshotDiscountDTO represents a scheduled discount window recorded
+// in a price snapshot
+type PriceSnapshotDiscountDTO struct {
+	DiscountKind              string
+	DiscountPercent           *big.Rat
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	StartDate                 time.Time
+	EndDate                   time.Time
+}
+
+// PriceIntervalDTO represents a period with a single effective price
+type PriceIntervalDTO struct {
+	StartSec int64
+	EndSec   int64
+
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	Currency             string
+
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+
+	// Discount information (if active during the interval)
+	HasDiscount               bool
+	DiscountKind              string
+	DiscountPercent           *big.Rat
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *int64
+	DiscountEndDate           *int64
+}
+
+// ScheduledDiscountDTO represents a discount queued on a product
+type ScheduledDiscountDTO struct {
+	DiscountID                string
+	DiscountKind              string
+	DiscountPercent           *big.Rat // Set only for percentage discounts
+	DiscountAmountNumerator   *int64   // Set only for fixed-amount discounts
+	DiscountAmountDenominator *int64
+	Currency                  string
+	StartSec                  int64
+	EndSec                    int64
+}
+
+// TaxDTO splits an amount into its net price, the tax levied on it in a region
+// and the gross price. Amounts are rounded to the currency's minor units, so
+// net plus tax equals gross.
+type TaxDTO struct {
+	Region      string
+	TaxClass    string
+	RatePercent *big.Rat // Exact, e.g. 19 for 19% VAT
+
+	NetNumerator     int64
+	NetDenominator   int64
+	TaxNumerator     int64
+	TaxDenominator   int64
+	GrossNumerator   int64
+	GrossDenominator int64
+
+	NetDecimal   string
+	TaxDecimal   string
+	GrossDecimal string
 }
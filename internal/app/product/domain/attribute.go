@@ -0,0 +1,309 @@
+package domain
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AttributeType identifies the type of values an attribute holds
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+// attributeDecimalPlaces is the precision of number attributes, matching the
+// scale of the NUMERIC column
+const attributeDecimalPlaces = 9
+
+// ParseAttributeType returns the attribute type for its name
+func ParseAttributeType(name string) (AttributeType, error) {
+	switch typ := AttributeType(name); typ {
+	case AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean:
+		return typ, nil
+	default:
+		return "", ErrUnsupportedAttributeType
+	}
+}
+
+// AttributeDefinition describes an attribute products of a category may carry
+type AttributeDefinition struct {
+	name          string
+	typ           AttributeType
+	unit          string   // Optional, e.g. "W" or "in"
+	required      bool     // Products of the category must carry the attribute
+	allowedValues []string // Optional, string attributes only
+}
+
+// NewAttributeDefinition creates a new AttributeDefinition value object
+func NewAttributeDefinition(name string, typ AttributeType, unit string, required bool, allowedValues []string) (*AttributeDefinition, error) {
+	if name == "" {
+		return nil, ErrInvalidAttributeDefinition
+	}
+
+	if _, err := ParseAttributeType(string(typ)); err != nil {
+		return nil, err
+	}
+
+	if len(allowedValues) > 0 && typ != AttributeTypeString {
+		return nil, ErrInvalidAttributeDefinition
+	}
+
+	allowed := make([]string, len(allowedValues))
+	copy(allowed, allowedValues)
+
+	return &AttributeDefinition{
+		name:          name,
+		typ:           typ,
+		unit:          unit,
+		required:      required,
+		allowedValues: allowed,
+	}, nil
+}
+
+// Accessor methods
+
+func (d *AttributeDefinition) Name() string        { return d.name }
+func (d *AttributeDefinition) Type() AttributeType { return d.typ }
+func (d *AttributeDefinition) Unit() string        { return d.unit }
+func (d *AttributeDefinition) Required() bool      { return d.required }
+
+// AllowedValues returns the values a string attribute is restricted to, if any
+func (d *AttributeDefinition) AllowedValues() []string {
+	allowed := make([]string, len(d.allowedValues))
+	copy(allowed, d.allowedValues)
+	return allowed
+}
+
+// ParseValue parses raw as a value of the attribute, checking allowed values
+func (d *AttributeDefinition) ParseValue(raw string) (AttributeValue, error) {
+	value, err := ReconstructAttributeValue(string(d.typ), raw)
+	if err != nil {
+		return AttributeValue{}, err
+	}
+
+	if len(d.allowedValues) > 0 {
+		for _, allowed := range d.allowedValues {
+			if allowed == value.text {
+				return value, nil
+			}
+		}
+		return AttributeValue{}, ErrInvalidAttributeValue
+	}
+
+	return value, nil
+}
+
+// AttributeSchema holds the attribute definitions of a category
+type AttributeSchema struct {
+	category    string
+	definitions map[string]*AttributeDefinition
+}
+
+// NewAttributeSchema creates the attribute schema of a category
+func NewAttributeSchema(category string, definitions []*AttributeDefinition) *AttributeSchema {
+	s := &AttributeSchema{
+		category:    category,
+		definitions: make(map[string]*AttributeDefinition, len(definitions)),
+	}
+	for _, d := range definitions {
+		s.definitions[d.name] = d
+	}
+	return s
+}
+
+// Category returns the category the schema applies to
+func (s *AttributeSchema) Category() string { return s.category }
+
+// Definition returns the definition of the named attribute, if any
+func (s *AttributeSchema) Definition(name string) (*AttributeDefinition, bool) {
+	d, ok := s.definitions[name]
+	return d, ok
+}
+
+// Definitions returns the attribute definitions ordered by name
+func (s *AttributeSchema) Definitions() []*AttributeDefinition {
+	definitions := make([]*AttributeDefinition, 0, len(s.definitions))
+	for _, d := range s.definitions {
+		definitions = append(definitions, d)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].name < definitions[j].name
+	})
+	return definitions
+}
+
+// Validate checks that every value is defined with a matching type and that
+// every required attribute is present
+func (s *AttributeSchema) Validate(values map[string]AttributeValue) error {
+	for name, value := range values {
+		d, ok := s.definitions[name]
+		if !ok {
+			return ErrUnknownAttribute
+		}
+		if d.typ != value.typ {
+			return ErrInvalidAttributeValue
+		}
+	}
+
+	for name, d := range s.definitions {
+		if _, ok := values[name]; d.required && !ok {
+			return ErrMissingRequiredAttribute
+		}
+	}
+
+	return nil
+}
+
+// AttributeValue is a typed attribute value
+type AttributeValue struct {
+	typ    AttributeType
+	text   string   // Canonical text, e.g. "15.6" or "true"
+	number *big.Rat // Set only for number attributes
+}
+
+// ReconstructAttributeValue parses raw as a value of the given type.
+// Numbers are exact decimals with at most nine decimal places.
+func ReconstructAttributeValue(typ, raw string) (AttributeValue, error) {
+	attributeType, err := ParseAttributeType(typ)
+	if err != nil {
+		return AttributeValue{}, err
+	}
+
+	switch attributeType {
+	case AttributeTypeNumber:
+		number, err := ParseAttributeNumber(raw)
+		if err != nil {
+			return AttributeValue{}, err
+		}
+		return AttributeValue{
+			typ:    attributeType,
+			text:   formatDecimal(number, attributeDecimalPlaces),
+			number: number,
+		}, nil
+	case AttributeTypeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return AttributeValue{}, ErrInvalidAttributeValue
+		}
+		return AttributeValue{typ: attributeType, text: strconv.FormatBool(b)}, nil
+	default:
+		if raw == "" {
+			return AttributeValue{}, ErrInvalidAttributeValue
+		}
+		return AttributeValue{typ: attributeType, text: raw}, nil
+	}
+}
+
+// ParseAttributeNumber parses an exact decimal number attribute value such as
+// "15.6", with at most nine decimal places
+func ParseAttributeNumber(raw string) (*big.Rat, error) {
+	if strings.Contains(raw, "/") {
+		return nil, ErrInvalidAttributeValue
+	}
+
+	number, ok := new(big.Rat).SetString(strings.TrimSpace(raw))
+	if !ok {
+		return nil, ErrInvalidAttributeValue
+	}
+
+	scaled := new(big.Rat).Mul(number, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(attributeDecimalPlaces), nil)))
+	if !scaled.IsInt() {
+		return nil, ErrInvalidAttributeValue
+	}
+
+	return number, nil
+}
+
+// Type returns the attribute type of the value
+func (v AttributeValue) Type() AttributeType { return v.typ }
+
+// String returns the canonical text of the value
+func (v AttributeValue) String() string { return v.text }
+
+// Number returns the value of a number attribute, or nil
+func (v AttributeValue) Number() *big.Rat {
+	if v.number == nil {
+		return nil
+	}
+	return new(big.Rat).Set(v.number)
+}
+
+// Equals checks if two attribute values have the same type and value
+func (v AttributeValue) Equals(other AttributeValue) bool {
+	return v.typ == other.typ && v.text == other.text
+}
+
+// Attributes returns the product's attribute values by name
+func (p *Product) Attributes() map[string]AttributeValue {
+	attributes := make(map[string]AttributeValue, len(p.attributes))
+	for name, value := range p.attributes {
+		attributes[name] = value
+	}
+	return attributes
+}
+
+// UpdateAttributes sets and clears attribute values and validates the result
+// against the schema of the product's category. Attributes left over from a
+// previous category must be cleared in the same update.
+func (p *Product) UpdateAttributes(schema *AttributeSchema, set map[string]string, clear []string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	if schema == nil || schema.category != p.category {
+		return ErrAttributeSchemaMismatch
+	}
+
+	attributes := p.Attributes()
+	for _, name := range clear {
+		delete(attributes, name)
+	}
+
+	for name, raw := range set {
+		d, ok := schema.Definition(name)
+		if !ok {
+			return ErrUnknownAttribute
+		}
+
+		value, err := d.ParseValue(raw)
+		if err != nil {
+			return err
+		}
+		attributes[name] = value
+	}
+
+	if err := schema.Validate(attributes); err != nil {
+		return err
+	}
+
+	if attributesEqual(p.attributes, attributes) {
+		return nil // Attributes unchanged
+	}
+
+	p.attributes = attributes
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldAttributes)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewProductAttributesChangedEvent(p.id, attributes))
+
+	return nil
+}
+
+func attributesEqual(a, b map[string]AttributeValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, v := range a {
+		if w, ok := b[name]; !ok || !v.Equals(w) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func laptopSchema(t *testing.T) *AttributeSchema {
+	t.Helper()
+
+	screen, err := NewAttributeDefinition("screen_size", AttributeTypeNumber, "in", true, nil)
+	require.NoError(t, err)
+	panel, err := NewAttributeDefinition("panel", AttributeTypeString, "", false, []string{"ips", "oled"})
+	require.NoError(t, err)
+	touch, err := NewAttributeDefinition("touchscreen", AttributeTypeBoolean, "", false, nil)
+	require.NoError(t, err)
+
+	return NewAttributeSchema("laptops", []*AttributeDefinition{screen, panel, touch})
+}
+
+func TestUpdateAttributesValidatesAgainstSchema(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	schema := laptopSchema(t)
+
+	price, _ := NewMoney(999, 1, "USD")
+	product, _ := NewProduct("p-1", "Laptop", "", "laptops", price, now)
+	product.ClearEvents()
+
+	assert.ErrorIs(t, product.UpdateAttributes(schema, map[string]string{"panel": "ips"}, nil, now), ErrMissingRequiredAttribute)
+	assert.ErrorIs(t, product.UpdateAttributes(schema, map[string]string{"screen_size": "big"}, nil, now), ErrInvalidAttributeValue)
+	assert.ErrorIs(t, product.UpdateAttributes(schema, map[string]string{"screen_size": "15.6", "panel": "tn"}, nil, now), ErrInvalidAttributeValue)
+	assert.ErrorIs(t, product.UpdateAttributes(schema, map[string]string{"screen_size": "15.6", "weight": "2"}, nil, now), ErrUnknownAttribute)
+	assert.Empty(t, product.Attributes(), "rejected updates must not change attributes")
+
+	require.NoError(t, product.UpdateAttributes(schema, map[string]string{"screen_size": "15.60", "touchscreen": "1"}, nil, now))
+	assert.Equal(t, "15.6", product.Attributes()["screen_size"].String())
+	assert.Equal(t, "true", product.Attributes()["touchscreen"].String())
+	assert.True(t, product.Changes().Dirty(FieldAttributes))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, map[string]string{"screen_size": "15.6", "touchscreen": "true"}, product.DomainEvents()[0].(ProductAttributesChangedEvent).Attributes)
+
+	// Setting an equal value is a no-op
+	require.NoError(t, product.UpdateAttributes(schema, map[string]string{"screen_size": "15.6"}, nil, now))
+	assert.Len(t, product.DomainEvents(), 1)
+
+	require.NoError(t, product.UpdateAttributes(schema, nil, []string{"touchscreen"}, now))
+	assert.NotContains(t, product.Attributes(), "touchscreen")
+	assert.ErrorIs(t, product.UpdateAttributes(schema, nil, []string{"screen_size"}, now), ErrMissingRequiredAttribute)
+}
+
+func TestUpdateAttributesRequiresCategorySchema(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	price, _ := NewMoney(999, 1, "USD")
+	product, _ := NewProduct("p-1", "Laptop", "", "laptops", price, now)
+	require.NoError(t, product.UpdateAttributes(laptopSchema(t), map[string]string{"screen_size": "14"}, nil, now))
+
+	// Moving to another category requires clearing attributes it does not define
+	require.NoError(t, product.UpdateDetails("Laptop", "", "tablets", now))
+	tablets := NewAttributeSchema("tablets", nil)
+	assert.ErrorIs(t, product.UpdateAttributes(tablets, nil, nil, now), ErrUnknownAttribute)
+	require.NoError(t, product.UpdateAttributes(tablets, nil, []string{"screen_size"}, now))
+	assert.Empty(t, product.Attributes())
+
+	assert.ErrorIs(t, product.UpdateAttributes(laptopSchema(t), nil, nil, now), ErrAttributeSchemaMismatch)
+}
+
+func TestAttributeDefinitionRules(t *testing.T) {
+	_, err := NewAttributeDefinition("", AttributeTypeString, "", false, nil)
+	assert.ErrorIs(t, err, ErrInvalidAttributeDefinition)
+
+	_, err = NewAttributeDefinition("weight", AttributeTypeNumber, "kg", false, []string{"1"})
+	assert.ErrorIs(t, err, ErrInvalidAttributeDefinition, "only string attributes may restrict values")
+
+	_, err = NewAttributeDefinition("weight", AttributeType("date"), "", false, nil)
+	assert.ErrorIs(t, err, ErrUnsupportedAttributeType)
+
+	_, err = ParseAttributeNumber("1/3")
+	assert.ErrorIs(t, err, ErrInvalidAttributeValue)
+	_, err = ParseAttributeNumber("0.0000000001")
+	assert.ErrorIs(t, err, ErrInvalidAttributeValue, "numbers are limited to nine decimal places")
+}
@@ -0,0 +1,320 @@
+package domain
+
+import (
+	"math/big"
+	"sort"
+	"time"
+)
+
+// ProductType distinguishes products sold on their own from bundles of other products
+type ProductType string
+
+const (
+	ProductTypeStandard ProductType = "standard"
+	ProductTypeBundle   ProductType = "bundle"
+)
+
+// BundleComponent is a product included in a bundle and how many units of it
+// one bundle contains
+type BundleComponent struct {
+	productID string
+	quantity  int64
+}
+
+// NewBundleComponent creates a new BundleComponent value object
+func NewBundleComponent(productID string, quantity int64) (BundleComponent, error) {
+	if productID == "" {
+		return BundleComponent{}, ErrInvalidBundle
+	}
+	if quantity <= 0 {
+		return BundleComponent{}, ErrInvalidQuantity
+	}
+	return BundleComponent{productID: productID, quantity: quantity}, nil
+}
+
+func (c BundleComponent) ProductID() string { return c.productID }
+func (c BundleComponent) Quantity() int64   { return c.quantity }
+
+// Bundle describes the components of a bundle product and how its price is
+// derived: the explicit price override if set, otherwise the sum of the
+// components' effective prices less the bundle discount, if any
+type Bundle struct {
+	components      []BundleComponent // Ordered by product ID
+	discountPercent *big.Rat          // Set only for percentage bundle discounts
+	discountAmount  *Money            // Set only for fixed-amount bundle discounts
+	priceOverride   *Money            // Set only for bundles sold at a fixed price
+}
+
+// NewBundle creates a new Bundle value object. At most one of discountPercent,
+// discountAmount and priceOverride may be set.
+func NewBundle(components []BundleComponent, discountPercent *big.Rat, discountAmount, priceOverride *Money) (*Bundle, error) {
+	if len(components) == 0 {
+		return nil, ErrInvalidBundle
+	}
+
+	seen := make(map[string]bool, len(components))
+	for _, c := range components {
+		if c.productID == "" || c.quantity <= 0 || seen[c.productID] {
+			return nil, ErrInvalidBundle
+		}
+		seen[c.productID] = true
+	}
+
+	pricingRules := 0
+	for _, set := range []bool{discountPercent != nil, discountAmount != nil, priceOverride != nil} {
+		if set {
+			pricingRules++
+		}
+	}
+	if pricingRules > 1 {
+		return nil, ErrInvalidBundle
+	}
+
+	if discountPercent != nil && (discountPercent.Sign() < 0 || discountPercent.Cmp(big.NewRat(100, 1)) > 0) {
+		return nil, ErrDiscountOutOfRange
+	}
+
+	sorted := make([]BundleComponent, len(components))
+	copy(sorted, components)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].productID < sorted[j].productID
+	})
+
+	b := &Bundle{
+		components:     sorted,
+		discountAmount: discountAmount,
+		priceOverride:  priceOverride,
+	}
+	if discountPercent != nil {
+		b.discountPercent = new(big.Rat).Set(discountPercent)
+	}
+
+	return b, nil
+}
+
+// Accessor methods
+
+func (b *Bundle) DiscountAmount() *Money { return b.discountAmount }
+func (b *Bundle) PriceOverride() *Money  { return b.priceOverride }
+
+// Components returns the bundle's components ordered by product ID
+func (b *Bundle) Components() []BundleComponent {
+	components := make([]BundleComponent, len(b.components))
+	copy(components, b.components)
+	return components
+}
+
+// DiscountPercent returns the percentage taken off the components' total, or nil
+func (b *Bundle) DiscountPercent() *big.Rat {
+	if b.discountPercent == nil {
+		return nil
+	}
+	return new(big.Rat).Set(b.discountPercent)
+}
+
+// Contains checks if the bundle includes the given product
+func (b *Bundle) Contains(productID string) bool {
+	for _, c := range b.components {
+		if c.productID == productID {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckComponents checks that every component is a sellable standard product.
+// components must hold the component products by ID.
+func (b *Bundle) CheckComponents(components map[string]*Product) error {
+	for _, c := range b.components {
+		product, ok := components[c.productID]
+		if !ok {
+			return ErrProductNotFound
+		}
+		if product.IsBundle() {
+			return ErrNestedBundle
+		}
+		if product.status == ProductStatusArchived {
+			return ErrProductIsArchived
+		}
+	}
+	return nil
+}
+
+// CheckSellable checks that every component can be sold, so the bundle can be.
+// components must hold the component products by ID.
+func (b *Bundle) CheckSellable(components map[string]*Product) error {
+	for _, c := range b.components {
+		product, ok := components[c.productID]
+		if !ok || product.CheckSellable() != nil {
+			return ErrBundleUnavailable
+		}
+	}
+	return nil
+}
+
+// ComponentStock is the sellability of a bundle component
+type ComponentStock struct {
+	Active       bool
+	Availability Availability
+	Available    int64
+}
+
+// Availability derives the bundle's availability from its components' stock,
+// keyed by product ID: unavailable if any component is missing or not active,
+// otherwise as many bundles as the scarcest component allows, reported as low
+// stock if any component is low
+func (b *Bundle) Availability(stock map[string]ComponentStock) (Availability, int64) {
+	var (
+		available int64 = -1
+		low       bool
+	)
+
+	for _, c := range b.components {
+		s, ok := stock[c.productID]
+		if !ok || !s.Active {
+			return AvailabilityUnavailable, 0
+		}
+
+		if units := s.Available / c.quantity; available < 0 || units < available {
+			available = units
+		}
+		low = low || s.Availability == AvailabilityLowStock
+	}
+
+	switch {
+	case available <= 0:
+		return AvailabilityOutOfStock, 0
+	case low:
+		return AvailabilityLowStock, available
+	default:
+		return AvailabilityInStock, available
+	}
+}
+
+// Equals checks if two bundles have the same components and pricing rule
+func (b *Bundle) Equals(other *Bundle) bool {
+	if b == nil || other == nil {
+		return b == nil && other == nil
+	}
+
+	if len(b.components) != len(other.components) {
+		return false
+	}
+	for i, c := range b.components {
+		if c != other.components[i] {
+			return false
+		}
+	}
+
+	if (b.discountPercent == nil) != (other.discountPercent == nil) ||
+		b.discountPercent != nil && b.discountPercent.Cmp(other.discountPercent) != 0 {
+		return false
+	}
+
+	return b.discountAmount.Equals(other.discountAmount) && b.priceOverride.Equals(other.priceOverride)
+}
+
+// Type returns whether the product is a standard product or a bundle
+func (p *Product) Type() ProductType {
+	if p.bundle != nil {
+		return ProductTypeBundle
+	}
+	return ProductTypeStandard
+}
+
+// IsBundle checks if the product is a bundle of other products
+func (p *Product) IsBundle() bool { return p.bundle != nil }
+
+// Bundle returns the bundle definition of a bundle product, or nil
+func (p *Product) Bundle() *Bundle { return p.bundle }
+
+// NewBundleProduct creates a new bundle product. price is the bundle's price
+// derived from its definition at creation and must be positive; readers derive
+// the current price from the components' prices at the time of reading.
+func NewBundleProduct(id, name, description, category string, bundle *Bundle, price *Money, now time.Time) (*Product, error) {
+	if price == nil || price.Value().Sign() <= 0 {
+		return nil, ErrInvalidPrice
+	}
+
+	p, err := NewProduct(id, name, description, category, price, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.validateBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	p.bundle = bundle
+	p.changes.MarkDirty(FieldBundle)
+
+	p.recordEvent(NewProductBundleChangedEvent(p.id, bundle))
+
+	return p, nil
+}
+
+// UpdateBundle replaces the components and pricing rule of a bundle product.
+// price is the bundle's price derived from the new definition.
+func (p *Product) UpdateBundle(bundle *Bundle, price *Money, now time.Time) error {
+	if p.bundle == nil {
+		return ErrNotABundle
+	}
+
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	if err := p.validateBundle(bundle); err != nil {
+		return err
+	}
+
+	if price == nil || price.Value().Sign() <= 0 {
+		return ErrInvalidPrice
+	}
+	if !p.basePrice.SameCurrency(price) {
+		return ErrCurrencyMismatch
+	}
+
+	if p.bundle.Equals(bundle) && p.basePrice.Equals(price) {
+		return nil // Bundle unchanged
+	}
+
+	if !p.basePrice.Equals(price) {
+		oldPrice := p.basePrice
+		p.basePrice = price
+		p.changes.MarkDirty(FieldBasePrice)
+		p.recordEvent(NewProductPriceChangedEvent(p.id, oldPrice, price, bundlePriceReasonCode))
+	}
+
+	p.bundle = bundle
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldBundle)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewProductBundleChangedEvent(p.id, bundle))
+
+	return nil
+}
+
+// bundlePriceReasonCode is recorded on price changes caused by a bundle update
+const bundlePriceReasonCode = "bundle_updated"
+
+// validateBundle checks a bundle definition fits the product: it must not
+// include the product itself and its amounts must be in the product's currency
+func (p *Product) validateBundle(bundle *Bundle) error {
+	if bundle == nil {
+		return ErrInvalidBundle
+	}
+
+	if bundle.Contains(p.id) {
+		return ErrNestedBundle
+	}
+
+	for _, amount := range []*Money{bundle.discountAmount, bundle.priceOverride} {
+		if amount != nil && !p.basePrice.SameCurrency(amount) {
+			return ErrCurrencyMismatch
+		}
+	}
+
+	return nil
+}
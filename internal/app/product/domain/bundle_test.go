@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBundleValidation(t *testing.T) {
+	a, _ := NewBundleComponent("a", 1)
+	b, _ := NewBundleComponent("b", 2)
+	price, _ := NewMoney(10, 1, "USD")
+
+	_, err := NewBundleComponent("a", 0)
+	assert.ErrorIs(t, err, ErrInvalidQuantity)
+
+	_, err = NewBundle(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidBundle, "a bundle needs components")
+
+	_, err = NewBundle([]BundleComponent{a, a}, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidBundle, "components must be distinct")
+
+	_, err = NewBundle([]BundleComponent{a, b}, big.NewRat(10, 1), nil, price)
+	assert.ErrorIs(t, err, ErrInvalidBundle, "a discount and an override are exclusive")
+
+	_, err = NewBundle([]BundleComponent{a, b}, big.NewRat(101, 1), nil, nil)
+	assert.ErrorIs(t, err, ErrDiscountOutOfRange)
+
+	bundle, err := NewBundle([]BundleComponent{b, a}, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "a", bundle.Components()[0].ProductID(), "components are ordered by product ID")
+
+	same, _ := NewBundle([]BundleComponent{a, b}, nil, nil, nil)
+	assert.True(t, bundle.Equals(same))
+}
+
+func TestBundleProductRules(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	a, _ := NewBundleComponent("a", 1)
+	b, _ := NewBundleComponent("b", 2)
+	bundle, _ := NewBundle([]BundleComponent{a, b}, big.NewRat(10, 1), nil, nil)
+	price, _ := NewMoney(90, 1, "USD")
+
+	product, err := NewBundleProduct("kit", "Starter Kit", "", "kits", bundle, price, now)
+	require.NoError(t, err)
+	assert.Equal(t, ProductTypeBundle, product.Type())
+	assert.True(t, product.Changes().Dirty(FieldBundle))
+	assert.Equal(t, []string{"product.created", "product.bundle_changed"}, eventTypes(product.DomainEvents()))
+
+	selfRef, _ := NewBundleComponent("kit", 1)
+	nested, _ := NewBundle([]BundleComponent{a, selfRef}, nil, nil, nil)
+	assert.ErrorIs(t, product.UpdateBundle(nested, price, now), ErrNestedBundle)
+
+	eur, _ := NewMoney(80, 1, "EUR")
+	overridden, _ := NewBundle([]BundleComponent{a, b}, nil, nil, eur)
+	assert.ErrorIs(t, product.UpdateBundle(overridden, price, now), ErrCurrencyMismatch)
+
+	assert.ErrorIs(t, product.ChangePrice(eur, "manual", now), ErrBundlePriceDerived)
+	assert.ErrorIs(t, product.AddVariant("v-1", "KIT-1", nil, nil, now), ErrBundleVariants)
+
+	product.ClearEvents()
+	require.NoError(t, product.UpdateBundle(bundle, price, now), "an unchanged bundle is a no-op")
+	assert.Empty(t, product.DomainEvents())
+
+	repriced, _ := NewMoney(85, 1, "USD")
+	require.NoError(t, product.UpdateBundle(bundle, repriced, now))
+	assert.Equal(t, []string{"product.price_changed", "product.bundle_changed"}, eventTypes(product.DomainEvents()))
+
+	standard, _ := NewProduct("p-1", "Chair", "", "furniture", price, now)
+	assert.ErrorIs(t, standard.UpdateBundle(bundle, price, now), ErrNotABundle)
+	assert.Equal(t, ProductTypeStandard, standard.Type())
+}
+
+func TestBundleCheckComponents(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(10, 1, "USD")
+
+	a, _ := NewBundleComponent("a", 1)
+	bundle, _ := NewBundle([]BundleComponent{a}, nil, nil, nil)
+
+	assert.ErrorIs(t, bundle.CheckComponents(map[string]*Product{}), ErrProductNotFound)
+
+	component, _ := NewProduct("a", "Cable", "", "accessories", price, now)
+	assert.NoError(t, bundle.CheckComponents(map[string]*Product{"a": component}))
+
+	require.NoError(t, component.Archive(now))
+	assert.ErrorIs(t, bundle.CheckComponents(map[string]*Product{"a": component}), ErrProductIsArchived)
+
+	kit, _ := NewBundleProduct("a", "Kit", "", "kits", func() *Bundle {
+		c, _ := NewBundleComponent("z", 1)
+		b, _ := NewBundle([]BundleComponent{c}, nil, nil, nil)
+		return b
+	}(), price, now)
+	assert.ErrorIs(t, bundle.CheckComponents(map[string]*Product{"a": kit}), ErrNestedBundle)
+}
+
+func TestBundleAvailability(t *testing.T) {
+	a, _ := NewBundleComponent("a", 1)
+	b, _ := NewBundleComponent("b", 2)
+	bundle, _ := NewBundle([]BundleComponent{a, b}, nil, nil, nil)
+
+	tests := []struct {
+		name      string
+		stock     map[string]ComponentStock
+		expected  Availability
+		available int64
+	}{
+		{
+			name: "limited by the scarcest component",
+			stock: map[string]ComponentStock{
+				"a": {Active: true, Availability: AvailabilityInStock, Available: 10},
+				"b": {Active: true, Availability: AvailabilityInStock, Available: 7},
+			},
+			expected:  AvailabilityInStock,
+			available: 3,
+		},
+		{
+			name: "low when a component is low",
+			stock: map[string]ComponentStock{
+				"a": {Active: true, Availability: AvailabilityLowStock, Available: 2},
+				"b": {Active: true, Availability: AvailabilityInStock, Available: 20},
+			},
+			expected:  AvailabilityLowStock,
+			available: 2,
+		},
+		{
+			name: "out of stock when a component cannot fill one bundle",
+			stock: map[string]ComponentStock{
+				"a": {Active: true, Availability: AvailabilityInStock, Available: 10},
+				"b": {Active: true, Availability: AvailabilityLowStock, Available: 1},
+			},
+			expected: AvailabilityOutOfStock,
+		},
+		{
+			name: "unavailable when a component is inactive",
+			stock: map[string]ComponentStock{
+				"a": {Active: false, Availability: AvailabilityInStock, Available: 10},
+				"b": {Active: true, Availability: AvailabilityInStock, Available: 10},
+			},
+			expected: AvailabilityUnavailable,
+		},
+		{
+			name: "unavailable when a component is missing",
+			stock: map[string]ComponentStock{
+				"a": {Active: true, Availability: AvailabilityInStock, Available: 10},
+			},
+			expected: AvailabilityUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			availability, available := bundle.Availability(tt.stock)
+			assert.Equal(t, tt.expected, availability)
+			assert.Equal(t, tt.available, available)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// CategoryStatus represents the status of a category
+type CategoryStatus string
+
+const (
+	CategoryStatusActive   CategoryStatus = "active"
+	CategoryStatusArchived CategoryStatus = "archived"
+)
+
+// maxCategoryNameLength matches the size of the categories.name column
+const maxCategoryNameLength = 100
+
+// Category is the aggregate root for the category taxonomy. Categories form a
+// tree; each category records the path of IDs from its root to itself so a
+// subtree can be selected by path prefix.
+type Category struct {
+	id         string
+	name       string
+	parentID   string // Empty for root categories
+	path       string // "/<root id>/.../<id>/"
+	status     CategoryStatus
+	createdAt  time.Time
+	updatedAt  time.Time
+	archivedAt *time.Time
+	changes    *ChangeTracker
+	events     []DomainEvent
+	version    int
+}
+
+// NewCategory creates a new active category under parent, or a root category
+// if parent is nil
+func NewCategory(id, name string, parent *Category, now time.Time) (*Category, error) {
+	name = strings.TrimSpace(name)
+	if id == "" || name == "" || len(name) > maxCategoryNameLength {
+		return nil, ErrInvalidCategoryName
+	}
+
+	c := &Category{
+		id:        id,
+		name:      name,
+		path:      "/" + id + "/",
+		status:    CategoryStatusActive,
+		createdAt: now,
+		updatedAt: now,
+		changes:   NewChangeTracker(),
+		events:    make([]DomainEvent, 0),
+	}
+
+	if parent != nil {
+		if parent.IsArchived() {
+			return nil, ErrCategoryArchived
+		}
+		c.parentID = parent.id
+		c.path = parent.path + id + "/"
+	}
+
+	c.recordEvent(NewCategoryCreatedEvent(id, name, c.parentID))
+
+	return c, nil
+}
+
+// ReconstructCategory reconstructs a category from persistence
+func ReconstructCategory(
+	id, name, parentID, path string,
+	status string,
+	createdAt, updatedAt time.Time,
+	archivedAt *time.Time,
+	version int,
+) *Category {
+	return &Category{
+		id:         id,
+		name:       name,
+		parentID:   parentID,
+		path:       path,
+		status:     CategoryStatus(status),
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+		archivedAt: archivedAt,
+		changes:    NewChangeTracker(),
+		events:     make([]DomainEvent, 0),
+		version:    version,
+	}
+}
+
+// Accessor methods
+
+func (c *Category) ID() string              { return c.id }
+func (c *Category) Name() string            { return c.name }
+func (c *Category) ParentID() string        { return c.parentID }
+func (c *Category) Path() string            { return c.path }
+func (c *Category) Status() CategoryStatus  { return c.status }
+func (c *Category) CreatedAt() time.Time    { return c.createdAt }
+func (c *Category) UpdatedAt() time.Time    { return c.updatedAt }
+func (c *Category) ArchivedAt() *time.Time  { return c.archivedAt }
+func (c *Category) Changes() *ChangeTracker { return c.changes }
+func (c *Category) Version() int            { return c.version }
+func (c *Category) IsArchived() bool        { return c.status == CategoryStatusArchived }
+
+// NameKey returns the key sibling category names must be unique by
+func (c *Category) NameKey() string { return CategoryNameKey(c.name) }
+
+// CategoryNameKey normalises a category name for uniqueness checks, so that
+// "Shoes" and " shoes" collide
+func CategoryNameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Contains checks if other is the category itself or one of its descendants
+func (c *Category) Contains(other *Category) bool {
+	return strings.HasPrefix(other.path, c.path)
+}
+
+// DomainEvents returns all recorded events
+func (c *Category) DomainEvents() []DomainEvent {
+	return c.events
+}
+
+// CanAssignProducts checks that products may be placed in the category
+func (c *Category) CanAssignProducts() error {
+	if c.IsArchived() {
+		return ErrCategoryArchived
+	}
+	return nil
+}
+
+// Rename changes the category's display name
+func (c *Category) Rename(name string, now time.Time) error {
+	if c.IsArchived() {
+		return ErrCategoryArchived
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxCategoryNameLength {
+		return ErrInvalidCategoryName
+	}
+
+	if c.name == name {
+		return nil // Name unchanged
+	}
+
+	c.name = name
+	c.updatedAt = now
+	c.changes.MarkDirty(FieldName)
+	c.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	c.recordEvent(NewCategoryRenamedEvent(c.id, name))
+
+	return nil
+}
+
+// MoveTo moves the category and its subtree under parent, or to the root if
+// parent is nil. descendants must hold every descendant of the category; their
+// paths are rewritten to follow the move.
+func (c *Category) MoveTo(parent *Category, descendants []*Category, now time.Time) error {
+	if c.IsArchived() {
+		return ErrCategoryArchived
+	}
+
+	newParentID, newPath := "", "/"+c.id+"/"
+	if parent != nil {
+		if parent.IsArchived() {
+			return ErrCategoryArchived
+		}
+		if c.Contains(parent) {
+			return ErrCategoryCycle
+		}
+		newParentID, newPath = parent.id, parent.path+c.id+"/"
+	}
+
+	if newParentID == c.parentID {
+		return nil // Already under parent
+	}
+
+	oldParentID, oldPath := c.parentID, c.path
+	for _, d := range descendants {
+		if d.id == c.id || !strings.HasPrefix(d.path, oldPath) {
+			continue
+		}
+		d.path = newPath + strings.TrimPrefix(d.path, oldPath)
+		d.updatedAt = now
+		d.changes.MarkDirty(FieldCategoryPath)
+		d.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+	}
+
+	c.parentID = newParentID
+	c.path = newPath
+	c.updatedAt = now
+	c.changes.MarkDirty(FieldCategoryPath)
+	c.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	c.recordEvent(NewCategoryMovedEvent(c.id, oldParentID, newParentID))
+
+	return nil
+}
+
+// Archive retires the category. children must hold the category's direct
+// children; a category with active children cannot be archived. Products keep
+// their archived category but no product can be moved into it.
+func (c *Category) Archive(children []*Category, now time.Time) error {
+	if c.IsArchived() {
+		return nil // Already archived
+	}
+
+	for _, child := range children {
+		if child.parentID == c.id && !child.IsArchived() {
+			return ErrCategoryHasChildren
+		}
+	}
+
+	c.status = CategoryStatusArchived
+	c.archivedAt = &now
+	c.updatedAt = now
+	c.changes.MarkDirty(FieldStatus)
+	c.changes.MarkDirty(FieldArchivedAt)
+
+	c.recordEvent(NewCategoryArchivedEvent(c.id))
+
+	return nil
+}
+
+func (c *Category) recordEvent(event DomainEvent) {
+	c.events = append(c.events, event)
+}
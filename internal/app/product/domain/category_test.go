@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryBuildsPathFromParent(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	root, err := NewCategory("c-1", " Electronics ", nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Electronics", root.Name())
+	assert.Equal(t, "/c-1/", root.Path())
+	assert.Empty(t, root.ParentID())
+
+	child, err := NewCategory("c-2", "Laptops", root, now)
+	require.NoError(t, err)
+	assert.Equal(t, "c-1", child.ParentID())
+	assert.Equal(t, "/c-1/c-2/", child.Path())
+	assert.True(t, root.Contains(child))
+	assert.False(t, child.Contains(root))
+
+	_, err = NewCategory("c-3", "  ", nil, now)
+	assert.ErrorIs(t, err, ErrInvalidCategoryName)
+
+	assert.Equal(t, CategoryNameKey("laptops"), child.NameKey())
+}
+
+func TestMoveCategoryRewritesSubtree(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	electronics, _ := NewCategory("c-1", "Electronics", nil, now)
+	computers, _ := NewCategory("c-2", "Computers", electronics, now)
+	laptops, _ := NewCategory("c-3", "Laptops", computers, now)
+	office, _ := NewCategory("c-4", "Office", nil, now)
+
+	// Test: A category cannot move below itself
+	assert.ErrorIs(t, computers.MoveTo(laptops, []*Category{laptops}, now), ErrCategoryCycle)
+	assert.ErrorIs(t, computers.MoveTo(computers, []*Category{laptops}, now), ErrCategoryCycle)
+
+	require.NoError(t, computers.MoveTo(office, []*Category{laptops}, now))
+	assert.Equal(t, "c-4", computers.ParentID())
+	assert.Equal(t, "/c-4/c-2/", computers.Path())
+	assert.Equal(t, "/c-4/c-2/c-3/", laptops.Path())
+	assert.Equal(t, "c-2", laptops.ParentID(), "descendants keep their parent")
+	assert.True(t, laptops.Changes().Dirty(FieldCategoryPath))
+
+	events := computers.DomainEvents()
+	require.Len(t, events, 2)
+	moved := events[1].(CategoryMovedEvent)
+	assert.Equal(t, "c-1", moved.OldParentID)
+	assert.Equal(t, "c-4", moved.ParentID)
+
+	// Moving to the root
+	require.NoError(t, computers.MoveTo(nil, []*Category{laptops}, now))
+	assert.Equal(t, "/c-2/c-3/", laptops.Path())
+}
+
+func TestArchiveCategoryRequiresArchivedChildren(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	root, _ := NewCategory("c-1", "Electronics", nil, now)
+	child, _ := NewCategory("c-2", "Laptops", root, now)
+
+	assert.ErrorIs(t, root.Archive([]*Category{child}, now), ErrCategoryHasChildren)
+
+	require.NoError(t, child.Archive(nil, now))
+	require.NoError(t, root.Archive([]*Category{child}, now))
+	assert.True(t, root.IsArchived())
+	assert.NotNil(t, root.ArchivedAt())
+
+	assert.ErrorIs(t, root.CanAssignProducts(), ErrCategoryArchived)
+	assert.ErrorIs(t, root.Rename("Gadgets", now), ErrCategoryArchived)
+	_, err := NewCategory("c-3", "Phones", root, now)
+	assert.ErrorIs(t, err, ErrCategoryArchived)
+}
+
+func TestMigrateCategoryAppliesToArchivedProducts(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(1000, 100, "USD")
+
+	product, err := NewProduct("p-1", "Mug", "", "Kitchen", price, now)
+	require.NoError(t, err)
+	require.NoError(t, product.Archive(now))
+	product.ClearEvents()
+
+	assert.ErrorIs(t, product.UpdateDetails("Mug", "", "c-1", now), ErrProductIsArchived)
+
+	require.NoError(t, product.MigrateCategory("c-1", now))
+	assert.Equal(t, "c-1", product.Category())
+	assert.True(t, product.Changes().Dirty(FieldCategory))
+	require.Len(t, product.DomainEvents(), 1)
+
+	// Migrating again is a no-op
+	product.ClearEvents()
+	require.NoError(t, product.MigrateCategory("c-1", now))
+	assert.Empty(t, product.DomainEvents())
+}
@@ -59,12 +59,23 @@ func (ct *ChangeTracker) DirtyFields() []string {
 
 // Field constants for change tracking
 const (
-	FieldID           = "id"
-	FieldName         = "name"
-	FieldDescription  = "description"
-	FieldCategory     = "category"
-	FieldBasePrice    = "base_price"
-	FieldDiscount     = "discount"
-	FieldStatus       = "status"
-	FieldArchivedAt   = "archived_at"
+	FieldID               = "id"
+	FieldName             = "name"
+	FieldDescription      = "description"
+	FieldCategory         = "category"
+	FieldAttributes       = "attributes"
+	FieldBasePrice        = "base_price"
+	FieldDiscount         = "discount"
+	FieldDiscountSchedule = "discount_schedule"
+	FieldListingPrice     = "listing_price" // The effective price stored for listings
+	FieldVariants         = "variants"
+	FieldBundle           = "bundle" // A bundle's components and pricing rule
+	FieldPriceTiers       = "price_tiers"
+	FieldPriceListEntries = "price_list_entries"
+	FieldTaxClass         = "tax_class"
+	FieldStatus           = "status"
+	FieldArchivedAt       = "archived_at"
+	FieldCategoryPath     = "category_path" // A category's parent and path
+	FieldStockLevels      = "stock_levels"  // A stock record's on-hand and reserved quantities
+	FieldStockThreshold   = "stock_threshold"
 )
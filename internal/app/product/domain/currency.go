@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"strings"
+)
+
+// DefaultCurrencyCode is used when a request does not specify a currency
+const DefaultCurrencyCode = "USD"
+
+// Currency is an ISO-4217 currency with its minor-unit precision
+type Currency struct {
+	code       string
+	minorUnits int
+}
+
+// Code returns the ISO-4217 alphabetic code
+func (c Currency) Code() string { return c.code }
+
+// MinorUnits returns the number of decimal places of the currency's minor unit
+func (c Currency) MinorUnits() int { return c.minorUnits }
+
+// knownCurrencies lists the supported ISO-4217 currencies and their minor units
+var knownCurrencies = map[string]int{
+	"AUD": 2,
+	"BHD": 3,
+	"BRL": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HKD": 2,
+	"HUF": 2,
+	"INR": 2,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"MXN": 2,
+	"NOK": 2,
+	"NZD": 2,
+	"OMR": 3,
+	"PKR": 2,
+	"PLN": 2,
+	"SEK": 2,
+	"SGD": 2,
+	"TND": 3,
+	"TRY": 2,
+	"USD": 2,
+	"ZAR": 2,
+}
+
+// LookupCurrency returns the currency for an ISO-4217 code
+func LookupCurrency(code string) (Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	minorUnits, ok := knownCurrencies[code]
+	if !ok {
+		return Currency{}, ErrUnsupportedCurrency
+	}
+
+	return Currency{code: code, minorUnits: minorUnits}, nil
+}
@@ -1,19 +1,75 @@
 package domain
 
 import (
+	"math/big"
+	"strings"
 	"time"
 )
 
-// Discount represents a percentage-based discount with a validity period
+// DiscountKind identifies how a discount reduces the base price
+type DiscountKind string
+
+const (
+	DiscountKindPercentage  DiscountKind = "percentage"
+	DiscountKindFixedAmount DiscountKind = "fixed_amount"
+)
+
+// ParseDiscountKind returns the discount kind for its name.
+// An empty name is a percentage discount.
+func ParseDiscountKind(name string) (DiscountKind, error) {
+	switch kind := DiscountKind(name); kind {
+	case "":
+		return DiscountKindPercentage, nil
+	case DiscountKindPercentage, DiscountKindFixedAmount:
+		return kind, nil
+	default:
+		return "", ErrUnsupportedDiscountKind
+	}
+}
+
+// percentageDecimalPlaces is the scale of the NUMERIC columns storing
+// percentages. Parsed percentages must be exact at this scale.
+const percentageDecimalPlaces = 9
+
+// percentageScale is 10^percentageDecimalPlaces
+var percentageScale = new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(percentageDecimalPlaces), nil))
+
+// ParsePercentage parses an exact percentage written as a decimal or a fraction,
+// for example "12.5" or "25/2". Percentages with more than nine decimal places,
+// such as "100/3", are rejected because NUMERIC columns would round them.
+func ParsePercentage(s string) (*big.Rat, error) {
+	percentage, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, ErrInvalidDiscountPercent
+	}
+	if !new(big.Rat).Mul(percentage, percentageScale).IsInt() {
+		return nil, ErrPercentageTooPrecise
+	}
+	return percentage, nil
+}
+
+// FormatPercentage renders a percentage as a decimal string with no trailing zeros,
+// for example "12.5". Repeating decimals are rounded to nine decimal places.
+func FormatPercentage(percentage *big.Rat) string {
+	if percentage == nil {
+		return "0"
+	}
+	return formatDecimal(percentage, percentageDecimalPlaces)
+}
+
+// Discount represents a percentage or fixed-amount discount with a validity period
 type Discount struct {
-	percentage int64
+	kind       DiscountKind
+	percentage *big.Rat
+	amount     *Money
 	startDate  time.Time
 	endDate    time.Time
 }
 
-// NewDiscount creates a new Discount value object
-func NewDiscount(percentage int64, startDate, endDate time.Time) (*Discount, error) {
-	if percentage < 0 || percentage > 100 {
+// NewDiscount creates a new percentage Discount value object.
+// The percentage is exact and may be fractional, e.g. 25/2 for 12.5%.
+func NewDiscount(percentage *big.Rat, startDate, endDate time.Time) (*Discount, error) {
+	if percentage == nil || percentage.Sign() < 0 || percentage.Cmp(big.NewRat(100, 1)) > 0 {
 		return nil, ErrDiscountOutOfRange
 	}
 
@@ -22,18 +78,78 @@ func NewDiscount(percentage int64, startDate, endDate time.Time) (*Discount, err
 	}
 
 	return &Discount{
-		percentage: percentage,
+		kind:       DiscountKindPercentage,
+		percentage: new(big.Rat).Set(percentage),
 		startDate:  startDate,
 		endDate:    endDate,
 	}, nil
 }
 
-// Percentage returns the discount percentage
-func (d *Discount) Percentage() int64 {
+// NewFixedAmountDiscount creates a new Discount taking a fixed amount off the base price
+func NewFixedAmountDiscount(amount *Money, startDate, endDate time.Time) (*Discount, error) {
+	if amount == nil {
+		return nil, ErrInvalidDiscountAmount
+	}
+
+	if endDate.Before(startDate) {
+		return nil, ErrInvalidDateRange
+	}
+
+	return &Discount{
+		kind:      DiscountKindFixedAmount,
+		amount:    amount,
+		startDate: startDate,
+		endDate:   endDate,
+	}, nil
+}
+
+// ReconstructDiscount reconstructs a discount from persistence.
+// An empty kind is a percentage discount, as stored before fixed amounts existed.
+func ReconstructDiscount(
+	kind string,
+	percentage *big.Rat,
+	amountNum, amountDenom int64,
+	currencyCode string,
+	startDate, endDate time.Time,
+) (*Discount, error) {
+	discountKind, err := ParseDiscountKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if discountKind == DiscountKindFixedAmount {
+		amount, err := NewMoney(amountNum, amountDenom, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+		return NewFixedAmountDiscount(amount, startDate, endDate)
+	}
+
+	return NewDiscount(percentage, startDate, endDate)
+}
+
+// Kind returns how the discount reduces the base price
+func (d *Discount) Kind() DiscountKind {
+	if d == nil {
+		return ""
+	}
+	return d.kind
+}
+
+// Percentage returns the exact discount percentage, or nil for a fixed-amount discount
+func (d *Discount) Percentage() *big.Rat {
+	if d == nil || d.percentage == nil {
+		return nil
+	}
+	return new(big.Rat).Set(d.percentage)
+}
+
+// Amount returns the amount taken off, or nil for a percentage discount
+func (d *Discount) Amount() *Money {
 	if d == nil {
-		return 0
+		return nil
 	}
-	return d.percentage
+	return d.amount
 }
 
 // StartDate returns when the discount becomes active
@@ -72,6 +188,18 @@ func (d *Discount) IsValidAt(now time.Time) bool {
 	return now.Before(d.endDate) || now.Equal(d.endDate)
 }
 
+// ApplyTo returns the price after the discount, never below zero
+func (d *Discount) ApplyTo(price *Money) (*Money, error) {
+	if d == nil {
+		return price, nil
+	}
+
+	if d.kind == DiscountKindFixedAmount {
+		return price.SubtractFixedAmount(d.amount)
+	}
+	return price.ApplyPercentage(d.percentage)
+}
+
 // Equals checks if two discounts are equal
 func (d *Discount) Equals(other *Discount) bool {
 	if d == nil && other == nil {
@@ -81,7 +209,11 @@ func (d *Discount) Equals(other *Discount) bool {
 		return false
 	}
 
-	return d.percentage == other.percentage &&
+	return d.kind == other.kind &&
+		(d.percentage == nil) == (other.percentage == nil) &&
+		(d.percentage == nil || d.percentage.Cmp(other.percentage) == 0) &&
+		(d.amount == nil) == (other.amount == nil) &&
+		(d.amount == nil || d.amount.Equals(other.amount)) &&
 		d.startDate.Equal(other.startDate) &&
 		d.endDate.Equal(other.endDate)
 }
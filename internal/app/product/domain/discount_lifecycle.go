@@ -0,0 +1,109 @@
+package domain
+
+import "time"
+
+// DiscountPhase records which lifecycle events have been emitted for a discount window
+type DiscountPhase string
+
+const (
+	DiscountPhasePending DiscountPhase = "pending"
+	DiscountPhaseStarted DiscountPhase = "started"
+	DiscountPhaseEnded   DiscountPhase = "ended"
+)
+
+// ParseDiscountPhase returns the discount phase for its name.
+// An empty name is a pending discount, as stored before phases were tracked.
+func ParseDiscountPhase(name string) (DiscountPhase, error) {
+	switch phase := DiscountPhase(name); phase {
+	case "":
+		return DiscountPhasePending, nil
+	case DiscountPhasePending, DiscountPhaseStarted, DiscountPhaseEnded:
+		return phase, nil
+	default:
+		return "", ErrUnsupportedDiscountPhase
+	}
+}
+
+// DiscountPhase returns the lifecycle phase of the applied discount
+func (p *Product) DiscountPhase() DiscountPhase { return p.discountPhase }
+
+// Phase returns the lifecycle phase of the scheduled discount
+func (s *ScheduledDiscount) Phase() DiscountPhase { return s.phase }
+
+// AdvanceDiscountLifecycle emits the start and end events of every discount
+// window that crossed a boundary by now and records the new phases, so each
+// event is emitted once. A window that was missed entirely emits both events.
+// It returns true if any phase changed.
+func (p *Product) AdvanceDiscountLifecycle(now time.Time) bool {
+	if p.status == ProductStatusArchived {
+		return false
+	}
+
+	advanced := false
+
+	if p.discount != nil {
+		if phase := p.advancePhase("", p.discount, p.discountPhase, now); phase != p.discountPhase {
+			p.discountPhase = phase
+			p.changes.MarkDirty(FieldDiscount)
+			advanced = true
+		}
+	}
+
+	for _, s := range p.schedule {
+		if phase := p.advancePhase(s.id, s.discount, s.phase, now); phase != s.phase {
+			s.phase = phase
+			p.changes.MarkDirty(FieldDiscountSchedule)
+			advanced = true
+		}
+	}
+
+	if advanced {
+		p.updatedAt = now
+		p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+	}
+
+	return advanced
+}
+
+// endStarted records the end event of a discount that is withdrawn after its
+// window started but before the lifecycle ended it, so consumers that saw the
+// start also see the end
+func (p *Product) endStarted(discountID string, discount *Discount, phase DiscountPhase) {
+	if discount != nil && phase == DiscountPhaseStarted {
+		p.recordEvent(NewDiscountEndedEvent(p.id, discountID, discount))
+	}
+}
+
+// endRunningDiscounts ends every discount window that has started and not yet
+// ended, recording its end event, for products the lifecycle stops advancing
+func (p *Product) endRunningDiscounts() {
+	if p.discountPhase == DiscountPhaseStarted {
+		p.endStarted("", p.discount, p.discountPhase)
+		p.discountPhase = DiscountPhaseEnded
+		p.changes.MarkDirty(FieldDiscount)
+	}
+
+	for _, s := range p.schedule {
+		if s.phase == DiscountPhaseStarted {
+			p.endStarted(s.id, s.discount, s.phase)
+			s.phase = DiscountPhaseEnded
+			p.changes.MarkDirty(FieldDiscountSchedule)
+		}
+	}
+}
+
+// advancePhase records the events for the boundaries a discount crossed since
+// phase and returns its phase at now
+func (p *Product) advancePhase(discountID string, discount *Discount, phase DiscountPhase, now time.Time) DiscountPhase {
+	if phase == DiscountPhasePending && !now.Before(discount.StartDate()) {
+		p.recordEvent(NewDiscountStartedEvent(p.id, discountID, discount))
+		phase = DiscountPhaseStarted
+	}
+
+	if phase == DiscountPhaseStarted && now.After(discount.EndDate()) {
+		p.recordEvent(NewDiscountEndedEvent(p.id, discountID, discount))
+		phase = DiscountPhaseEnded
+	}
+
+	return phase
+}
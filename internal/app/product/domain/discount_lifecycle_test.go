@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eventTypes(events []DomainEvent) []string {
+	types := make([]string, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.EventType())
+	}
+	return types
+}
+
+func TestAdvanceDiscountLifecycleEmitsEachBoundaryOnce(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "Chair", "", "furniture", price, now)
+
+	applied, _ := NewDiscount(big.NewRat(10, 1), day(1), day(2))
+	require.NoError(t, product.ApplyDiscount(applied, now))
+	scheduled, _ := NewDiscount(big.NewRat(30, 1), day(7), day(9))
+	require.NoError(t, product.ScheduleDiscount("d-1", scheduled, now))
+	product.ClearEvents()
+
+	assert.False(t, product.AdvanceDiscountLifecycle(now), "no window has opened yet")
+
+	require.True(t, product.AdvanceDiscountLifecycle(day(1)))
+	assert.Equal(t, []string{"discount.started"}, eventTypes(product.DomainEvents()))
+	assert.Equal(t, DiscountPhaseStarted, product.DiscountPhase())
+	assert.True(t, product.Changes().Dirty(FieldDiscount))
+	product.ClearEvents()
+
+	assert.False(t, product.AdvanceDiscountLifecycle(day(1)), "start must not be emitted twice")
+	assert.Empty(t, product.DomainEvents())
+
+	// The scheduler missed the whole scheduled window: both events are emitted in order
+	require.True(t, product.AdvanceDiscountLifecycle(day(10)))
+	assert.Equal(t,
+		[]string{"discount.ended", "discount.started", "discount.ended"},
+		eventTypes(product.DomainEvents()),
+	)
+	assert.Equal(t, "d-1", product.DomainEvents()[1].(DiscountStartedEvent).DiscountID)
+	assert.Equal(t, DiscountPhaseEnded, product.DiscountSchedule()[0].Phase())
+	assert.True(t, product.Changes().Dirty(FieldDiscountSchedule))
+}
+
+func TestWithdrawingStartedDiscountEmitsEnd(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "Chair", "", "furniture", price, now)
+
+	first, _ := NewDiscount(big.NewRat(10, 1), now, day(5))
+	require.NoError(t, product.ApplyDiscount(first, now))
+	require.True(t, product.AdvanceDiscountLifecycle(now))
+	product.ClearEvents()
+
+	// Replacing a started discount ends it
+	second, _ := NewDiscount(big.NewRat(20, 1), day(1), day(5))
+	require.NoError(t, product.ApplyDiscount(second, day(1)))
+	assert.Equal(t, []string{"discount.ended", "discount.applied"}, eventTypes(product.DomainEvents()))
+	assert.Equal(t, "10", product.DomainEvents()[0].(DiscountEndedEvent).DiscountPercent.RatString())
+	product.ClearEvents()
+
+	// Removing a pending discount emits no end
+	require.NoError(t, product.RemoveDiscount(day(1)))
+	assert.Equal(t, []string{"discount.removed"}, eventTypes(product.DomainEvents()))
+	product.ClearEvents()
+
+	// Cancelling a started scheduled discount ends it
+	scheduled, _ := NewDiscount(big.NewRat(30, 1), day(2), day(4))
+	require.NoError(t, product.ScheduleDiscount("d-1", scheduled, day(1)))
+	require.True(t, product.AdvanceDiscountLifecycle(day(3)))
+	product.ClearEvents()
+
+	require.NoError(t, product.CancelScheduledDiscount("d-1", day(3)))
+	assert.Equal(t, []string{"discount.ended", "discount.schedule_cancelled"}, eventTypes(product.DomainEvents()))
+}
+
+func TestReconstructProductResumesDiscountPhase(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	product, err := ReconstructProduct(
+		"p-1", "Chair", "", "furniture",
+		100, 1, "USD",
+		"percentage", big.NewRat(10, 1), 0, 0,
+		now.AddDate(0, 0, -1), now.AddDate(0, 0, 1),
+		"started",
+		"active",
+		now, now, nil, 1, nil, nil, nil, nil, nil, "",
+	)
+	require.NoError(t, err)
+
+	assert.False(t, product.AdvanceDiscountLifecycle(now), "a started discount must not start again")
+	assert.True(t, product.AdvanceDiscountLifecycle(now.AddDate(0, 0, 2)))
+	assert.Equal(t, []string{"discount.ended"}, eventTypes(product.DomainEvents()))
+}
+
+func TestArchivingEndsStartedDiscounts(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "Chair", "", "furniture", price, now)
+
+	applied, _ := NewDiscount(big.NewRat(10, 1), day(1), day(3))
+	require.NoError(t, product.ApplyDiscount(applied, now))
+	require.True(t, product.AdvanceDiscountLifecycle(day(2)))
+	product.ClearEvents()
+
+	require.NoError(t, product.Archive(day(2)))
+	assert.Equal(t, []string{"discount.ended", "product.archived"}, eventTypes(product.DomainEvents()))
+	assert.Equal(t, "", product.DomainEvents()[0].(DiscountEndedEvent).DiscountID)
+	assert.Equal(t, DiscountPhaseEnded, product.DiscountPhase())
+	assert.True(t, product.Changes().Dirty(FieldDiscount))
+
+	// Scheduled windows end too, but those that never started have no end
+	product, _ = NewProduct("p-2", "Table", "", "furniture", price, now)
+	running, _ := NewDiscount(big.NewRat(20, 1), day(1), day(3))
+	require.NoError(t, product.ScheduleDiscount("d-1", running, now))
+	pending, _ := NewDiscount(big.NewRat(30, 1), day(7), day(9))
+	require.NoError(t, product.ScheduleDiscount("d-2", pending, now))
+	require.True(t, product.AdvanceDiscountLifecycle(day(2)))
+	product.ClearEvents()
+
+	require.NoError(t, product.Archive(day(2)))
+	assert.Equal(t, []string{"discount.ended", "product.archived"}, eventTypes(product.DomainEvents()))
+	assert.Equal(t, "d-1", product.DomainEvents()[0].(DiscountEndedEvent).DiscountID)
+	assert.Equal(t, DiscountPhaseEnded, product.DiscountSchedule()[0].Phase())
+	assert.Equal(t, DiscountPhasePending, product.DiscountSchedule()[1].Phase())
+	assert.True(t, product.Changes().Dirty(FieldDiscountSchedule))
+}
+
+func TestListingPriceFollowsDiscountLifecycle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "Chair", "", "furniture", price, now)
+
+	discount, _ := NewDiscount(big.NewRat(25, 1), day(1), day(2))
+	require.NoError(t, product.ApplyDiscount(discount, now))
+	assert.Equal(t, "100", product.ListingPrice().Value().RatString(), "the window has not opened yet")
+
+	require.True(t, product.AdvanceDiscountLifecycle(day(1)))
+	assert.Equal(t, "75", product.ListingPrice().Value().RatString())
+
+	require.True(t, product.AdvanceDiscountLifecycle(day(3)))
+	assert.Equal(t, "100", product.ListingPrice().Value().RatString())
+}
+
+func TestRefreshListingPriceAppliesActiveDiscount(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Stored before the discount window opened
+	product, err := ReconstructProduct(
+		"p-1", "Chair", "", "furniture",
+		100, 1, "USD",
+		"percentage", big.NewRat(25, 1), 0, 0,
+		now.AddDate(0, 0, 1), now.AddDate(0, 0, 3),
+		"pending",
+		"active",
+		now, now, nil, 1, nil, nil, nil, nil, nil, "",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "100", product.ListingPrice().Value().RatString())
+
+	product.RefreshListingPrice(now.AddDate(0, 0, 2))
+	assert.Equal(t, "75", product.ListingPrice().Value().RatString())
+	assert.True(t, product.Changes().Dirty(FieldListingPrice))
+	assert.Empty(t, product.DomainEvents(), "refreshing the listing price must not change the product")
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// ScheduledDiscount is a discount queued on a product for its validity window
+type ScheduledDiscount struct {
+	id       string
+	discount *Discount
+	phase    DiscountPhase
+}
+
+// NewScheduledDiscount creates a new ScheduledDiscount value object
+func NewScheduledDiscount(id string, discount *Discount) *ScheduledDiscount {
+	return ReconstructScheduledDiscount(id, discount, DiscountPhasePending)
+}
+
+// ReconstructScheduledDiscount reconstructs a scheduled discount from persistence
+func ReconstructScheduledDiscount(id string, discount *Discount, phase DiscountPhase) *ScheduledDiscount {
+	return &ScheduledDiscount{
+		id:       id,
+		discount: discount,
+		phase:    phase,
+	}
+}
+
+// ID returns the scheduled discount identifier
+func (s *ScheduledDiscount) ID() string { return s.id }
+
+// Discount returns the scheduled discount
+func (s *ScheduledDiscount) Discount() *Discount { return s.discount }
+
+// Overlaps checks if the validity windows of two discounts share any instant
+func (d *Discount) Overlaps(other *Discount) bool {
+	if d == nil || other == nil {
+		return false
+	}
+
+	return !d.endDate.Before(other.startDate) && !other.endDate.Before(d.startDate)
+}
+
+// ScheduleDiscount queues a discount for its validity window.
+// The window must not overlap the applied discount or another scheduled one.
+func (p *Product) ScheduleDiscount(id string, discount *Discount, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	if err := p.validateDiscount(discount, now); err != nil {
+		return err
+	}
+
+	if p.discount.Overlaps(discount) || p.overlapsSchedule(discount) {
+		return ErrDiscountOverlap
+	}
+
+	p.schedule = append(p.schedule, NewScheduledDiscount(id, discount))
+	sortSchedule(p.schedule)
+
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldDiscountSchedule)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewDiscountScheduledEvent(p.id, id, discount))
+
+	return nil
+}
+
+// CancelScheduledDiscount removes a queued discount from the schedule
+func (p *Product) CancelScheduledDiscount(id string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	for i, s := range p.schedule {
+		if s.id != id {
+			continue
+		}
+
+		p.endStarted(s.id, s.discount, s.phase)
+
+		p.schedule = append(p.schedule[:i:i], p.schedule[i+1:]...)
+
+		p.updatedAt = now
+		p.changes.MarkDirty(FieldDiscountSchedule)
+		p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+		p.recordEvent(NewDiscountScheduleCancelledEvent(p.id, id))
+
+		return nil
+	}
+
+	return ErrScheduledDiscountNotFound
+}
+
+// DiscountSchedule returns the queued discounts ordered by start date
+func (p *Product) DiscountSchedule() []*ScheduledDiscount {
+	schedule := make([]*ScheduledDiscount, len(p.schedule))
+	copy(schedule, p.schedule)
+	return schedule
+}
+
+// DiscountAt returns the applied or scheduled discount whose window contains t, if any
+func (p *Product) DiscountAt(t time.Time) *Discount {
+	if p.discount.IsActiveAt(t) {
+		return p.discount
+	}
+
+	for _, s := range p.schedule {
+		if s.discount.IsActiveAt(t) {
+			return s.discount
+		}
+	}
+
+	return nil
+}
+
+// overlapsSchedule checks if a discount's window overlaps any scheduled discount
+func (p *Product) overlapsSchedule(discount *Discount) bool {
+	for _, s := range p.schedule {
+		if s.discount.Overlaps(discount) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortSchedule orders scheduled discounts by start date
+func sortSchedule(schedule []*ScheduledDiscount) {
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].discount.StartDate().Before(schedule[j].discount.StartDate())
+	})
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleDiscountRejectsOverlappingWindows(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, err := NewProduct("p-1", "Chair", "", "furniture", price, now)
+	require.NoError(t, err)
+
+	today, _ := NewDiscount(big.NewRat(10, 1), day(0), day(2))
+	require.NoError(t, product.ApplyDiscount(today, now))
+
+	nextWeek, _ := NewDiscount(big.NewRat(30, 1), day(7), day(9))
+	require.NoError(t, product.ScheduleDiscount("d-1", nextWeek, now))
+
+	// Overlaps the applied discount
+	clash, _ := NewDiscount(big.NewRat(20, 1), day(1), day(3))
+	assert.ErrorIs(t, product.ScheduleDiscount("d-2", clash, now), ErrDiscountOverlap)
+
+	// Overlaps the scheduled discount
+	clash, _ = NewDiscount(big.NewRat(20, 1), day(9), day(10))
+	assert.ErrorIs(t, product.ScheduleDiscount("d-2", clash, now), ErrDiscountOverlap)
+
+	// Replacing the applied discount must not overlap the schedule either
+	assert.ErrorIs(t, product.ApplyDiscount(clash, now), ErrDiscountOverlap)
+
+	between, _ := NewDiscount(big.NewRat(20, 1), day(3), day(6))
+	require.NoError(t, product.ScheduleDiscount("d-3", between, now))
+
+	schedule := product.DiscountSchedule()
+	require.Len(t, schedule, 2)
+	assert.Equal(t, "d-3", schedule[0].ID())
+	assert.Equal(t, "d-1", schedule[1].ID())
+}
+
+func TestEffectivePricePicksDiscountWindowContainingNow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "Chair", "", "furniture", price, now)
+
+	today, _ := NewDiscount(big.NewRat(10, 1), day(0), day(2))
+	require.NoError(t, product.ApplyDiscount(today, now))
+	nextWeek, _ := NewDiscount(big.NewRat(30, 1), day(7), day(9))
+	require.NoError(t, product.ScheduleDiscount("d-1", nextWeek, now))
+
+	for at, want := range map[time.Time]string{
+		day(1): "90.00 USD",
+		day(5): "100.00 USD",
+		day(8): "70.00 USD",
+	} {
+		effective, err := product.EffectivePrice(at)
+		require.NoError(t, err)
+		assert.Equal(t, want, effective.String())
+	}
+
+	require.NoError(t, product.CancelScheduledDiscount("d-1", now))
+	effective, _ := product.EffectivePrice(day(8))
+	assert.Equal(t, "100.00 USD", effective.String())
+
+	assert.ErrorIs(t, product.CancelScheduledDiscount("d-1", now), ErrScheduledDiscountNotFound)
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedAmountDiscountNeverGoesBelowZero(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(20, 1, "USD")
+	five, _ := NewMoney(5, 1, "USD")
+	thirty, _ := NewMoney(30, 1, "USD")
+
+	discount, err := NewFixedAmountDiscount(five, now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	discounted, err := discount.ApplyTo(price)
+	require.NoError(t, err)
+	assert.Equal(t, "15.00 USD", discounted.String())
+
+	floored, err := price.SubtractFixedAmount(thirty)
+	require.NoError(t, err)
+	assert.Equal(t, 0, floored.Value().Sign())
+}
+
+func TestApplyDiscountRejectsFixedAmountAboveBasePrice(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(20, 1, "USD")
+	product, err := NewProduct("p-1", "Mug", "", "kitchen", price, now)
+	require.NoError(t, err)
+
+	tooMuch, _ := NewMoney(25, 1, "USD")
+	discount, _ := NewFixedAmountDiscount(tooMuch, now, now.Add(time.Hour))
+	assert.ErrorIs(t, product.ApplyDiscount(discount, now), ErrDiscountExceedsPrice)
+
+	euros, _ := NewMoney(5, 1, "EUR")
+	discount, _ = NewFixedAmountDiscount(euros, now, now.Add(time.Hour))
+	assert.ErrorIs(t, product.ApplyDiscount(discount, now), ErrCurrencyMismatch)
+
+	dollars, _ := NewMoney(5, 1, "USD")
+	discount, _ = NewFixedAmountDiscount(dollars, now, now.Add(time.Hour))
+	require.NoError(t, product.ApplyDiscount(discount, now))
+
+	effective, err := product.EffectivePrice(now)
+	require.NoError(t, err)
+	assert.Equal(t, "15.00 USD", effective.String())
+}
+
+func TestChangePriceRejectsPriceBelowFixedAmountDiscount(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(20, 1, "USD")
+	product, err := NewProduct("p-1", "Mug", "", "kitchen", price, now)
+	require.NoError(t, err)
+
+	eight, _ := NewMoney(8, 1, "USD")
+	discount, _ := NewFixedAmountDiscount(eight, now, now.Add(time.Hour))
+	require.NoError(t, product.ApplyDiscount(discount, now))
+
+	five, _ := NewMoney(5, 1, "USD")
+	assert.ErrorIs(t, product.ChangePrice(five, "manual", now), ErrDiscountExceedsPrice)
+	assert.Equal(t, "20.00 USD", product.BasePrice().String())
+
+	ten, _ := NewMoney(10, 1, "USD")
+	require.NoError(t, product.ChangePrice(ten, "manual", now))
+
+	// Scheduled fixed-amount discounts must fit too
+	product, _ = NewProduct("p-2", "Mug", "", "kitchen", price, now)
+	later, _ := NewFixedAmountDiscount(eight, now.Add(24*time.Hour), now.Add(48*time.Hour))
+	require.NoError(t, product.ScheduleDiscount("s-1", later, now))
+	assert.ErrorIs(t, product.ChangePrice(five, "manual", now), ErrDiscountExceedsPrice)
+}
+
+func TestReconstructDiscountDefaultsToPercentage(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	discount, err := ReconstructDiscount("", big.NewRat(20, 1), 0, 0, "USD", now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, DiscountKindPercentage, discount.Kind())
+	assert.Equal(t, "20", discount.Percentage().RatString())
+
+	_, err = ReconstructDiscount("bogo", nil, 0, 0, "USD", now, now.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrUnsupportedDiscountKind)
+}
+
+func TestFractionalPercentageDiscount(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(100, 1, "USD")
+
+	third, err := ParsePercentage("33.333333333")
+	require.NoError(t, err)
+	discount, err := NewDiscount(third, now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	discounted, err := discount.ApplyTo(price)
+	require.NoError(t, err)
+	assert.Equal(t, "66.666666667", discounted.Value().FloatString(9))
+
+	// NUMERIC columns keep nine decimal places, so repeating decimals would be rounded
+	_, err = ParsePercentage("100/3")
+	assert.ErrorIs(t, err, ErrPercentageTooPrecise)
+	_, err = ParsePercentage("0.0000000001")
+	assert.ErrorIs(t, err, ErrPercentageTooPrecise)
+
+	half, err := ParsePercentage("25/2")
+	require.NoError(t, err)
+	assert.Equal(t, "25/2", half.RatString())
+
+	eighth, err := ParsePercentage("12.5")
+	require.NoError(t, err)
+	discounted, err = price.ApplyPercentage(eighth)
+	require.NoError(t, err)
+	assert.Equal(t, "175/2", discounted.Value().RatString())
+
+	_, err = NewDiscount(big.NewRat(201, 2), now, now.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrDiscountOutOfRange)
+
+	_, err = ParsePercentage("twelve")
+	assert.ErrorIs(t, err, ErrInvalidDiscountPercent)
+}
+
+func TestFormatPercentage(t *testing.T) {
+	assert.Equal(t, "20", FormatPercentage(big.NewRat(20, 1)))
+	assert.Equal(t, "12.5", FormatPercentage(big.NewRat(25, 2)))
+	assert.Equal(t, "33.333333333", FormatPercentage(big.NewRat(100, 3)))
+	assert.Equal(t, "0.125", FormatPercentage(big.NewRat(1, 8)))
+}
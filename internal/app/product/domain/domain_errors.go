@@ -8,16 +8,95 @@ var (
 	ErrProductNotActive     = errors.New("product is not active")
 	ErrProductAlreadyActive = errors.New("product is already active")
 	ErrProductIsArchived    = errors.New("product is archived")
+	ErrInvalidProductStatus = errors.New("product status must be active, inactive or archived")
+	ErrStoredStateExpired   = errors.New("stored state is not retained that far back")
 
 	// Discount errors
-	ErrInvalidDiscountPeriod = errors.New("discount period is invalid")
-	ErrDiscountOutOfRange    = errors.New("discount must be between 0 and 100")
-	ErrNoActiveDiscount      = errors.New("no active discount to remove")
+	ErrInvalidDiscountPeriod     = errors.New("discount period is invalid")
+	ErrDiscountOutOfRange        = errors.New("discount must be between 0 and 100")
+	ErrNoActiveDiscount          = errors.New("no active discount to remove")
+	ErrUnsupportedDiscountKind   = errors.New("discount kind is not supported")
+	ErrInvalidDiscountPercent    = errors.New("discount percentage is not a valid number")
+	ErrPercentageTooPrecise      = errors.New("percentage must have at most 9 decimal places")
+	ErrInvalidDiscountAmount     = errors.New("discount amount must be positive")
+	ErrDiscountExceedsPrice      = errors.New("discount amount exceeds the base price")
+	ErrDiscountOverlap           = errors.New("discount overlaps another discount")
+	ErrScheduledDiscountNotFound = errors.New("scheduled discount not found")
+	ErrUnsupportedDiscountPhase  = errors.New("discount phase is not supported")
+
+	// Variant errors
+	ErrVariantNotFound = errors.New("variant not found")
+	ErrVariantRetired  = errors.New("variant is retired")
+	ErrInvalidSKU      = errors.New("sku cannot be empty")
+	ErrDuplicateSKU    = errors.New("sku is already used by another variant")
+
+	// Bundle errors
+	ErrInvalidBundle      = errors.New("bundle must list distinct components and at most one pricing rule")
+	ErrNestedBundle       = errors.New("bundle components must be standard products")
+	ErrNotABundle         = errors.New("product is not a bundle")
+	ErrBundlePriceDerived = errors.New("bundle price is derived from its components")
+	ErrBundleVariants     = errors.New("bundles cannot have variants")
+	ErrBundleUnavailable  = errors.New("bundle has a component that is not for sale")
+
+	// Price tier errors
+	ErrInvalidPriceTier       = errors.New("price tier minimum quantity must be at least 2")
+	ErrPriceTiersNotMonotonic = errors.New("price tiers must ascend by quantity without raising the unit price")
+
+	// Price list errors
+	ErrPriceListNotFound      = errors.New("price list not found")
+	ErrInvalidPriceList       = errors.New("price list needs a name and a channel or segment")
+	ErrPriceListEntryNotFound = errors.New("product has no price in the price list")
+
+	// Tax errors
+	ErrInvalidTaxClass = errors.New("tax class must be a lower-case slug of at most 50 characters")
+	ErrInvalidRegion   = errors.New("region must be an ISO 3166 country code with an optional subdivision")
+	ErrInvalidTaxRate  = errors.New("tax rate must be between 0 and 100 percent")
+	ErrTaxRateNotFound = errors.New("no tax rate for the region and tax class")
+
+	// Quote errors
+	ErrInvalidQuote = errors.New("quote must have between 1 and 100 lines")
+
+	// Listing errors
+	ErrInvalidPageToken     = errors.New("page token is invalid or was issued for other filters")
+	ErrUnsupportedSortOrder = errors.New("unsupported sort order")
+	ErrInvalidPriceRange    = errors.New("price range bounds must share a currency and min must not exceed max")
+	ErrInvalidPriceBands    = errors.New("price band bounds must share a currency and strictly ascend")
+	ErrInvalidSearchQuery   = errors.New("search query must contain between 1 and 16 words")
+
+	// Category errors
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryArchived      = errors.New("category is archived")
+	ErrInvalidCategoryName   = errors.New("category name is invalid")
+	ErrDuplicateCategoryName = errors.New("category name is already used by a sibling")
+	ErrCategoryCycle         = errors.New("category cannot be moved into its own subtree")
+	ErrCategoryHasChildren   = errors.New("category has active subcategories")
+
+	// Stock errors
+	ErrStockNotFound          = errors.New("stock not found")
+	ErrInvalidQuantity        = errors.New("quantity must be positive and within the maximum")
+	ErrInsufficientStock      = errors.New("insufficient stock available")
+	ErrReleaseExceedsReserved = errors.New("release exceeds reserved quantity")
+	ErrInvalidThreshold       = errors.New("low stock threshold cannot be negative")
+
+	// Attribute errors
+	ErrUnsupportedAttributeType    = errors.New("attribute type is not supported")
+	ErrInvalidAttributeDefinition  = errors.New("attribute definition is invalid")
+	ErrAttributeDefinitionNotFound = errors.New("attribute definition not found")
+	ErrAttributeSchemaMismatch     = errors.New("attribute schema does not match the product's category")
+	ErrUnknownAttribute            = errors.New("attribute is not defined for the category")
+	ErrInvalidAttributeValue       = errors.New("attribute value does not match its definition")
+	ErrMissingRequiredAttribute    = errors.New("required attribute is missing")
+
+	// Currency errors
+	ErrUnsupportedCurrency     = errors.New("currency is not supported")
+	ErrCurrencyMismatch        = errors.New("money amounts have different currencies")
+	ErrUnsupportedRoundingMode = errors.New("rounding mode is not supported")
+	ErrAmountOverflow          = errors.New("amount does not fit a 64-bit numerator and denominator")
 
 	// Validation errors
-	ErrInvalidName        = errors.New("name cannot be empty")
-	ErrInvalidCategory    = errors.New("category cannot be empty")
-	ErrInvalidPrice       = errors.New("price must be positive")
-	ErrInvalidDateRange   = errors.New("end date must be after start date")
+	ErrInvalidName            = errors.New("name cannot be empty")
+	ErrInvalidCategory        = errors.New("category cannot be empty")
+	ErrInvalidPrice           = errors.New("price must be positive")
+	ErrInvalidDateRange       = errors.New("end date must be after start date")
 	ErrConcurrentModification = errors.New("product was modified by another transaction")
 )
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 // DomainEvent represents a domain event
 type DomainEvent interface {
@@ -24,26 +27,28 @@ func NewBaseEvent(aggregateID, eventType string) BaseEvent {
 	}
 }
 
-func (e BaseEvent) AggregateID() string { return e.aggregateID }
-func (e BaseEvent) EventType() string   { return e.eventType }
+func (e BaseEvent) AggregateID() string   { return e.aggregateID }
+func (e BaseEvent) EventType() string     { return e.eventType }
 func (e BaseEvent) OccurredAt() time.Time { return e.occurredAt }
 
 // ProductCreatedEvent is emitted when a new product is created
 type ProductCreatedEvent struct {
 	BaseEvent
-	Name        string
-	Category    string
+	Name                 string
+	Category             string
 	BasePriceNumerator   int64
 	BasePriceDenominator int64
+	Currency             string
 }
 
-func NewProductCreatedEvent(aggregateID, name, category string, numerator, denominator int64) ProductCreatedEvent {
+func NewProductCreatedEvent(aggregateID, name, category string, numerator, denominator int64, currency string) ProductCreatedEvent {
 	return ProductCreatedEvent{
 		BaseEvent:            NewBaseEvent(aggregateID, "product.created"),
 		Name:                 name,
 		Category:             category,
 		BasePriceNumerator:   numerator,
 		BasePriceDenominator: denominator,
+		Currency:             currency,
 	}
 }
 
@@ -91,21 +96,260 @@ func NewProductArchivedEvent(aggregateID string) ProductArchivedEvent {
 	}
 }
 
+// ProductPriceChangedEvent is emitted when a product's base price changes
+type ProductPriceChangedEvent struct {
+	BaseEvent
+	OldPriceNumerator   int64
+	OldPriceDenominator int64
+	NewPriceNumerator   int64
+	NewPriceDenominator int64
+	Currency            string
+	ReasonCode          string
+}
+
+func NewProductPriceChangedEvent(aggregateID string, oldPrice, newPrice *Money, reasonCode string) ProductPriceChangedEvent {
+	return ProductPriceChangedEvent{
+		BaseEvent:           NewBaseEvent(aggregateID, "product.price_changed"),
+		OldPriceNumerator:   oldPrice.Numerator(),
+		OldPriceDenominator: oldPrice.Denominator(),
+		NewPriceNumerator:   newPrice.Numerator(),
+		NewPriceDenominator: newPrice.Denominator(),
+		Currency:            newPrice.Currency().Code(),
+		ReasonCode:          reasonCode,
+	}
+}
+
 // DiscountAppliedEvent is emitted when a discount is applied to a product
 type DiscountAppliedEvent struct {
 	BaseEvent
-	DiscountPercent int64
-	StartDate       int64
-	EndDate         int64
+	DiscountKind      string
+	DiscountPercent   *big.Rat // Set only for percentage discounts
+	AmountNumerator   int64    // Set only for fixed-amount discounts
+	AmountDenominator int64
+	Currency          string
+	StartDate         int64
+	EndDate           int64
 }
 
-func NewDiscountAppliedEvent(aggregateID string, discountPercent int64, startDate, endDate int64) DiscountAppliedEvent {
-	return DiscountAppliedEvent{
+func NewDiscountAppliedEvent(aggregateID string, discount *Discount) DiscountAppliedEvent {
+	event := DiscountAppliedEvent{
 		BaseEvent:       NewBaseEvent(aggregateID, "discount.applied"),
-		DiscountPercent: discountPercent,
-		StartDate:       startDate,
-		EndDate:         endDate,
+		DiscountKind:    string(discount.Kind()),
+		DiscountPercent: discount.Percentage(),
+		StartDate:       discount.StartDate().Unix(),
+		EndDate:         discount.EndDate().Unix(),
+	}
+
+	if amount := discount.Amount(); amount != nil {
+		event.AmountNumerator = amount.Numerator()
+		event.AmountDenominator = amount.Denominator()
+		event.Currency = amount.Currency().Code()
+	}
+
+	return event
+}
+
+// DiscountScheduledEvent is emitted when a discount is queued on a product
+type DiscountScheduledEvent struct {
+	DiscountAppliedEvent
+	DiscountID string
+}
+
+func NewDiscountScheduledEvent(aggregateID, discountID string, discount *Discount) DiscountScheduledEvent {
+	event := DiscountScheduledEvent{
+		DiscountAppliedEvent: NewDiscountAppliedEvent(aggregateID, discount),
+		DiscountID:           discountID,
+	}
+	event.BaseEvent = NewBaseEvent(aggregateID, "discount.scheduled")
+	return event
+}
+
+// DiscountScheduleCancelledEvent is emitted when a queued discount is cancelled
+type DiscountScheduleCancelledEvent struct {
+	BaseEvent
+	DiscountID string
+}
+
+func NewDiscountScheduleCancelledEvent(aggregateID, discountID string) DiscountScheduleCancelledEvent {
+	return DiscountScheduleCancelledEvent{
+		BaseEvent:  NewBaseEvent(aggregateID, "discount.schedule_cancelled"),
+		DiscountID: discountID,
+	}
+}
+
+// DiscountStartedEvent is emitted when the window of an applied or scheduled
+// discount opens. DiscountID is empty for the discount applied on the product.
+type DiscountStartedEvent struct {
+	DiscountAppliedEvent
+	DiscountID string
+}
+
+func NewDiscountStartedEvent(aggregateID, discountID string, discount *Discount) DiscountStartedEvent {
+	event := DiscountStartedEvent{
+		DiscountAppliedEvent: NewDiscountAppliedEvent(aggregateID, discount),
+		DiscountID:           discountID,
+	}
+	event.BaseEvent = NewBaseEvent(aggregateID, "discount.started")
+	return event
+}
+
+// DiscountEndedEvent is emitted when the window of an applied or scheduled
+// discount closes. DiscountID is empty for the discount applied on the product.
+type DiscountEndedEvent struct {
+	DiscountAppliedEvent
+	DiscountID string
+}
+
+func NewDiscountEndedEvent(aggregateID, discountID string, discount *Discount) DiscountEndedEvent {
+	event := DiscountEndedEvent{
+		DiscountAppliedEvent: NewDiscountAppliedEvent(aggregateID, discount),
+		DiscountID:           discountID,
+	}
+	event.BaseEvent = NewBaseEvent(aggregateID, "discount.ended")
+	return event
+}
+
+// ProductAttributesChangedEvent is emitted when a product's attribute values change
+type ProductAttributesChangedEvent struct {
+	BaseEvent
+	Attributes map[string]string // All attribute values after the change
+}
+
+func NewProductAttributesChangedEvent(aggregateID string, attributes map[string]AttributeValue) ProductAttributesChangedEvent {
+	values := make(map[string]string, len(attributes))
+	for name, value := range attributes {
+		values[name] = value.String()
+	}
+
+	return ProductAttributesChangedEvent{
+		BaseEvent:  NewBaseEvent(aggregateID, "product.attributes_changed"),
+		Attributes: values,
+	}
+}
+
+// VariantAddedEvent is emitted when a variant is added to a product
+type VariantAddedEvent struct {
+	BaseEvent
+	VariantID                string
+	SKU                      string
+	Options                  map[string]string
+	PriceOverrideNumerator   int64 // Zero if the variant inherits the base price
+	PriceOverrideDenominator int64
+	Currency                 string
+}
+
+func NewVariantAddedEvent(aggregateID string, variant *Variant) VariantAddedEvent {
+	event := VariantAddedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "variant.added"),
+		VariantID: variant.ID(),
+		SKU:       variant.SKU(),
+		Options:   variant.Options(),
+	}
+
+	if price := variant.PriceOverride(); price != nil {
+		event.PriceOverrideNumerator = price.Numerator()
+		event.PriceOverrideDenominator = price.Denominator()
+		event.Currency = price.Currency().Code()
+	}
+
+	return event
+}
+
+// VariantUpdatedEvent is emitted when a variant's SKU, options or price override change
+type VariantUpdatedEvent struct {
+	VariantAddedEvent
+}
+
+func NewVariantUpdatedEvent(aggregateID string, variant *Variant) VariantUpdatedEvent {
+	event := VariantUpdatedEvent{
+		VariantAddedEvent: NewVariantAddedEvent(aggregateID, variant),
+	}
+	event.BaseEvent = NewBaseEvent(aggregateID, "variant.updated")
+	return event
+}
+
+// VariantRetiredEvent is emitted when a variant is retired
+type VariantRetiredEvent struct {
+	BaseEvent
+	VariantID string
+}
+
+func NewVariantRetiredEvent(aggregateID, variantID string) VariantRetiredEvent {
+	return VariantRetiredEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "variant.retired"),
+		VariantID: variantID,
+	}
+}
+
+// ProductBundleChangedEvent is emitted when a bundle product is created or its
+// components or pricing rule change
+type ProductBundleChangedEvent struct {
+	BaseEvent
+	Components      map[string]int64 // Quantity by component product ID
+	DiscountPercent string           // Empty unless the bundle has a percentage discount
+
+	// Zero unless the bundle has a fixed-amount discount or price override
+	DiscountAmountNumerator   int64
+	DiscountAmountDenominator int64
+	PriceOverrideNumerator    int64
+	PriceOverrideDenominator  int64
+}
+
+func NewProductBundleChangedEvent(aggregateID string, bundle *Bundle) ProductBundleChangedEvent {
+	event := ProductBundleChangedEvent{
+		BaseEvent:  NewBaseEvent(aggregateID, "product.bundle_changed"),
+		Components: make(map[string]int64, len(bundle.components)),
+	}
+
+	for _, c := range bundle.components {
+		event.Components[c.productID] = c.quantity
+	}
+
+	if percent := bundle.DiscountPercent(); percent != nil {
+		event.DiscountPercent = FormatPercentage(percent)
+	}
+	if amount := bundle.DiscountAmount(); amount != nil {
+		event.DiscountAmountNumerator = amount.Numerator()
+		event.DiscountAmountDenominator = amount.Denominator()
+	}
+	if override := bundle.PriceOverride(); override != nil {
+		event.PriceOverrideNumerator = override.Numerator()
+		event.PriceOverrideDenominator = override.Denominator()
+	}
+
+	return event
+}
+
+// PriceTierValues is a price tier as carried by events
+type PriceTierValues struct {
+	MinQuantity      int64
+	PriceNumerator   int64
+	PriceDenominator int64
+}
+
+// ProductPriceTiersChangedEvent is emitted when a product's price tiers are replaced
+type ProductPriceTiersChangedEvent struct {
+	BaseEvent
+	Tiers    []PriceTierValues // Ordered by minimum quantity, empty if the tiers were cleared
+	Currency string
+}
+
+func NewProductPriceTiersChangedEvent(aggregateID, currency string, tiers []PriceTier) ProductPriceTiersChangedEvent {
+	event := ProductPriceTiersChangedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "product.price_tiers_changed"),
+		Tiers:     make([]PriceTierValues, 0, len(tiers)),
+		Currency:  currency,
+	}
+
+	for _, t := range tiers {
+		event.Tiers = append(event.Tiers, PriceTierValues{
+			MinQuantity:      t.minQuantity,
+			PriceNumerator:   t.price.Numerator(),
+			PriceDenominator: t.price.Denominator(),
+		})
 	}
+
+	return event
 }
 
 // DiscountRemovedEvent is emitted when a discount is removed from a product
@@ -118,3 +362,162 @@ func NewDiscountRemovedEvent(aggregateID string) DiscountRemovedEvent {
 		BaseEvent: NewBaseEvent(aggregateID, "discount.removed"),
 	}
 }
+
+// CategoryCreatedEvent is emitted when a new category is created
+type CategoryCreatedEvent struct {
+	BaseEvent
+	Name     string
+	ParentID string // Empty for root categories
+}
+
+func NewCategoryCreatedEvent(aggregateID, name, parentID string) CategoryCreatedEvent {
+	return CategoryCreatedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "category.created"),
+		Name:      name,
+		ParentID:  parentID,
+	}
+}
+
+// CategoryRenamedEvent is emitted when a category's name changes
+type CategoryRenamedEvent struct {
+	BaseEvent
+	Name string
+}
+
+func NewCategoryRenamedEvent(aggregateID, name string) CategoryRenamedEvent {
+	return CategoryRenamedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "category.renamed"),
+		Name:      name,
+	}
+}
+
+// CategoryMovedEvent is emitted when a category moves to another parent
+type CategoryMovedEvent struct {
+	BaseEvent
+	OldParentID string
+	ParentID    string // Empty when moved to the root
+}
+
+func NewCategoryMovedEvent(aggregateID, oldParentID, parentID string) CategoryMovedEvent {
+	return CategoryMovedEvent{
+		BaseEvent:   NewBaseEvent(aggregateID, "category.moved"),
+		OldParentID: oldParentID,
+		ParentID:    parentID,
+	}
+}
+
+// CategoryArchivedEvent is emitted when a category is archived
+type CategoryArchivedEvent struct {
+	BaseEvent
+}
+
+func NewCategoryArchivedEvent(aggregateID string) CategoryArchivedEvent {
+	return CategoryArchivedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "category.archived"),
+	}
+}
+
+// StockChangedEvent is emitted when a product's on-hand or reserved quantity changes
+type StockChangedEvent struct {
+	BaseEvent
+	Change     string // "reserved", "released" or "adjusted"
+	Quantity   int64  // Reserved or released quantity, or the signed on-hand adjustment
+	OnHand     int64
+	Reserved   int64
+	ReasonCode string // Set only for adjustments
+}
+
+func NewStockChangedEvent(aggregateID string, change StockChange, quantity, onHand, reserved int64, reasonCode string) StockChangedEvent {
+	return StockChangedEvent{
+		BaseEvent:  NewBaseEvent(aggregateID, "product.stock_changed"),
+		Change:     string(change),
+		Quantity:   quantity,
+		OnHand:     onHand,
+		Reserved:   reserved,
+		ReasonCode: reasonCode,
+	}
+}
+
+// StockThresholdChangedEvent is emitted when a product's low stock threshold changes
+type StockThresholdChangedEvent struct {
+	BaseEvent
+	LowStockThreshold int64
+}
+
+func NewStockThresholdChangedEvent(aggregateID string, threshold int64) StockThresholdChangedEvent {
+	return StockThresholdChangedEvent{
+		BaseEvent:         NewBaseEvent(aggregateID, "product.stock_threshold_changed"),
+		LowStockThreshold: threshold,
+	}
+}
+
+// PriceListCreatedEvent is emitted when a new price list is created
+type PriceListCreatedEvent struct {
+	BaseEvent
+	Name      string
+	Currency  string
+	Channel   string
+	Segment   string
+	ValidFrom int64
+	ValidTo   int64 // Zero for open-ended lists
+}
+
+func NewPriceListCreatedEvent(list *PriceList) PriceListCreatedEvent {
+	event := PriceListCreatedEvent{
+		BaseEvent: NewBaseEvent(list.id, "price_list.created"),
+		Name:      list.name,
+		Currency:  list.currency.Code(),
+		Channel:   list.channel,
+		Segment:   list.segment,
+		ValidFrom: list.validFrom.Unix(),
+	}
+	if list.validTo != nil {
+		event.ValidTo = list.validTo.Unix()
+	}
+	return event
+}
+
+// PriceListPriceSetEvent is emitted when a product's price in a price list is set
+type PriceListPriceSetEvent struct {
+	BaseEvent
+	ProductID        string
+	PriceNumerator   int64
+	PriceDenominator int64
+	Currency         string
+}
+
+func NewPriceListPriceSetEvent(aggregateID, productID string, price *Money) PriceListPriceSetEvent {
+	return PriceListPriceSetEvent{
+		BaseEvent:        NewBaseEvent(aggregateID, "price_list.price_set"),
+		ProductID:        productID,
+		PriceNumerator:   price.Numerator(),
+		PriceDenominator: price.Denominator(),
+		Currency:         price.Currency().Code(),
+	}
+}
+
+// PriceListPriceRemovedEvent is emitted when a product's price is removed from a price list
+type PriceListPriceRemovedEvent struct {
+	BaseEvent
+	ProductID string
+}
+
+func NewPriceListPriceRemovedEvent(aggregateID, productID string) PriceListPriceRemovedEvent {
+	return PriceListPriceRemovedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "price_list.price_removed"),
+		ProductID: productID,
+	}
+}
+
+// ProductTaxClassChangedEvent is emitted when a product's tax class changes
+type ProductTaxClassChangedEvent struct {
+	BaseEvent
+	TaxClass string
+}
+
+func NewProductTaxClassChangedEvent(aggregateID, taxClass string) ProductTaxClassChangedEvent {
+	return ProductTaxClassChangedEvent{
+		BaseEvent: NewBaseEvent(aggregateID, "product.tax_class_changed"),
+		TaxClass:  taxClass,
+	}
+}
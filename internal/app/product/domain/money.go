@@ -4,23 +4,44 @@ import (
 	"math/big"
 )
 
-// Money represents a monetary value using rational numbers for precise calculations
+// Money represents a monetary value in a currency using rational numbers for precise calculations
 type Money struct {
-	value *big.Rat
+	value    *big.Rat
+	currency Currency
 }
 
-// NewMoney creates a new Money value from numerator and denominator
-func NewMoney(numerator, denominator int64) (*Money, error) {
+// NewMoney creates a new Money value from numerator, denominator and ISO-4217 currency code
+func NewMoney(numerator, denominator int64, currencyCode string) (*Money, error) {
 	if denominator == 0 {
 		return nil, ErrInvalidPrice
 	}
 
+	currency, err := LookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
 	rat := big.NewRat(numerator, denominator)
 	if rat.Sign() <= 0 {
 		return nil, ErrInvalidPrice
 	}
 
-	return &Money{value: rat}, nil
+	return &Money{value: rat, currency: currency}, nil
+}
+
+// NewMoneyFromRat creates a Money value from an exact amount. Unlike NewMoney it
+// accepts zero, as discounted prices may be free.
+func NewMoneyFromRat(value *big.Rat, currencyCode string) (*Money, error) {
+	if value == nil || value.Sign() < 0 {
+		return nil, ErrInvalidPrice
+	}
+
+	currency, err := LookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{value: new(big.Rat).Set(value), currency: currency}, nil
 }
 
 // Value returns the underlying big.Rat value
@@ -31,7 +52,16 @@ func (m *Money) Value() *big.Rat {
 	return m.value
 }
 
-// Numerator returns the numerator of the rational number
+// Currency returns the currency of the amount
+func (m *Money) Currency() Currency {
+	if m == nil {
+		return Currency{}
+	}
+	return m.currency
+}
+
+// Numerator returns the numerator of the rational number. It is truncated if
+// it does not fit an int64; see CheckFractions.
 func (m *Money) Numerator() int64 {
 	if m == nil {
 		return 0
@@ -39,7 +69,8 @@ func (m *Money) Numerator() int64 {
 	return m.value.Num().Int64()
 }
 
-// Denominator returns the denominator of the rational number
+// Denominator returns the denominator of the rational number. It is truncated
+// if it does not fit an int64; see CheckFractions.
 func (m *Money) Denominator() int64 {
 	if m == nil {
 		return 0
@@ -47,24 +78,56 @@ func (m *Money) Denominator() int64 {
 	return m.value.Denom().Int64()
 }
 
-// ApplyPercentage applies a percentage discount and returns the discounted amount
-// For example, applying 20% to $100 returns $80
-func (m *Money) ApplyPercentage(percentage int64) (*Money, error) {
+// CheckFractions returns ErrAmountOverflow if the numerator or denominator of
+// any amount does not fit an int64, e.g. for the total of a large cart, so
+// that computed amounts are checked before they are returned as fractions.
+// Nil amounts are skipped.
+func CheckFractions(amounts ...*Money) error {
+	for _, m := range amounts {
+		if m != nil && (!m.value.Num().IsInt64() || !m.value.Denom().IsInt64()) {
+			return ErrAmountOverflow
+		}
+	}
+	return nil
+}
+
+// ApplyPercentage applies an exact percentage discount and returns the discounted amount
+// For example, applying 20% to $100 returns $80 and 12.5% returns $87.50
+func (m *Money) ApplyPercentage(percentage *big.Rat) (*Money, error) {
 	if m == nil {
 		return nil, ErrInvalidPrice
 	}
 
-	if percentage < 0 || percentage > 100 {
+	if percentage == nil || percentage.Sign() < 0 || percentage.Cmp(big.NewRat(100, 1)) > 0 {
 		return nil, ErrDiscountOutOfRange
 	}
 
 	// Calculate discount amount: price * (percentage / 100)
-	discount := new(big.Rat).Mul(m.value, big.NewRat(percentage, 100))
+	discount := new(big.Rat).Mul(m.value, new(big.Rat).Quo(percentage, big.NewRat(100, 1)))
 
 	// Subtract discount from original price
 	finalPrice := new(big.Rat).Sub(m.value, discount)
 
-	return &Money{value: finalPrice}, nil
+	return &Money{value: finalPrice, currency: m.currency}, nil
+}
+
+// SubtractFixedAmount subtracts a fixed discount and returns the discounted amount,
+// never going below zero. For example, taking $5 off $20 returns $15.
+func (m *Money) SubtractFixedAmount(amount *Money) (*Money, error) {
+	if m == nil || amount == nil {
+		return nil, ErrInvalidPrice
+	}
+
+	if m.currency != amount.currency {
+		return nil, ErrCurrencyMismatch
+	}
+
+	finalPrice := new(big.Rat).Sub(m.value, amount.value)
+	if finalPrice.Sign() < 0 {
+		finalPrice.SetInt64(0)
+	}
+
+	return &Money{value: finalPrice, currency: m.currency}, nil
 }
 
 // Add adds another Money value to this one
@@ -73,19 +136,46 @@ func (m *Money) Add(other *Money) (*Money, error) {
 		return nil, ErrInvalidPrice
 	}
 
+	if m.currency != other.currency {
+		return nil, ErrCurrencyMismatch
+	}
+
 	sum := new(big.Rat).Add(m.value, other.value)
-	return &Money{value: sum}, nil
+	return &Money{value: sum, currency: m.currency}, nil
 }
 
-// Equals checks if two Money values are equal
+// Multiply returns the amount multiplied by a positive quantity
+func (m *Money) Multiply(quantity int64) (*Money, error) {
+	if m == nil {
+		return nil, ErrInvalidPrice
+	}
+
+	if quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	product := new(big.Rat).Mul(m.value, big.NewRat(quantity, 1))
+	return &Money{value: product, currency: m.currency}, nil
+}
+
+// Equals checks if two Money values have the same amount and currency
 func (m *Money) Equals(other *Money) bool {
 	if m == nil || other == nil {
 		return m == nil && other == nil
 	}
-	return m.value.Cmp(other.value) == 0
+	return m.currency == other.currency && m.value.Cmp(other.value) == 0
+}
+
+// SameCurrency checks if two Money values share a currency
+func (m *Money) SameCurrency(other *Money) bool {
+	if m == nil || other == nil {
+		return false
+	}
+	return m.currency == other.currency
 }
 
-// GreaterThan checks if this Money is greater than other
+// GreaterThan checks if this Money is greater than other.
+// Amounts in different currencies are not comparable and never greater.
 func (m *Money) GreaterThan(other *Money) bool {
 	if m == nil {
 		return false
@@ -93,19 +183,32 @@ func (m *Money) GreaterThan(other *Money) bool {
 	if other == nil {
 		return true
 	}
+	if m.currency != other.currency {
+		return false
+	}
 	return m.value.Cmp(other.value) > 0
 }
 
-// String returns the decimal representation
-func (m *Money) String() string {
+// Round rounds the amount to the currency's minor units, e.g. 1/3 USD to 0.33
+func (m *Money) Round(mode RoundingMode) *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{value: roundRat(m.value, m.currency.MinorUnits(), mode), currency: m.currency}
+}
+
+// Format renders the amount as a decimal string in the currency's minor units
+func (m *Money) Format(mode RoundingMode) string {
 	if m == nil {
 		return "0"
 	}
-	f, _ := m.value.Float64()
-	return formatMoney(f)
+	return formatRat(m.value, m.currency.MinorUnits(), mode)
 }
 
-func formatMoney(f float64) string {
-	// Simple formatting - in production you'd want proper decimal formatting
-	return string(rune(f))
+// String returns the decimal representation with the currency code, e.g. "19.99 USD"
+func (m *Money) String() string {
+	if m == nil {
+		return "0"
+	}
+	return m.Format(DefaultRoundingMode) + " " + m.currency.Code()
 }
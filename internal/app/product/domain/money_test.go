@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMoneyValidatesCurrency(t *testing.T) {
+	m, err := NewMoney(1999, 100, "eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", m.Currency().Code())
+	assert.Equal(t, 2, m.Currency().MinorUnits())
+
+	_, err = NewMoney(1999, 100, "XXX")
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestMoneyAddRejectsMismatchedCurrencies(t *testing.T) {
+	eur, _ := NewMoney(10, 1, "EUR")
+	gbp, _ := NewMoney(10, 1, "GBP")
+
+	_, err := eur.Add(gbp)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	sum, err := eur.Add(eur)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), sum.Numerator())
+	assert.Equal(t, "EUR", sum.Currency().Code())
+}
+
+func TestMoneyEqualsComparesCurrency(t *testing.T) {
+	eur, _ := NewMoney(10, 1, "EUR")
+	gbp, _ := NewMoney(10, 1, "GBP")
+
+	assert.False(t, eur.Equals(gbp))
+	assert.False(t, eur.GreaterThan(gbp))
+}
+
+func TestFormatAmountRoundsToMinorUnits(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   int64
+		denominator int64
+		currency    string
+		mode        RoundingMode
+		want        string
+	}{
+		{"exact", 1999, 100, "USD", RoundHalfEven, "19.99"},
+		{"repeating fraction", 1, 3, "USD", RoundHalfEven, "0.33"},
+		{"half even tie down", 125, 1000, "USD", RoundHalfEven, "0.12"},
+		{"half even tie up", 135, 1000, "USD", RoundHalfEven, "0.14"},
+		{"half up tie", 125, 1000, "USD", RoundHalfUp, "0.13"},
+		{"floor", 2, 3, "USD", RoundFloor, "0.66"},
+		{"negative half up", -125, 1000, "USD", RoundHalfUp, "-0.13"},
+		{"zero minor units", 2999, 10, "JPY", RoundHalfEven, "300"},
+		{"three minor units", 1, 8, "KWD", RoundHalfEven, "0.125"},
+		{"leading zeros", 5, 1000, "EUR", RoundHalfUp, "0.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatAmount(tt.numerator, tt.denominator, tt.currency, tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoneyStringUsesCurrencyMinorUnits(t *testing.T) {
+	m, err := NewMoney(10, 3, "USD")
+	require.NoError(t, err)
+	assert.Equal(t, "3.33 USD", m.String())
+
+	rounded := m.Round(RoundHalfEven)
+	assert.Equal(t, int64(333), rounded.Numerator())
+	assert.Equal(t, int64(100), rounded.Denominator())
+}
+
+func TestCheckFractionsRejectsAmountsBeyondInt64(t *testing.T) {
+	m, err := NewMoney(1<<62, 1, "USD")
+	require.NoError(t, err)
+	require.NoError(t, CheckFractions(m, nil))
+
+	doubled, err := m.Multiply(2)
+	require.NoError(t, err)
+	assert.ErrorIs(t, CheckFractions(m, doubled), ErrAmountOverflow)
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	mode, err := ParseRoundingMode("HALF_UP")
+	require.NoError(t, err)
+	assert.Equal(t, RoundHalfUp, mode)
+
+	_, err = ParseRoundingMode("ceiling")
+	assert.ErrorIs(t, err, ErrUnsupportedRoundingMode)
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+)
+
+// PriceSnapshot records a product's pricing state from a point in time
+// until the next snapshot: its base price, applied discount and the windows
+// of its scheduled discounts
+type PriceSnapshot struct {
+	effectiveFrom time.Time
+	basePrice     *Money
+	discount      *Discount
+	schedule      []*Discount
+}
+
+// NewPriceSnapshot creates a new PriceSnapshot value object
+func NewPriceSnapshot(effectiveFrom time.Time, basePrice *Money, discount *Discount, schedule []*Discount) (*PriceSnapshot, error) {
+	if basePrice == nil {
+		return nil, ErrInvalidPrice
+	}
+
+	return &PriceSnapshot{
+		effectiveFrom: effectiveFrom,
+		basePrice:     basePrice,
+		discount:      discount,
+		schedule:      schedule,
+	}, nil
+}
+
+// EffectiveFrom returns when the snapshot took effect
+func (s *PriceSnapshot) EffectiveFrom() time.Time { return s.effectiveFrom }
+
+// BasePrice returns the base price recorded in the snapshot
+func (s *PriceSnapshot) BasePrice() *Money { return s.basePrice }
+
+// Discount returns the applied discount recorded in the snapshot, if any
+func (s *PriceSnapshot) Discount() *Discount { return s.discount }
+
+// Schedule returns the scheduled discounts recorded in the snapshot
+func (s *PriceSnapshot) Schedule() []*Discount { return s.schedule }
+
+// Discounts returns the applied and scheduled discounts of the snapshot
+func (s *PriceSnapshot) Discounts() []*Discount {
+	discounts := make([]*Discount, 0, len(s.schedule)+1)
+	if s.discount != nil {
+		discounts = append(discounts, s.discount)
+	}
+	return append(discounts, s.schedule...)
+}
+
+// DiscountAt returns the applied or scheduled discount whose window contains t, if any
+func (s *PriceSnapshot) DiscountAt(t time.Time) *Discount {
+	for _, d := range s.Discounts() {
+		if d.IsActiveAt(t) {
+			return d
+		}
+	}
+	return nil
+}
+
+// EffectivePrice calculates the snapshot's price at the given time
+func (s *PriceSnapshot) EffectivePrice(at time.Time) (*Money, error) {
+	return effectivePrice(s.basePrice, s.DiscountAt(at), at)
+}
+
+// PriceInterval is a period during which a product had a single effective price
+type PriceInterval struct {
+	Start          time.Time
+	End            time.Time
+	BasePrice      *Money
+	Discount       *Discount // Set only if the discount was active during the interval
+	EffectivePrice *Money
+}
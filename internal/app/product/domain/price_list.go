@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxPriceListNameLength matches the size of the price_lists.name column
+const maxPriceListNameLength = 100
+
+// PriceList is the aggregate root for prices that replace products' default
+// base prices in a sales channel, e.g. "wholesale", or for a customer segment.
+// It holds at most one price per product, in the list's currency, and applies
+// only within its validity window.
+type PriceList struct {
+	id        string
+	name      string
+	currency  Currency
+	channel   string     // Empty if the list targets a segment only
+	segment   string     // Empty if the list targets a channel only
+	validFrom time.Time  // Inclusive
+	validTo   *time.Time // Inclusive, nil for open-ended lists
+	entries   map[string]*Money
+	changed   map[string]bool // Product IDs whose entry was set or removed
+	createdAt time.Time
+	updatedAt time.Time
+	changes   *ChangeTracker
+	events    []DomainEvent
+	version   int
+}
+
+// NewPriceList creates a new empty price list for a channel, a segment or
+// both, valid from validFrom (now if zero) until validTo (open-ended if nil)
+func NewPriceList(id, name, currencyCode, channel, segment string, validFrom time.Time, validTo *time.Time, now time.Time) (*PriceList, error) {
+	name = strings.TrimSpace(name)
+	if id == "" || name == "" || len(name) > maxPriceListNameLength {
+		return nil, ErrInvalidPriceList
+	}
+	if channel == "" && segment == "" {
+		return nil, ErrInvalidPriceList
+	}
+
+	currency, err := LookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if validFrom.IsZero() {
+		validFrom = now
+	}
+	if validTo != nil && !validTo.After(validFrom) {
+		return nil, ErrInvalidDateRange
+	}
+
+	l := &PriceList{
+		id:        id,
+		name:      name,
+		currency:  currency,
+		channel:   channel,
+		segment:   segment,
+		validFrom: validFrom,
+		validTo:   validTo,
+		entries:   make(map[string]*Money),
+		changed:   make(map[string]bool),
+		createdAt: now,
+		updatedAt: now,
+		changes:   NewChangeTracker(),
+		events:    make([]DomainEvent, 0),
+	}
+
+	l.recordEvent(NewPriceListCreatedEvent(l))
+
+	return l, nil
+}
+
+// ReconstructPriceList reconstructs a price list from persistence. entries
+// holds the prices by product ID and may be limited to the products being read.
+func ReconstructPriceList(
+	id, name, currencyCode, channel, segment string,
+	validFrom time.Time,
+	validTo *time.Time,
+	entries map[string]*Money,
+	createdAt, updatedAt time.Time,
+	version int,
+) (*PriceList, error) {
+	currency, err := LookupCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		entries = make(map[string]*Money)
+	}
+
+	return &PriceList{
+		id:        id,
+		name:      name,
+		currency:  currency,
+		channel:   channel,
+		segment:   segment,
+		validFrom: validFrom,
+		validTo:   validTo,
+		entries:   entries,
+		changed:   make(map[string]bool),
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+		changes:   NewChangeTracker(),
+		events:    make([]DomainEvent, 0),
+		version:   version,
+	}, nil
+}
+
+// Accessor methods
+
+func (l *PriceList) ID() string              { return l.id }
+func (l *PriceList) Name() string            { return l.name }
+func (l *PriceList) Currency() Currency      { return l.currency }
+func (l *PriceList) Channel() string         { return l.channel }
+func (l *PriceList) Segment() string         { return l.segment }
+func (l *PriceList) ValidFrom() time.Time    { return l.validFrom }
+func (l *PriceList) ValidTo() *time.Time     { return l.validTo }
+func (l *PriceList) CreatedAt() time.Time    { return l.createdAt }
+func (l *PriceList) UpdatedAt() time.Time    { return l.updatedAt }
+func (l *PriceList) Changes() *ChangeTracker { return l.changes }
+func (l *PriceList) Version() int            { return l.version }
+
+// DomainEvents returns all recorded events
+func (l *PriceList) DomainEvents() []DomainEvent {
+	return l.events
+}
+
+// IsValidAt checks if the list's validity window contains t
+func (l *PriceList) IsValidAt(t time.Time) bool {
+	if t.Before(l.validFrom) {
+		return false
+	}
+	return l.validTo == nil || !t.After(*l.validTo)
+}
+
+// Price returns the product's price in the list, or nil if it has none
+func (l *PriceList) Price(productID string) *Money {
+	return l.entries[productID]
+}
+
+// ChangedEntries returns the IDs of products whose price was set or removed
+// since the list was loaded, in ID order
+func (l *PriceList) ChangedEntries() []string {
+	ids := make([]string, 0, len(l.changed))
+	for id := range l.changed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// SetPrice sets the price of a product in the list. The product must be a
+// sellable standard product priced in the list's currency.
+func (l *PriceList) SetPrice(product *Product, price *Money, now time.Time) error {
+	if product.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+	if product.IsBundle() {
+		return ErrBundlePriceDerived
+	}
+
+	if price == nil || price.Value().Sign() <= 0 {
+		return ErrInvalidPrice
+	}
+	if price.Currency() != l.currency || product.basePrice.Currency() != l.currency {
+		return ErrCurrencyMismatch
+	}
+
+	if l.entries[product.id].Equals(price) {
+		return nil // Price unchanged
+	}
+
+	l.entries[product.id] = price
+	l.markEntryChanged(product.id, now)
+	l.recordEvent(NewPriceListPriceSetEvent(l.id, product.id, price))
+
+	return nil
+}
+
+// RemovePrice removes a product's price from the list, so the product sells
+// at its default price in the list's channel or segment
+func (l *PriceList) RemovePrice(productID string, now time.Time) error {
+	if _, ok := l.entries[productID]; !ok {
+		return ErrPriceListEntryNotFound
+	}
+
+	delete(l.entries, productID)
+	l.markEntryChanged(productID, now)
+	l.recordEvent(NewPriceListPriceRemovedEvent(l.id, productID))
+
+	return nil
+}
+
+func (l *PriceList) markEntryChanged(productID string, now time.Time) {
+	l.changed[productID] = true
+	l.updatedAt = now
+	l.changes.MarkDirty(FieldPriceListEntries)
+	l.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+}
+
+// recordEvent adds a domain event
+func (l *PriceList) recordEvent(event DomainEvent) {
+	l.events = append(l.events, event)
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPriceListValidation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(24 * time.Hour)
+
+	_, err := NewPriceList("pl-1", "Wholesale", "USD", "", "", time.Time{}, nil, now)
+	assert.ErrorIs(t, err, ErrInvalidPriceList, "a list needs a channel or a segment")
+
+	_, err = NewPriceList("pl-1", " ", "USD", "wholesale", "", time.Time{}, nil, now)
+	assert.ErrorIs(t, err, ErrInvalidPriceList)
+
+	_, err = NewPriceList("pl-1", "Wholesale", "XXX", "wholesale", "", time.Time{}, nil, now)
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+
+	_, err = NewPriceList("pl-1", "Wholesale", "USD", "wholesale", "", later, &now, now)
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+	list, err := NewPriceList("pl-1", "Wholesale", "usd", "wholesale", "", time.Time{}, &later, now)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", list.Currency().Code())
+	assert.True(t, list.ValidFrom().Equal(now), "validity starts now by default")
+	assert.True(t, list.IsValidAt(now))
+	assert.True(t, list.IsValidAt(later), "validity window is inclusive")
+	assert.False(t, list.IsValidAt(later.Add(time.Second)))
+	assert.False(t, list.IsValidAt(now.Add(-time.Second)))
+	assert.Equal(t, []string{"price_list.created"}, eventTypes(list.DomainEvents()))
+}
+
+func TestPriceListSetAndRemovePrice(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	usd := func(num, denom int64) *Money {
+		m, err := NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+
+	list, err := NewPriceList("pl-1", "Wholesale", "USD", "wholesale", "", time.Time{}, nil, now)
+	require.NoError(t, err)
+	list.events = nil
+
+	product, _ := NewProduct("p-1", "Screw", "", "hardware", usd(10, 1), now)
+
+	eur, _ := NewMoney(8, 1, "EUR")
+	assert.ErrorIs(t, list.SetPrice(product, eur, now), ErrCurrencyMismatch)
+	assert.ErrorIs(t, list.SetPrice(product, nil, now), ErrInvalidPrice)
+
+	euroProduct, _ := NewProduct("p-2", "Bolt", "", "hardware", eur, now)
+	assert.ErrorIs(t, list.SetPrice(euroProduct, usd(8, 1), now), ErrCurrencyMismatch,
+		"products priced in another currency cannot be listed")
+
+	require.NoError(t, list.SetPrice(product, usd(8, 1), now))
+	assert.True(t, list.Price("p-1").Equals(usd(8, 1)))
+	assert.Equal(t, []string{"p-1"}, list.ChangedEntries())
+	assert.True(t, list.Changes().Dirty(FieldPriceListEntries))
+
+	require.NoError(t, list.SetPrice(product, usd(8, 1), now), "unchanged price is a no-op")
+	assert.Equal(t, []string{"price_list.price_set"}, eventTypes(list.DomainEvents()))
+
+	require.NoError(t, list.RemovePrice("p-1", now))
+	assert.Nil(t, list.Price("p-1"))
+	assert.ErrorIs(t, list.RemovePrice("p-1", now), ErrPriceListEntryNotFound)
+	assert.Equal(t, []string{"price_list.price_set", "price_list.price_removed"}, eventTypes(list.DomainEvents()))
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, list.SetPrice(product, usd(8, 1), now), ErrProductIsArchived)
+}
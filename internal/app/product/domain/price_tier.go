@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// MaxPricedQuantity caps the quantity of a product priced at once, keeping
+// line totals within the int64 numerators and denominators prices are returned in
+const MaxPricedQuantity = 1_000_000
+
+// PriceTier is the unit price of a product when at least minQuantity units are
+// bought at once, e.g. $8.50 each from 10 units
+type PriceTier struct {
+	minQuantity int64
+	price       *Money
+}
+
+// NewPriceTier creates a new PriceTier value object. Quantities below
+// minQuantity are priced by lower tiers or the base price, so minQuantity must
+// be at least 2.
+func NewPriceTier(minQuantity int64, price *Money) (PriceTier, error) {
+	if minQuantity < 2 {
+		return PriceTier{}, ErrInvalidPriceTier
+	}
+	if price == nil || price.Value().Sign() <= 0 {
+		return PriceTier{}, ErrInvalidPrice
+	}
+	return PriceTier{minQuantity: minQuantity, price: price}, nil
+}
+
+func (t PriceTier) MinQuantity() int64 { return t.minQuantity }
+func (t PriceTier) Price() *Money      { return t.price }
+
+// QuantityPrice is the price of buying a quantity of a product at once
+type QuantityPrice struct {
+	Quantity           int64
+	TierMinQuantity    int64     // Minimum quantity of the applied tier, 1 for the base price
+	UnitPrice          *Money    // Before discount
+	Discount           *Discount // Set only if a discount was applied
+	EffectiveUnitPrice *Money
+	LineTotal          *Money // Effective unit price times the quantity
+}
+
+// PriceTiers returns the product's price tiers ordered by minimum quantity
+func (p *Product) PriceTiers() []PriceTier {
+	tiers := make([]PriceTier, len(p.priceTiers))
+	copy(tiers, p.priceTiers)
+	return tiers
+}
+
+// SetPriceTiers replaces the product's price tiers. An empty list sells every
+// quantity at the base price.
+func (p *Product) SetPriceTiers(tiers []PriceTier, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	if p.bundle != nil {
+		return ErrBundlePriceDerived
+	}
+
+	sorted := make([]PriceTier, len(tiers))
+	copy(sorted, tiers)
+	sortPriceTiers(sorted)
+
+	if err := validatePriceTiers(p.basePrice, sorted); err != nil {
+		return err
+	}
+
+	if priceTiersEqual(p.priceTiers, sorted) {
+		return nil // Tiers unchanged
+	}
+
+	p.priceTiers = sorted
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldPriceTiers)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewProductPriceTiersChangedEvent(p.id, p.basePrice.Currency().Code(), sorted))
+
+	return nil
+}
+
+// UnitPrice returns the price of one unit before discounts when quantity
+// units are bought at once: the price of the highest tier quantity reaches,
+// or the base price
+func (p *Product) UnitPrice(quantity int64) (*Money, error) {
+	price, _, err := TierPrice(p.basePrice, p.priceTiers, quantity)
+	return price, err
+}
+
+// QuantityEffectivePrice calculates the unit price for quantity units after
+// applying the discount whose window contains now
+func (p *Product) QuantityEffectivePrice(quantity int64, now time.Time) (*Money, error) {
+	price, err := p.UnitPrice(quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return effectivePrice(price, p.DiscountAt(now), now)
+}
+
+// TierPrice returns the unit price for quantity units and the minimum quantity
+// of the tier it comes from, 1 for the base price. tiers must be ordered by
+// minimum quantity. Tiers priced above the base price, e.g. when a price list
+// lowers it, are skipped.
+func TierPrice(basePrice *Money, tiers []PriceTier, quantity int64) (*Money, int64, error) {
+	if quantity <= 0 || quantity > MaxPricedQuantity {
+		return nil, 0, ErrInvalidQuantity
+	}
+
+	price, minQuantity := basePrice, int64(1)
+	for _, t := range tiers {
+		if t.minQuantity > quantity {
+			break
+		}
+		if t.price.GreaterThan(basePrice) {
+			continue
+		}
+		price, minQuantity = t.price, t.minQuantity
+	}
+
+	return price, minQuantity, nil
+}
+
+// validatePriceTiers checks ordered tiers are in the base price's currency,
+// with strictly ascending minimum quantities and unit prices that never rise
+// above the base price or the tier before
+func validatePriceTiers(basePrice *Money, tiers []PriceTier) error {
+	previous := basePrice
+	var minQuantity int64 = 1
+
+	for _, t := range tiers {
+		if !basePrice.SameCurrency(t.price) {
+			return ErrCurrencyMismatch
+		}
+		if t.minQuantity <= minQuantity || t.price.GreaterThan(previous) {
+			return ErrPriceTiersNotMonotonic
+		}
+		previous, minQuantity = t.price, t.minQuantity
+	}
+
+	return nil
+}
+
+// sortPriceTiers orders tiers by minimum quantity
+func sortPriceTiers(tiers []PriceTier) {
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].minQuantity < tiers[j].minQuantity
+	})
+}
+
+func priceTiersEqual(a, b []PriceTier) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].minQuantity != b[i].minQuantity || !a[i].price.Equals(b[i].price) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetPriceTiers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	usd := func(num, denom int64) *Money {
+		m, err := NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+	tier := func(minQuantity int64, price *Money) PriceTier {
+		pt, err := NewPriceTier(minQuantity, price)
+		require.NoError(t, err)
+		return pt
+	}
+
+	_, err := NewPriceTier(1, usd(9, 1))
+	assert.ErrorIs(t, err, ErrInvalidPriceTier, "quantity 1 is the base price")
+
+	product, _ := NewProduct("p-1", "Screw", "", "hardware", usd(10, 1), now)
+	product.ClearEvents()
+
+	assert.ErrorIs(t, product.SetPriceTiers([]PriceTier{tier(10, usd(11, 1))}, now), ErrPriceTiersNotMonotonic,
+		"a tier must not cost more than the base price")
+	assert.ErrorIs(t, product.SetPriceTiers([]PriceTier{tier(10, usd(8, 1)), tier(50, usd(9, 1))}, now), ErrPriceTiersNotMonotonic,
+		"a larger tier must not cost more than a smaller one")
+	assert.ErrorIs(t, product.SetPriceTiers([]PriceTier{tier(10, usd(8, 1)), tier(10, usd(7, 1))}, now), ErrPriceTiersNotMonotonic,
+		"minimum quantities must be distinct")
+
+	eur, _ := NewMoney(8, 1, "EUR")
+	assert.ErrorIs(t, product.SetPriceTiers([]PriceTier{tier(10, eur)}, now), ErrCurrencyMismatch)
+
+	require.NoError(t, product.SetPriceTiers([]PriceTier{tier(50, usd(8, 1)), tier(10, usd(17, 2))}, now))
+	assert.Equal(t, int64(10), product.PriceTiers()[0].MinQuantity(), "tiers are ordered by minimum quantity")
+	assert.True(t, product.Changes().Dirty(FieldPriceTiers))
+	assert.Equal(t, []string{"product.price_tiers_changed"}, eventTypes(product.DomainEvents()))
+
+	product.ClearEvents()
+	require.NoError(t, product.SetPriceTiers([]PriceTier{tier(10, usd(17, 2)), tier(50, usd(8, 1))}, now), "unchanged tiers are a no-op")
+	assert.Empty(t, product.DomainEvents())
+
+	for quantity, expected := range map[int64]*Money{1: usd(10, 1), 9: usd(10, 1), 10: usd(17, 2), 49: usd(17, 2), 50: usd(8, 1)} {
+		price, err := product.UnitPrice(quantity)
+		require.NoError(t, err)
+		assert.True(t, price.Equals(expected), "quantity %d: got %s", quantity, price)
+	}
+
+	_, err = product.UnitPrice(0)
+	assert.ErrorIs(t, err, ErrInvalidQuantity)
+
+	assert.ErrorIs(t, product.ChangePrice(usd(8, 1), "manual", now), ErrPriceTiersNotMonotonic,
+		"the base price must stay at or above the first tier")
+	require.NoError(t, product.ChangePrice(usd(12, 1), "manual", now))
+}
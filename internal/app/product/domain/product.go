@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"math/big"
 	"time"
 )
 
@@ -8,26 +9,43 @@ import (
 type ProductStatus string
 
 const (
-	ProductStatusActive    ProductStatus = "active"
-	ProductStatusInactive  ProductStatus = "inactive"
-	ProductStatusArchived  ProductStatus = "archived"
+	ProductStatusActive   ProductStatus = "active"
+	ProductStatusInactive ProductStatus = "inactive"
+	ProductStatusArchived ProductStatus = "archived"
 )
 
+// ParseProductStatus validates a product status
+func ParseProductStatus(status string) (ProductStatus, error) {
+	switch s := ProductStatus(status); s {
+	case ProductStatusActive, ProductStatusInactive, ProductStatusArchived:
+		return s, nil
+	default:
+		return "", ErrInvalidProductStatus
+	}
+}
+
 // Product is the aggregate root for products
 type Product struct {
-	id          string
-	name        string
-	description string
-	category    string
-	basePrice   *Money
-	discount    *Discount
-	status      ProductStatus
-	createdAt   time.Time
-	updatedAt   time.Time
-	archivedAt  *time.Time
-	changes     *ChangeTracker
-	events      []DomainEvent
-	version     int
+	id            string
+	name          string
+	description   string
+	category      string
+	attributes    map[string]AttributeValue
+	basePrice     *Money
+	discount      *Discount
+	discountPhase DiscountPhase
+	schedule      []*ScheduledDiscount // Ordered by start date, non-overlapping
+	variants      []*Variant           // Ordered by creation
+	bundle        *Bundle              // Nil for standard products
+	priceTiers    []PriceTier          // Ordered by minimum quantity
+	taxClass      string
+	status        ProductStatus
+	createdAt     time.Time
+	updatedAt     time.Time
+	archivedAt    *time.Time
+	changes       *ChangeTracker
+	events        []DomainEvent
+	version       int
 }
 
 // NewProduct creates a new product
@@ -51,6 +69,7 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 		description: description,
 		category:    category,
 		basePrice:   basePrice,
+		taxClass:    DefaultTaxClass,
 		status:      ProductStatusActive,
 		createdAt:   now,
 		updatedAt:   now,
@@ -65,6 +84,7 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 	p.changes.MarkDirty(FieldDescription)
 	p.changes.MarkDirty(FieldCategory)
 	p.changes.MarkDirty(FieldBasePrice)
+	p.changes.MarkDirty(FieldTaxClass)
 	p.changes.MarkDirty(FieldStatus)
 
 	// Record creation event
@@ -74,6 +94,7 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 		category,
 		basePrice.Numerator(),
 		basePrice.Denominator(),
+		basePrice.Currency().Code(),
 	))
 
 	return p, nil
@@ -83,57 +104,94 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 func ReconstructProduct(
 	id, name, description, category string,
 	basePriceNum, basePriceDenom int64,
-	discountPercent int64,
+	currencyCode string,
+	discountKind string,
+	discountPercent *big.Rat,
+	discountAmountNum, discountAmountDenom int64,
 	discountStart, discountEnd time.Time,
+	discountPhase string,
 	status string,
 	createdAt, updatedAt time.Time,
 	archivedAt *time.Time,
 	version int,
+	schedule []*ScheduledDiscount,
+	variants []*Variant,
+	attributes map[string]AttributeValue,
+	bundle *Bundle,
+	priceTiers []PriceTier,
+	taxClass string,
 ) (*Product, error) {
-	basePrice, err := NewMoney(basePriceNum, basePriceDenom)
+	basePrice, err := NewMoney(basePriceNum, basePriceDenom, currencyCode)
 	if err != nil {
 		return nil, err
 	}
 
-	var discount *Discount
+	var (
+		discount *Discount
+		phase    DiscountPhase
+	)
 	if !discountStart.IsZero() && !discountEnd.IsZero() {
-		discount, err = NewDiscount(discountPercent, discountStart, discountEnd)
+		discount, err = ReconstructDiscount(
+			discountKind,
+			discountPercent,
+			discountAmountNum,
+			discountAmountDenom,
+			currencyCode,
+			discountStart,
+			discountEnd,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		phase, err = ParseDiscountPhase(discountPhase)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	sortSchedule(schedule)
+	sortVariants(variants)
+	sortPriceTiers(priceTiers)
+
 	return &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		discount:    discount,
-		status:      ProductStatus(status),
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
-		archivedAt:  archivedAt,
-		changes:     NewChangeTracker(),
-		events:      make([]DomainEvent, 0),
-		version:     version,
+		id:            id,
+		name:          name,
+		description:   description,
+		category:      category,
+		basePrice:     basePrice,
+		discount:      discount,
+		discountPhase: phase,
+		schedule:      schedule,
+		variants:      variants,
+		attributes:    attributes,
+		bundle:        bundle,
+		priceTiers:    priceTiers,
+		taxClass:      taxClass,
+		status:        ProductStatus(status),
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		archivedAt:    archivedAt,
+		changes:       NewChangeTracker(),
+		events:        make([]DomainEvent, 0),
+		version:       version,
 	}, nil
 }
 
 // Accessor methods
 
-func (p *Product) ID() string                 { return p.id }
-func (p *Product) Name() string               { return p.name }
-func (p *Product) Description() string       { return p.description }
-func (p *Product) Category() string           { return p.category }
-func (p *Product) BasePrice() *Money          { return p.basePrice }
-func (p *Product) Discount() *Discount        { return p.discount }
-func (p *Product) Status() ProductStatus      { return p.status }
-func (p *Product) CreatedAt() time.Time       { return p.createdAt }
-func (p *Product) UpdatedAt() time.Time       { return p.updatedAt }
-func (p *Product) ArchivedAt() *time.Time      { return p.archivedAt }
-func (p *Product) Changes() *ChangeTracker    { return p.changes }
-func (p *Product) Version() int               { return p.version }
+func (p *Product) ID() string              { return p.id }
+func (p *Product) Name() string            { return p.name }
+func (p *Product) Description() string     { return p.description }
+func (p *Product) Category() string        { return p.category }
+func (p *Product) BasePrice() *Money       { return p.basePrice }
+func (p *Product) Discount() *Discount     { return p.discount }
+func (p *Product) Status() ProductStatus   { return p.status }
+func (p *Product) CreatedAt() time.Time    { return p.createdAt }
+func (p *Product) UpdatedAt() time.Time    { return p.updatedAt }
+func (p *Product) ArchivedAt() *time.Time  { return p.archivedAt }
+func (p *Product) Changes() *ChangeTracker { return p.changes }
+func (p *Product) Version() int            { return p.version }
 
 // DomainEvents returns all recorded events
 func (p *Product) DomainEvents() []DomainEvent {
@@ -188,6 +246,74 @@ func (p *Product) UpdateDetails(name, description, category string, now time.Tim
 	return nil
 }
 
+// MigrateCategory replaces the free-text category name a product was created
+// with before categories existed by the ID of the matching category. Unlike
+// UpdateDetails it also applies to archived products, which can still be
+// listed by category.
+func (p *Product) MigrateCategory(categoryID string, now time.Time) error {
+	if categoryID == "" {
+		return ErrInvalidCategory
+	}
+	if p.category == categoryID {
+		return nil
+	}
+
+	p.category = categoryID
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldCategory)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+	p.recordEvent(NewProductUpdatedEvent(p.id))
+
+	return nil
+}
+
+// ChangePrice changes the product's base price
+func (p *Product) ChangePrice(newPrice *Money, reasonCode string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	if newPrice == nil {
+		return ErrInvalidPrice
+	}
+
+	if p.bundle != nil {
+		return ErrBundlePriceDerived
+	}
+
+	if !p.basePrice.SameCurrency(newPrice) {
+		return ErrCurrencyMismatch
+	}
+
+	if p.basePrice.Equals(newPrice) {
+		return nil // Price unchanged
+	}
+
+	if err := validatePriceTiers(newPrice, p.priceTiers); err != nil {
+		return err
+	}
+
+	// Fixed-amount discounts must still fit the lowered price
+	if err := discountFits(p.discount, newPrice); err != nil {
+		return err
+	}
+	for _, s := range p.schedule {
+		if err := discountFits(s.discount, newPrice); err != nil {
+			return err
+		}
+	}
+
+	oldPrice := p.basePrice
+	p.basePrice = newPrice
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldBasePrice)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewProductPriceChangedEvent(p.id, oldPrice, newPrice, reasonCode))
+
+	return nil
+}
+
 // Activate activates the product
 func (p *Product) Activate(now time.Time) error {
 	if p.status == ProductStatusArchived {
@@ -230,21 +356,51 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 		return ErrProductNotActive
 	}
 
-	if !discount.IsValidAt(now) {
-		return ErrInvalidDiscountPeriod
+	if err := p.validateDiscount(discount, now); err != nil {
+		return err
+	}
+
+	if p.overlapsSchedule(discount) {
+		return ErrDiscountOverlap
 	}
 
+	p.endStarted("", p.discount, p.discountPhase)
+
 	p.discount = discount
+	p.discountPhase = DiscountPhasePending
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldDiscount)
 	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
 
-	p.recordEvent(NewDiscountAppliedEvent(
-		p.id,
-		discount.Percentage(),
-		discount.StartDate().Unix(),
-		discount.EndDate().Unix(),
-	))
+	p.recordEvent(NewDiscountAppliedEvent(p.id, discount))
+
+	return nil
+}
+
+// validateDiscount checks that a discount can still take effect and fits the base price
+func (p *Product) validateDiscount(discount *Discount, now time.Time) error {
+	if !discount.IsValidAt(now) {
+		return ErrInvalidDiscountPeriod
+	}
+
+	return discountFits(discount, p.basePrice)
+}
+
+// discountFits checks that a fixed-amount discount is in the price's currency
+// and does not exceed the price. Nil and percentage discounts always fit.
+func discountFits(discount *Discount, price *Money) error {
+	if discount == nil {
+		return nil
+	}
+
+	if amount := discount.Amount(); amount != nil {
+		if !price.SameCurrency(amount) {
+			return ErrCurrencyMismatch
+		}
+		if amount.GreaterThan(price) {
+			return ErrDiscountExceedsPrice
+		}
+	}
 
 	return nil
 }
@@ -259,7 +415,10 @@ func (p *Product) RemoveDiscount(now time.Time) error {
 		return ErrNoActiveDiscount
 	}
 
+	p.endStarted("", p.discount, p.discountPhase)
+
 	p.discount = nil
+	p.discountPhase = ""
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldDiscount)
 	p.changes.MarkDirty(FieldStatus)
@@ -281,18 +440,61 @@ func (p *Product) Archive(now time.Time) error {
 	p.changes.MarkDirty(FieldStatus)
 	p.changes.MarkDirty(FieldArchivedAt)
 
+	// The lifecycle skips archived products, so end running discounts now
+	p.endRunningDiscounts()
+
 	p.recordEvent(NewProductArchivedEvent(p.id))
 
 	return nil
 }
 
-// EffectivePrice calculates the price after applying any active discount
+// EffectivePrice calculates the price after applying the applied or scheduled
+// discount whose window contains now
 func (p *Product) EffectivePrice(now time.Time) (*Money, error) {
-	if p.discount == nil || !p.discount.IsActiveAt(now) {
-		return p.basePrice, nil
+	return effectivePrice(p.basePrice, p.DiscountAt(now), now)
+}
+
+// ListingPrice returns the effective price at the product's last update,
+// which listings sort and filter by. The discount lifecycle updates the
+// product whenever a discount window starts or ends, keeping it current.
+func (p *Product) ListingPrice() *Money {
+	price, err := p.EffectivePrice(p.updatedAt)
+	if err != nil {
+		return p.basePrice
+	}
+	return price
+}
+
+// RefreshListingPrice recomputes the listing price at now, e.g. for products
+// last written before it was stored. The product's pricing does not change,
+// so no event is recorded.
+func (p *Product) RefreshListingPrice(now time.Time) {
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldListingPrice)
+}
+
+// PriceSnapshot returns the product's current pricing state
+func (p *Product) PriceSnapshot() *PriceSnapshot {
+	schedule := make([]*Discount, len(p.schedule))
+	for i, s := range p.schedule {
+		schedule[i] = s.discount
+	}
+
+	return &PriceSnapshot{
+		effectiveFrom: p.updatedAt,
+		basePrice:     p.basePrice,
+		discount:      p.discount,
+		schedule:      schedule,
+	}
+}
+
+// effectivePrice applies the discount to the base price if it is active at now
+func effectivePrice(basePrice *Money, discount *Discount, now time.Time) (*Money, error) {
+	if discount == nil || !discount.IsActiveAt(now) {
+		return basePrice, nil
 	}
 
-	return p.basePrice.ApplyPercentage(p.discount.Percentage())
+	return discount.ApplyTo(basePrice)
 }
 
 // IncrementVersion increments the version for optimistic locking
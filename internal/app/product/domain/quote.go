@@ -0,0 +1,43 @@
+package domain
+
+// MaxQuoteLines caps the number of lines priced by a single quote
+const MaxQuoteLines = 100
+
+// QuoteItem is a requested line of a quote: a quantity of a product
+type QuoteItem struct {
+	ProductID string
+	Quantity  int64
+}
+
+// QuoteLine is a priced or rejected line of a quote. Err is set if the line
+// was rejected, e.g. with ErrProductNotActive, in which case it has no prices.
+type QuoteLine struct {
+	ProductID          string
+	Quantity           int64
+	TierMinQuantity    int64     // Minimum quantity of the applied tier, 1 for the base price
+	UnitPrice          *Money    // Tier price before discount
+	Discount           *Discount // Set only if a discount was applied
+	EffectiveUnitPrice *Money
+	LineTotal          *Money // Effective unit price times the quantity
+	Err                error
+}
+
+// Quote prices the lines of a cart. Total sums the line totals of the priced
+// lines and is nil if every line was rejected.
+type Quote struct {
+	Lines []QuoteLine
+	Total *Money
+}
+
+// CheckSellable checks if the product can be sold: archived and inactive
+// products cannot
+func (p *Product) CheckSellable() error {
+	switch p.status {
+	case ProductStatusArchived:
+		return ErrProductIsArchived
+	case ProductStatusActive:
+		return nil
+	default:
+		return ErrProductNotActive
+	}
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductCheckSellable(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(10, 1, "USD")
+
+	product, err := NewProduct("p-1", "Mug", "", "kitchen", price, now)
+	require.NoError(t, err)
+	assert.NoError(t, product.CheckSellable())
+
+	require.NoError(t, product.Deactivate(now))
+	assert.ErrorIs(t, product.CheckSellable(), ErrProductNotActive)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.CheckSellable(), ErrProductIsArchived)
+}
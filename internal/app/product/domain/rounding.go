@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// RoundingMode defines how amounts are rounded to a currency's minor units
+type RoundingMode string
+
+const (
+	// RoundHalfEven rounds ties to the nearest even digit (banker's rounding)
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundHalfUp rounds ties away from zero
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundFloor rounds towards negative infinity
+	RoundFloor RoundingMode = "floor"
+)
+
+// DefaultRoundingMode is used when no rounding mode is configured
+const DefaultRoundingMode = RoundHalfEven
+
+// ParseRoundingMode returns the rounding mode for its name
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	switch mode := RoundingMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case RoundHalfEven, RoundHalfUp, RoundFloor:
+		return mode, nil
+	default:
+		return "", ErrUnsupportedRoundingMode
+	}
+}
+
+// FormatAmount renders numerator/denominator as a decimal string with the
+// currency's minor units, for example "19.99" for 1999/100 USD
+func FormatAmount(numerator, denominator int64, currencyCode string, mode RoundingMode) (string, error) {
+	if denominator == 0 {
+		return "", ErrInvalidPrice
+	}
+
+	currency, err := LookupCurrency(currencyCode)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRat(big.NewRat(numerator, denominator), currency.MinorUnits(), mode), nil
+}
+
+// roundScaled rounds r*10^places to an integer using the given mode
+func roundScaled(r *big.Rat, places int, mode RoundingMode) *big.Int {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(scale))
+
+	num, den := scaled.Num(), scaled.Denom()
+
+	// Euclidean division: q is the floor and rem is non-negative since den > 0
+	q, rem := new(big.Int).DivMod(num, den, new(big.Int))
+	if rem.Sign() == 0 || mode == RoundFloor {
+		return q
+	}
+
+	cmp := new(big.Int).Lsh(rem, 1).Cmp(den)
+	switch {
+	case cmp > 0:
+		q.Add(q, big.NewInt(1))
+	case cmp == 0 && mode == RoundHalfUp && num.Sign() > 0:
+		q.Add(q, big.NewInt(1))
+	case cmp == 0 && mode == RoundHalfEven && q.Bit(0) == 1:
+		q.Add(q, big.NewInt(1))
+	}
+
+	return q
+}
+
+// roundRat rounds r to the given number of decimal places
+func roundRat(r *big.Rat, places int, mode RoundingMode) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	return new(big.Rat).SetFrac(roundScaled(r, places, mode), scale)
+}
+
+// formatRat renders r as a decimal string with exactly the given number of places
+func formatRat(r *big.Rat, places int, mode RoundingMode) string {
+	q := roundScaled(r, places, mode)
+
+	sign := ""
+	if q.Sign() < 0 {
+		sign = "-"
+		q.Neg(q)
+	}
+
+	digits := q.String()
+	if places == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+}
+
+// formatDecimal renders r exactly with no trailing zeros, or rounded to maxPlaces
+// decimal places if its decimal expansion is longer
+func formatDecimal(r *big.Rat, maxPlaces int) string {
+	scale := big.NewInt(1)
+	for places := 0; places <= maxPlaces; places++ {
+		if new(big.Int).Rem(scale, r.Denom()).Sign() == 0 {
+			return r.FloatString(places)
+		}
+		scale.Mul(scale, big.NewInt(10))
+	}
+
+	s := r.FloatString(maxPlaces)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
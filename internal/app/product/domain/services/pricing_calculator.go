@@ -1,7 +1,9 @@
 package services
 
 import (
+	"math/big"
 	"product-catalog-service/internal/app/product/domain"
+	"sort"
 	"time"
 )
 
@@ -32,3 +34,287 @@ func (pc *PricingCalculator) CalculateEffectivePrices(products []*domain.Product
 
 	return result, nil
 }
+
+// CalculateQuote prices each item of a cart at now, looking its product up by
+// ID in products, which must also hold the components of bundles. Lines are
+// priced like a single product: from the product's price in list, if given,
+// and its tier for the quantity, less the discount active at now; bundles
+// derive their price from their components' effective prices. Items of
+// unknown, inactive or archived products, bundles with a component that
+// cannot be sold, items with a quantity outside 1 to MaxPricedQuantity, items
+// whose prices do not fit int64 fractions and items priced in another
+// currency than the first priced item are rejected individually. A total that
+// does not fit fails the quote with ErrAmountOverflow. list may be nil.
+func (pc *PricingCalculator) CalculateQuote(items []domain.QuoteItem, products map[string]*domain.Product, list *domain.PriceList, now time.Time) (*domain.Quote, error) {
+	if len(items) == 0 || len(items) > domain.MaxQuoteLines {
+		return nil, domain.ErrInvalidQuote
+	}
+
+	quote := &domain.Quote{Lines: make([]domain.QuoteLine, 0, len(items))}
+
+	for _, item := range items {
+		line := domain.QuoteLine{ProductID: item.ProductID, Quantity: item.Quantity}
+
+		price, err := pc.calculateQuoteLine(item, products, list, now)
+		if err == nil {
+			err = domain.CheckFractions(price.UnitPrice, price.EffectiveUnitPrice, price.LineTotal)
+		}
+		if err == nil && quote.Total != nil && !quote.Total.SameCurrency(price.UnitPrice) {
+			err = domain.ErrCurrencyMismatch
+		}
+		if err != nil {
+			line.Err = err
+			quote.Lines = append(quote.Lines, line)
+			continue
+		}
+
+		line.TierMinQuantity = price.TierMinQuantity
+		line.UnitPrice = price.UnitPrice
+		line.EffectiveUnitPrice = price.EffectiveUnitPrice
+		line.LineTotal = price.LineTotal
+		line.Discount = price.Discount
+
+		if quote.Total == nil {
+			quote.Total = line.LineTotal
+		} else if quote.Total, err = quote.Total.Add(line.LineTotal); err != nil {
+			return nil, err
+		}
+
+		quote.Lines = append(quote.Lines, line)
+	}
+
+	if err := domain.CheckFractions(quote.Total); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// calculateQuoteLine prices an item of a quote, or returns why it cannot be sold
+func (pc *PricingCalculator) calculateQuoteLine(item domain.QuoteItem, products map[string]*domain.Product, list *domain.PriceList, now time.Time) (*domain.QuantityPrice, error) {
+	product, ok := products[item.ProductID]
+	if !ok {
+		return nil, domain.ErrProductNotFound
+	}
+	if item.Quantity <= 0 || item.Quantity > domain.MaxPricedQuantity {
+		return nil, domain.ErrInvalidQuantity
+	}
+	if err := product.CheckSellable(); err != nil {
+		return nil, err
+	}
+
+	bundle := product.Bundle()
+	if bundle == nil {
+		basePrice := pc.ResolveBasePrice(product.BasePrice(), list, product.ID(), now)
+		return pc.CalculateQuantityPrice(basePrice, product.PriceTiers(), product.DiscountAt(now), item.Quantity, now)
+	}
+
+	if err := bundle.CheckSellable(products); err != nil {
+		return nil, err
+	}
+
+	componentPrices := make(map[string]*domain.Money, len(bundle.Components()))
+	for _, c := range bundle.Components() {
+		component := products[c.ProductID()]
+		basePrice := pc.ResolveBasePrice(component.BasePrice(), list, component.ID(), now)
+		price, err := pc.CalculateQuantityPrice(basePrice, nil, component.DiscountAt(now), 1, now)
+		if err != nil {
+			return nil, err
+		}
+		componentPrices[c.ProductID()] = price.EffectiveUnitPrice
+	}
+
+	// The bundle keeps its own discount, applied to the derived price
+	basePrice, err := pc.CalculateBundlePrice(bundle, componentPrices)
+	if err != nil {
+		return nil, err
+	}
+	return pc.CalculateQuantityPrice(basePrice, nil, product.DiscountAt(now), item.Quantity, now)
+}
+
+// ResolveBasePrice returns the base price of a product: its price in list if
+// the list is valid at now and prices the product in the default price's
+// currency, otherwise defaultPrice. list may be nil.
+func (pc *PricingCalculator) ResolveBasePrice(defaultPrice *domain.Money, list *domain.PriceList, productID string, now time.Time) *domain.Money {
+	if list == nil || !list.IsValidAt(now) {
+		return defaultPrice
+	}
+
+	price := list.Price(productID)
+	if price == nil || !defaultPrice.SameCurrency(price) {
+		return defaultPrice
+	}
+
+	return price
+}
+
+// CalculateTax splits a net amount into net, tax and gross amounts at rate.
+// The net amount and the tax are each rounded to the currency's minor units
+// with mode, so the gross amount is exactly their sum.
+func (pc *PricingCalculator) CalculateTax(net *domain.Money, rate domain.TaxRate, mode domain.RoundingMode) (*domain.TaxedAmount, error) {
+	if net == nil {
+		return nil, domain.ErrInvalidPrice
+	}
+
+	rounded := net.Round(mode)
+
+	// tax = net * rate / 100, computed exactly before rounding
+	exact := new(big.Rat).Mul(rounded.Value(), new(big.Rat).Quo(rate.Percent(), big.NewRat(100, 1)))
+	tax, err := domain.NewMoneyFromRat(exact, net.Currency().Code())
+	if err != nil {
+		return nil, err
+	}
+	tax = tax.Round(mode)
+
+	gross, err := rounded.Add(tax)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TaxedAmount{
+		Rate:  rate,
+		Net:   rounded,
+		Tax:   tax,
+		Gross: gross,
+	}, nil
+}
+
+// CalculateQuantityPrice prices quantity units of a product from its base price
+// and tiers ordered by minimum quantity, applying the discount if it is active at now
+func (pc *PricingCalculator) CalculateQuantityPrice(basePrice *domain.Money, tiers []domain.PriceTier, discount *domain.Discount, quantity int64, now time.Time) (*domain.QuantityPrice, error) {
+	unitPrice, minQuantity, err := domain.TierPrice(basePrice, tiers, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.QuantityPrice{
+		Quantity:           quantity,
+		TierMinQuantity:    minQuantity,
+		UnitPrice:          unitPrice,
+		EffectiveUnitPrice: unitPrice,
+	}
+
+	if discount != nil && discount.IsActiveAt(now) {
+		if result.EffectiveUnitPrice, err = discount.ApplyTo(unitPrice); err != nil {
+			return nil, err
+		}
+		result.Discount = discount
+	}
+
+	if result.LineTotal, err = result.EffectiveUnitPrice.Multiply(quantity); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CalculateBundlePrice prices a bundle from the effective unit prices of its
+// components, keyed by product ID: the bundle's price override if set, otherwise
+// the components' total less the bundle discount, never below zero
+func (pc *PricingCalculator) CalculateBundlePrice(bundle *domain.Bundle, componentPrices map[string]*domain.Money) (*domain.Money, error) {
+	if override := bundle.PriceOverride(); override != nil {
+		return override, nil
+	}
+
+	var total *domain.Money
+	for _, c := range bundle.Components() {
+		price, ok := componentPrices[c.ProductID()]
+		if !ok {
+			return nil, domain.ErrProductNotFound
+		}
+
+		subtotal, err := price.Multiply(c.Quantity())
+		if err != nil {
+			return nil, err
+		}
+
+		if total == nil {
+			total = subtotal
+			continue
+		}
+		if total, err = total.Add(subtotal); err != nil {
+			return nil, err
+		}
+	}
+
+	if percent := bundle.DiscountPercent(); percent != nil {
+		return total.ApplyPercentage(percent)
+	}
+	if amount := bundle.DiscountAmount(); amount != nil {
+		return total.SubtractFixedAmount(amount)
+	}
+
+	return total, nil
+}
+
+// CalculatePriceIntervals splits the range [from, to) into intervals with a single
+// effective price, based on the product's price snapshots
+func (pc *PricingCalculator) CalculatePriceIntervals(snapshots []*domain.PriceSnapshot, from, to time.Time) ([]domain.PriceInterval, error) {
+	if !from.Before(to) {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	sorted := make([]*domain.PriceSnapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveFrom().Before(sorted[j].EffectiveFrom())
+	})
+
+	intervals := make([]domain.PriceInterval, 0)
+
+	for i, snapshot := range sorted {
+		start := laterOf(snapshot.EffectiveFrom(), from)
+		end := to
+		if i+1 < len(sorted) {
+			end = earlierOf(sorted[i+1].EffectiveFrom(), to)
+		}
+		if !start.Before(end) {
+			continue
+		}
+
+		// The discount end date is inclusive, so its window closes just after it
+		cuts := []time.Time{start}
+		for _, d := range snapshot.Discounts() {
+			for _, cut := range []time.Time{d.StartDate(), d.EndDate().Add(time.Nanosecond)} {
+				if cut.After(start) && cut.Before(end) {
+					cuts = append(cuts, cut)
+				}
+			}
+		}
+		sort.Slice(cuts, func(i, j int) bool { return cuts[i].Before(cuts[j]) })
+		cuts = append(cuts, end)
+
+		for j := 0; j+1 < len(cuts); j++ {
+			price, err := snapshot.EffectivePrice(cuts[j])
+			if err != nil {
+				return nil, err
+			}
+
+			interval := domain.PriceInterval{
+				Start:          cuts[j],
+				End:            cuts[j+1],
+				BasePrice:      snapshot.BasePrice(),
+				Discount:       snapshot.DiscountAt(cuts[j]),
+				EffectivePrice: price,
+			}
+
+			intervals = append(intervals, interval)
+		}
+	}
+
+	return intervals, nil
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,431 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"product-catalog-service/internal/app/product/domain"
+)
+
+func TestCalculatePriceIntervals(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	price100, _ := domain.NewMoney(100, 1, "USD")
+	price120, _ := domain.NewMoney(120, 1, "USD")
+	discount, err := domain.NewDiscount(big.NewRat(25, 1), day(3), day(5))
+	require.NoError(t, err)
+
+	created, _ := domain.NewPriceSnapshot(day(1), price100, nil, nil)
+	discounted, _ := domain.NewPriceSnapshot(day(2), price100, discount, nil)
+	repriced, _ := domain.NewPriceSnapshot(day(8), price120, nil, nil)
+
+	intervals, err := NewPricingCalculator().CalculatePriceIntervals(
+		[]*domain.PriceSnapshot{repriced, created, discounted}, day(1), day(10))
+	require.NoError(t, err)
+	require.Len(t, intervals, 5)
+
+	expected := []struct {
+		start, end time.Time
+		price      int64
+		discounted bool
+	}{
+		{day(1), day(2), 100, false},
+		{day(2), day(3), 100, false},
+		{day(3), day(5).Add(time.Nanosecond), 75, true},
+		{day(5).Add(time.Nanosecond), day(8), 100, false},
+		{day(8), day(10), 120, false},
+	}
+
+	for i, want := range expected {
+		got := intervals[i]
+		assert.True(t, want.start.Equal(got.Start), "interval %d start", i)
+		assert.True(t, want.end.Equal(got.End), "interval %d end", i)
+		assert.Equal(t, want.price, got.EffectivePrice.Numerator(), "interval %d price", i)
+		assert.Equal(t, want.discounted, got.Discount != nil, "interval %d discount", i)
+	}
+}
+
+func TestCalculatePriceIntervalsIncludesScheduledWindows(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	price100, _ := domain.NewMoney(100, 1, "USD")
+	applied, err := domain.NewDiscount(big.NewRat(10, 1), day(2), day(3))
+	require.NoError(t, err)
+	scheduled, err := domain.NewDiscount(big.NewRat(50, 1), day(6), day(7))
+	require.NoError(t, err)
+
+	snapshot, _ := domain.NewPriceSnapshot(day(1), price100, applied, []*domain.Discount{scheduled})
+
+	intervals, err := NewPricingCalculator().CalculatePriceIntervals([]*domain.PriceSnapshot{snapshot}, day(1), day(10))
+	require.NoError(t, err)
+
+	var prices []int64
+	for _, interval := range intervals {
+		prices = append(prices, interval.EffectivePrice.Numerator())
+	}
+	assert.Equal(t, []int64{100, 90, 100, 50, 100}, prices)
+	assert.Same(t, scheduled, intervals[3].Discount)
+	assert.True(t, day(6).Equal(intervals[3].Start))
+}
+
+func TestCalculatePriceIntervalsRejectsEmptyRange(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewPricingCalculator().CalculatePriceIntervals(nil, now, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidDateRange)
+}
+
+func TestCalculateBundlePrice(t *testing.T) {
+	usd := func(num, denom int64) *domain.Money {
+		m, err := domain.NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+
+	camera, _ := domain.NewBundleComponent("camera", 1)
+	battery, _ := domain.NewBundleComponent("battery", 2)
+	prices := map[string]*domain.Money{
+		"camera":  usd(50000, 100), // $500.00
+		"battery": usd(3333, 100),  // $33.33
+	}
+
+	calculator := NewPricingCalculator()
+
+	tests := []struct {
+		name            string
+		discountPercent *big.Rat
+		discountAmount  *domain.Money
+		priceOverride   *domain.Money
+		expected        *big.Rat
+	}{
+		{"sum of components", nil, nil, nil, big.NewRat(56666, 100)},
+		{"percentage discount", big.NewRat(10, 1), nil, nil, big.NewRat(509994, 1000)},
+		{"fixed discount", nil, usd(6666, 100), nil, big.NewRat(500, 1)},
+		{"discount exceeding total", nil, usd(1000, 1), nil, big.NewRat(0, 1)},
+		{"price override", nil, nil, usd(450, 1), big.NewRat(450, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle, err := domain.NewBundle([]domain.BundleComponent{camera, battery}, tt.discountPercent, tt.discountAmount, tt.priceOverride)
+			require.NoError(t, err)
+
+			price, err := calculator.CalculateBundlePrice(bundle, prices)
+			require.NoError(t, err)
+			assert.Zero(t, price.Value().Cmp(tt.expected), "got %s", price.Value().RatString())
+		})
+	}
+
+	t.Run("missing component price", func(t *testing.T) {
+		bundle, _ := domain.NewBundle([]domain.BundleComponent{camera, battery}, nil, nil, nil)
+		_, err := calculator.CalculateBundlePrice(bundle, map[string]*domain.Money{"camera": usd(500, 1)})
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+
+	t.Run("mixed currencies", func(t *testing.T) {
+		eur, _ := domain.NewMoney(30, 1, "EUR")
+		bundle, _ := domain.NewBundle([]domain.BundleComponent{camera, battery}, nil, nil, nil)
+		_, err := calculator.CalculateBundlePrice(bundle, map[string]*domain.Money{"camera": usd(500, 1), "battery": eur})
+		assert.ErrorIs(t, err, domain.ErrCurrencyMismatch)
+	})
+}
+
+func TestCalculateQuantityPrice(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	usd := func(num, denom int64) *domain.Money {
+		m, err := domain.NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+
+	tier, err := domain.NewPriceTier(10, usd(17, 2)) // $8.50 from 10 units
+	require.NoError(t, err)
+	discount, err := domain.NewDiscount(big.NewRat(10, 1), day(3), day(5))
+	require.NoError(t, err)
+
+	calculator := NewPricingCalculator()
+
+	tests := []struct {
+		name        string
+		quantity    int64
+		at          time.Time
+		minQuantity int64
+		unit        *big.Rat
+		effective   *big.Rat
+		total       *big.Rat
+	}{
+		{"base price", 9, day(1), 1, big.NewRat(10, 1), big.NewRat(10, 1), big.NewRat(90, 1)},
+		{"tier price", 10, day(1), 10, big.NewRat(17, 2), big.NewRat(17, 2), big.NewRat(85, 1)},
+		{"tier price with discount", 12, day(4), 10, big.NewRat(17, 2), big.NewRat(153, 20), big.NewRat(459, 5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := calculator.CalculateQuantityPrice(usd(10, 1), []domain.PriceTier{tier}, discount, tt.quantity, tt.at)
+			require.NoError(t, err)
+			assert.Equal(t, tt.minQuantity, price.TierMinQuantity)
+			assert.Zero(t, price.UnitPrice.Value().Cmp(tt.unit), "unit price %s", price.UnitPrice.Value().RatString())
+			assert.Zero(t, price.EffectiveUnitPrice.Value().Cmp(tt.effective), "effective price %s", price.EffectiveUnitPrice.Value().RatString())
+			assert.Zero(t, price.LineTotal.Value().Cmp(tt.total), "line total %s", price.LineTotal.Value().RatString())
+			assert.Equal(t, tt.at.Equal(day(4)), price.Discount != nil)
+		})
+	}
+
+	_, err = calculator.CalculateQuantityPrice(usd(10, 1), nil, nil, 0, day(1))
+	assert.ErrorIs(t, err, domain.ErrInvalidQuantity)
+}
+
+func TestResolveBasePrice(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	usd := func(num, denom int64) *domain.Money {
+		m, err := domain.NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+
+	validTo := day(10)
+	list, err := domain.ReconstructPriceList("pl-1", "Wholesale", "USD", "wholesale", "", day(2), &validTo,
+		map[string]*domain.Money{"p-1": usd(8, 1)}, day(1), day(1), 0)
+	require.NoError(t, err)
+
+	calculator := NewPricingCalculator()
+	defaultPrice := usd(10, 1)
+
+	assert.True(t, calculator.ResolveBasePrice(defaultPrice, list, "p-1", day(5)).Equals(usd(8, 1)))
+	assert.Same(t, defaultPrice, calculator.ResolveBasePrice(defaultPrice, list, "p-2", day(5)), "unlisted products keep their default price")
+	assert.Same(t, defaultPrice, calculator.ResolveBasePrice(defaultPrice, list, "p-1", day(1)), "list not valid yet")
+	assert.Same(t, defaultPrice, calculator.ResolveBasePrice(defaultPrice, list, "p-1", day(11)), "list expired")
+	assert.Same(t, defaultPrice, calculator.ResolveBasePrice(defaultPrice, nil, "p-1", day(5)))
+
+	eur, _ := domain.NewMoney(10, 1, "EUR")
+	assert.Same(t, eur, calculator.ResolveBasePrice(eur, list, "p-1", day(5)), "list prices in another currency are ignored")
+
+	// Tiers priced above a lowered list price no longer apply
+	tier, err := domain.NewPriceTier(10, usd(9, 1))
+	require.NoError(t, err)
+	price, err := calculator.CalculateQuantityPrice(usd(8, 1), []domain.PriceTier{tier}, nil, 10, day(5))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), price.TierMinQuantity)
+	assert.True(t, price.UnitPrice.Equals(usd(8, 1)))
+}
+
+func TestCalculateTax(t *testing.T) {
+	eur := func(num, denom int64) *domain.Money {
+		m, err := domain.NewMoney(num, denom, "EUR")
+		require.NoError(t, err)
+		return m
+	}
+	rate := func(percent *big.Rat) domain.TaxRate {
+		r, err := domain.NewTaxRate("DE", "standard", percent)
+		require.NoError(t, err)
+		return r
+	}
+
+	calculator := NewPricingCalculator()
+
+	tests := []struct {
+		name    string
+		net     *domain.Money
+		percent *big.Rat
+		netExp  *big.Rat
+		tax     *big.Rat
+		gross   *big.Rat
+	}{
+		{"whole rate", eur(10, 1), big.NewRat(19, 1), big.NewRat(10, 1), big.NewRat(19, 10), big.NewRat(119, 10)},
+		{"fractional rate", eur(999, 100), big.NewRat(11, 2), big.NewRat(999, 100), big.NewRat(55, 100), big.NewRat(1054, 100)},
+		{"net rounded first", eur(10, 3), big.NewRat(19, 1), big.NewRat(333, 100), big.NewRat(63, 100), big.NewRat(396, 100)},
+		{"zero rate", eur(10, 1), new(big.Rat), big.NewRat(10, 1), new(big.Rat), big.NewRat(10, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxed, err := calculator.CalculateTax(tt.net, rate(tt.percent), domain.RoundHalfEven)
+			require.NoError(t, err)
+			assert.Zero(t, taxed.Net.Value().Cmp(tt.netExp), "net %s", taxed.Net.Value().RatString())
+			assert.Zero(t, taxed.Tax.Value().Cmp(tt.tax), "tax %s", taxed.Tax.Value().RatString())
+			assert.Zero(t, taxed.Gross.Value().Cmp(tt.gross), "gross %s", taxed.Gross.Value().RatString())
+		})
+	}
+
+	_, err := calculator.CalculateTax(nil, rate(big.NewRat(19, 1)), domain.RoundHalfEven)
+	assert.ErrorIs(t, err, domain.ErrInvalidPrice)
+}
+
+func TestCalculateQuote(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	usd := func(num, denom int64) *domain.Money {
+		m, err := domain.NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+	product := func(id string, price *domain.Money) *domain.Product {
+		p, err := domain.NewProduct(id, id, "", "kitchen", price, now)
+		require.NoError(t, err)
+		return p
+	}
+
+	mug := product("mug", usd(10, 1))
+	discount, err := domain.NewDiscount(big.NewRat(20, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, mug.ApplyDiscount(discount, now))
+
+	plate := product("plate", usd(499, 100))
+
+	inactive := product("bowl", usd(5, 1))
+	require.NoError(t, inactive.Deactivate(now))
+
+	archived := product("cup", usd(3, 1))
+	require.NoError(t, archived.Archive(now))
+
+	eur, _ := domain.NewMoney(7, 1, "EUR")
+	euroProduct := product("jug", eur)
+
+	products := map[string]*domain.Product{
+		"mug": mug, "plate": plate, "bowl": inactive, "cup": archived, "jug": euroProduct,
+	}
+
+	quote, err := NewPricingCalculator().CalculateQuote([]domain.QuoteItem{
+		{ProductID: "mug", Quantity: 3},
+		{ProductID: "plate", Quantity: 2},
+		{ProductID: "bowl", Quantity: 1},
+		{ProductID: "cup", Quantity: 1},
+		{ProductID: "jug", Quantity: 1},
+		{ProductID: "missing", Quantity: 1},
+		{ProductID: "plate", Quantity: 0},
+	}, products, nil, now)
+	require.NoError(t, err)
+	require.Len(t, quote.Lines, 7)
+
+	mugLine := quote.Lines[0]
+	require.NoError(t, mugLine.Err)
+	assert.True(t, mugLine.UnitPrice.Equals(usd(10, 1)))
+	assert.True(t, mugLine.EffectiveUnitPrice.Equals(usd(8, 1)))
+	assert.True(t, mugLine.LineTotal.Equals(usd(24, 1)))
+	assert.NotNil(t, mugLine.Discount)
+
+	plateLine := quote.Lines[1]
+	require.NoError(t, plateLine.Err)
+	assert.Nil(t, plateLine.Discount)
+	assert.True(t, plateLine.LineTotal.Equals(usd(998, 100)))
+
+	assert.ErrorIs(t, quote.Lines[2].Err, domain.ErrProductNotActive)
+	assert.ErrorIs(t, quote.Lines[3].Err, domain.ErrProductIsArchived)
+	assert.ErrorIs(t, quote.Lines[4].Err, domain.ErrCurrencyMismatch)
+	assert.ErrorIs(t, quote.Lines[5].Err, domain.ErrProductNotFound)
+	assert.ErrorIs(t, quote.Lines[6].Err, domain.ErrInvalidQuantity)
+
+	assert.True(t, quote.Total.Equals(usd(3398, 100)), "total %s", quote.Total.Value().RatString())
+
+	t.Run("every line rejected", func(t *testing.T) {
+		quote, err := NewPricingCalculator().CalculateQuote([]domain.QuoteItem{{ProductID: "cup", Quantity: 1}}, products, nil, now)
+		require.NoError(t, err)
+		assert.Nil(t, quote.Total)
+	})
+
+	t.Run("empty quote", func(t *testing.T) {
+		_, err := NewPricingCalculator().CalculateQuote(nil, products, nil, now)
+		assert.ErrorIs(t, err, domain.ErrInvalidQuote)
+	})
+
+	t.Run("quantity above the maximum", func(t *testing.T) {
+		quote, err := NewPricingCalculator().CalculateQuote([]domain.QuoteItem{
+			{ProductID: "plate", Quantity: domain.MaxPricedQuantity + 1},
+		}, products, nil, now)
+		require.NoError(t, err)
+		assert.ErrorIs(t, quote.Lines[0].Err, domain.ErrInvalidQuantity)
+	})
+
+	// Line totals and the grand total must fit the int64 fractions they are returned in
+	huge := map[string]*domain.Product{"crate": product("crate", usd(1<<62, 1))}
+
+	t.Run("line total beyond int64", func(t *testing.T) {
+		quote, err := NewPricingCalculator().CalculateQuote([]domain.QuoteItem{
+			{ProductID: "crate", Quantity: 2},
+		}, huge, nil, now)
+		require.NoError(t, err)
+		assert.ErrorIs(t, quote.Lines[0].Err, domain.ErrAmountOverflow)
+		assert.Nil(t, quote.Total)
+	})
+
+	t.Run("grand total beyond int64", func(t *testing.T) {
+		_, err := NewPricingCalculator().CalculateQuote([]domain.QuoteItem{
+			{ProductID: "crate", Quantity: 1},
+			{ProductID: "crate", Quantity: 1},
+		}, huge, nil, now)
+		assert.ErrorIs(t, err, domain.ErrAmountOverflow)
+	})
+}
+
+func TestCalculateQuotePricesTiersListsAndBundles(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	usd := func(num, denom int64) *domain.Money {
+		m, err := domain.NewMoney(num, denom, "USD")
+		require.NoError(t, err)
+		return m
+	}
+	product := func(id string, price *domain.Money) *domain.Product {
+		p, err := domain.NewProduct(id, id, "", "kitchen", price, now)
+		require.NoError(t, err)
+		return p
+	}
+	bundle := func(id string, componentIDs ...string) *domain.Product {
+		components := make([]domain.BundleComponent, 0, len(componentIDs))
+		for _, componentID := range componentIDs {
+			c, err := domain.NewBundleComponent(componentID, 2)
+			require.NoError(t, err)
+			components = append(components, c)
+		}
+		b, err := domain.NewBundle(components, big.NewRat(10, 1), nil, nil)
+		require.NoError(t, err)
+		p, err := domain.NewBundleProduct(id, id, "", "kitchen", b, usd(1, 1), now)
+		require.NoError(t, err)
+		return p
+	}
+
+	mug := product("mug", usd(10, 1))
+	tier, err := domain.NewPriceTier(10, usd(8, 1))
+	require.NoError(t, err)
+	require.NoError(t, mug.SetPriceTiers([]domain.PriceTier{tier}, now))
+
+	plate := product("plate", usd(5, 1))
+	bowl := product("bowl", usd(7, 1))
+	require.NoError(t, bowl.Deactivate(now))
+
+	products := map[string]*domain.Product{
+		"mug": mug, "plate": plate, "bowl": bowl,
+		"set": bundle("set", "mug", "plate"), "bowls": bundle("bowls", "bowl", "plate"),
+	}
+
+	list, err := domain.ReconstructPriceList("pl-1", "Wholesale", "USD", "wholesale", "", now.Add(-time.Hour), nil,
+		map[string]*domain.Money{"plate": usd(4, 1)}, now, now, 0)
+	require.NoError(t, err)
+
+	items := []domain.QuoteItem{
+		{ProductID: "mug", Quantity: 10},
+		{ProductID: "set", Quantity: 1},
+		{ProductID: "bowls", Quantity: 1},
+	}
+
+	quote, err := NewPricingCalculator().CalculateQuote(items, products, nil, now)
+	require.NoError(t, err)
+
+	// Test: The tier for the quantity prices the line
+	assert.Equal(t, int64(10), quote.Lines[0].TierMinQuantity)
+	assert.True(t, quote.Lines[0].LineTotal.Equals(usd(80, 1)))
+
+	// Test: Bundles derive their price from their components, not the stored price
+	require.NoError(t, quote.Lines[1].Err)
+	assert.True(t, quote.Lines[1].UnitPrice.Equals(usd(27, 1)), "(2*10 + 2*5) less 10%%")
+
+	// Test: A bundle with a component that cannot be sold is rejected
+	assert.ErrorIs(t, quote.Lines[2].Err, domain.ErrBundleUnavailable)
+
+	// Test: List prices apply to products and bundle components alike
+	quote, err = NewPricingCalculator().CalculateQuote(items, products, list, now)
+	require.NoError(t, err)
+	assert.True(t, quote.Lines[0].LineTotal.Equals(usd(80, 1)), "unlisted products keep their default price")
+	assert.True(t, quote.Lines[1].UnitPrice.Equals(usd(252, 10)), "(2*10 + 2*4) less 10%%")
+}
@@ -0,0 +1,172 @@
+package domain
+
+import "time"
+
+// Availability describes whether a product can be bought
+type Availability string
+
+const (
+	AvailabilityInStock    Availability = "in_stock"
+	AvailabilityLowStock   Availability = "low_stock"
+	AvailabilityOutOfStock Availability = "out_of_stock"
+
+	// AvailabilityUnavailable marks bundles with an archived or inactive component
+	AvailabilityUnavailable Availability = "unavailable"
+)
+
+// StockChange identifies the kind of change to a stock record
+type StockChange string
+
+const (
+	StockChangeReserved StockChange = "reserved"
+	StockChangeReleased StockChange = "released"
+	StockChangeAdjusted StockChange = "adjusted"
+)
+
+// AvailabilityOf derives the availability of a product with the given
+// available quantity: out of stock at zero, low stock at or below threshold
+func AvailabilityOf(available, lowStockThreshold int64) Availability {
+	switch {
+	case available <= 0:
+		return AvailabilityOutOfStock
+	case available <= lowStockThreshold:
+		return AvailabilityLowStock
+	default:
+		return AvailabilityInStock
+	}
+}
+
+// Stock is the aggregate root for a product's inventory. It is kept apart from
+// the Product aggregate so reservations do not contend with catalog edits.
+// Invariant: 0 <= reserved <= onHand.
+type Stock struct {
+	productID         string
+	onHand            int64
+	reserved          int64
+	lowStockThreshold int64
+	updatedAt         time.Time
+	changes           *ChangeTracker
+	events            []DomainEvent
+	version           int
+}
+
+// NewStock creates an empty stock record for a product
+func NewStock(productID string, now time.Time) *Stock {
+	return &Stock{
+		productID: productID,
+		updatedAt: now,
+		changes:   NewChangeTracker(),
+		events:    make([]DomainEvent, 0),
+	}
+}
+
+// ReconstructStock reconstructs a stock record from persistence
+func ReconstructStock(productID string, onHand, reserved, lowStockThreshold int64, updatedAt time.Time, version int) *Stock {
+	return &Stock{
+		productID:         productID,
+		onHand:            onHand,
+		reserved:          reserved,
+		lowStockThreshold: lowStockThreshold,
+		updatedAt:         updatedAt,
+		changes:           NewChangeTracker(),
+		events:            make([]DomainEvent, 0),
+		version:           version,
+	}
+}
+
+// Accessor methods
+
+func (s *Stock) ProductID() string        { return s.productID }
+func (s *Stock) OnHand() int64            { return s.onHand }
+func (s *Stock) Reserved() int64          { return s.reserved }
+func (s *Stock) LowStockThreshold() int64 { return s.lowStockThreshold }
+func (s *Stock) UpdatedAt() time.Time     { return s.updatedAt }
+func (s *Stock) Changes() *ChangeTracker  { return s.changes }
+func (s *Stock) Version() int             { return s.version }
+func (s *Stock) Available() int64         { return s.onHand - s.reserved }
+func (s *Stock) Availability() Availability {
+	return AvailabilityOf(s.Available(), s.lowStockThreshold)
+}
+
+// DomainEvents returns all recorded events
+func (s *Stock) DomainEvents() []DomainEvent {
+	return s.events
+}
+
+// Reserve holds quantity units of the available stock
+func (s *Stock) Reserve(quantity int64, now time.Time) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+	if quantity > s.Available() {
+		return ErrInsufficientStock
+	}
+
+	s.reserved += quantity
+	s.markLevelsChanged(now)
+	s.recordEvent(NewStockChangedEvent(s.productID, StockChangeReserved, quantity, s.onHand, s.reserved, ""))
+
+	return nil
+}
+
+// Release returns quantity reserved units to the available stock
+func (s *Stock) Release(quantity int64, now time.Time) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+	if quantity > s.reserved {
+		return ErrReleaseExceedsReserved
+	}
+
+	s.reserved -= quantity
+	s.markLevelsChanged(now)
+	s.recordEvent(NewStockChangedEvent(s.productID, StockChangeReleased, quantity, s.onHand, s.reserved, ""))
+
+	return nil
+}
+
+// Adjust changes the on-hand quantity by delta, e.g. +50 for a delivery or -2
+// for shrinkage. On-hand stock cannot drop below the reserved quantity.
+func (s *Stock) Adjust(delta int64, reasonCode string, now time.Time) error {
+	if delta == 0 {
+		return ErrInvalidQuantity
+	}
+	if s.onHand+delta < s.reserved {
+		return ErrInsufficientStock
+	}
+
+	s.onHand += delta
+	s.markLevelsChanged(now)
+	s.recordEvent(NewStockChangedEvent(s.productID, StockChangeAdjusted, delta, s.onHand, s.reserved, reasonCode))
+
+	return nil
+}
+
+// SetLowStockThreshold sets the available quantity at or below which the
+// product is reported as low stock
+func (s *Stock) SetLowStockThreshold(threshold int64, now time.Time) error {
+	if threshold < 0 {
+		return ErrInvalidThreshold
+	}
+
+	if s.lowStockThreshold == threshold {
+		return nil // Threshold unchanged
+	}
+
+	s.lowStockThreshold = threshold
+	s.updatedAt = now
+	s.changes.MarkDirty(FieldStockThreshold)
+
+	s.recordEvent(NewStockThresholdChangedEvent(s.productID, threshold))
+
+	return nil
+}
+
+func (s *Stock) markLevelsChanged(now time.Time) {
+	s.updatedAt = now
+	s.changes.MarkDirty(FieldStockLevels)
+}
+
+func (s *Stock) recordEvent(event DomainEvent) {
+	s.events = append(s.events, event)
+}
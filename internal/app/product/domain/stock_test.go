@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockReservationsKeepLevelsNonNegative(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stock := NewStock("p-1", now)
+
+	assert.ErrorIs(t, stock.Reserve(1, now), ErrInsufficientStock)
+	assert.ErrorIs(t, stock.Adjust(-1, "", now), ErrInsufficientStock)
+
+	require.NoError(t, stock.Adjust(10, "delivery", now))
+	require.NoError(t, stock.Reserve(7, now))
+	assert.Equal(t, int64(3), stock.Available())
+
+	assert.ErrorIs(t, stock.Reserve(4, now), ErrInsufficientStock)
+	assert.ErrorIs(t, stock.Reserve(0, now), ErrInvalidQuantity)
+	assert.ErrorIs(t, stock.Adjust(-4, "shrinkage", now), ErrInsufficientStock, "on-hand cannot drop below reserved")
+	assert.ErrorIs(t, stock.Release(8, now), ErrReleaseExceedsReserved)
+
+	require.NoError(t, stock.Release(2, now))
+	assert.Equal(t, int64(10), stock.OnHand())
+	assert.Equal(t, int64(5), stock.Reserved())
+	assert.True(t, stock.Changes().Dirty(FieldStockLevels))
+
+	events := stock.DomainEvents()
+	require.Len(t, events, 3)
+	adjusted := events[0].(StockChangedEvent)
+	assert.Equal(t, "adjusted", adjusted.Change)
+	assert.Equal(t, "delivery", adjusted.ReasonCode)
+	released := events[2].(StockChangedEvent)
+	assert.Equal(t, "released", released.Change)
+	assert.Equal(t, int64(2), released.Quantity)
+	assert.Equal(t, int64(5), released.Reserved)
+}
+
+func TestAvailabilityFollowsThreshold(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stock := NewStock("p-1", now)
+
+	assert.Equal(t, AvailabilityOutOfStock, stock.Availability())
+	assert.ErrorIs(t, stock.SetLowStockThreshold(-1, now), ErrInvalidThreshold)
+	require.NoError(t, stock.SetLowStockThreshold(5, now))
+
+	require.NoError(t, stock.Adjust(6, "", now))
+	assert.Equal(t, AvailabilityInStock, stock.Availability())
+
+	require.NoError(t, stock.Reserve(1, now))
+	assert.Equal(t, AvailabilityLowStock, stock.Availability(), "threshold is inclusive")
+
+	require.NoError(t, stock.Reserve(5, now))
+	assert.Equal(t, AvailabilityOutOfStock, stock.Availability())
+}
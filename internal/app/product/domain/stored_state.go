@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// StoredStateRetention is how far back the stored state of products can be
+// read. It matches the database's version retention period, Spanner's default
+// of one hour.
+const StoredStateRetention = time.Hour
+
+// ResolveStoredStateRead validates a read of the stored state at asOf and
+// reports whether it must read a past snapshot. Instants from now on read the
+// latest state; instants older than the retention period are rejected.
+func ResolveStoredStateRead(asOf, now time.Time) (bool, error) {
+	if !asOf.Before(now) {
+		return false, nil
+	}
+
+	if asOf.Before(now.Add(-StoredStateRetention)) {
+		return false, ErrStoredStateExpired
+	}
+
+	return true, nil
+}
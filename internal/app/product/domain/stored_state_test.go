@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveStoredStateRead(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	past, err := ResolveStoredStateRead(now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	assert.True(t, past)
+
+	past, err = ResolveStoredStateRead(now.Add(time.Hour), now)
+	require.NoError(t, err)
+	assert.False(t, past, "future instants read the latest state")
+
+	past, err = ResolveStoredStateRead(now.Add(-StoredStateRetention), now)
+	require.NoError(t, err)
+	assert.True(t, past)
+
+	_, err = ResolveStoredStateRead(now.Add(-StoredStateRetention-time.Second), now)
+	assert.ErrorIs(t, err, ErrStoredStateExpired)
+}
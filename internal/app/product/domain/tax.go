@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultTaxClass is the tax class of products that do not set one
+const DefaultTaxClass = "standard"
+
+var (
+	// Tax classes are lower-case slugs, e.g. "standard", "reduced" or "zero"
+	taxClassPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+
+	// Regions are ISO 3166 country codes with an optional subdivision, e.g. "DE" or "US-CA"
+	regionPattern = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,3})?$`)
+)
+
+// ParseTaxClass validates a tax class, defaulting an empty one to DefaultTaxClass
+func ParseTaxClass(class string) (string, error) {
+	class = strings.TrimSpace(class)
+	if class == "" {
+		return DefaultTaxClass, nil
+	}
+	if !taxClassPattern.MatchString(class) {
+		return "", ErrInvalidTaxClass
+	}
+	return class, nil
+}
+
+// ParseRegion validates and normalises a tax region to upper case
+func ParseRegion(region string) (string, error) {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if !regionPattern.MatchString(region) {
+		return "", ErrInvalidRegion
+	}
+	return region, nil
+}
+
+// TaxRate is the rate levied on products of a tax class sold in a region,
+// as an exact percentage of the net price, e.g. 19 for 19% VAT
+type TaxRate struct {
+	region   string
+	taxClass string
+	percent  *big.Rat
+}
+
+// NewTaxRate creates a new TaxRate value object. percent must be between 0 and 100.
+func NewTaxRate(region, taxClass string, percent *big.Rat) (TaxRate, error) {
+	region, err := ParseRegion(region)
+	if err != nil {
+		return TaxRate{}, err
+	}
+
+	taxClass, err = ParseTaxClass(taxClass)
+	if err != nil {
+		return TaxRate{}, err
+	}
+
+	if percent == nil || percent.Sign() < 0 || percent.Cmp(big.NewRat(100, 1)) > 0 {
+		return TaxRate{}, ErrInvalidTaxRate
+	}
+
+	return TaxRate{region: region, taxClass: taxClass, percent: new(big.Rat).Set(percent)}, nil
+}
+
+func (r TaxRate) Region() string   { return r.region }
+func (r TaxRate) TaxClass() string { return r.taxClass }
+
+// Percent returns the rate as a percentage of the net price
+func (r TaxRate) Percent() *big.Rat {
+	if r.percent == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(r.percent)
+}
+
+// TaxedAmount splits an amount into its net price, the tax levied on it and
+// the gross price the customer pays. Net and tax are rounded to the currency's
+// minor units, so net plus tax always equals gross.
+type TaxedAmount struct {
+	Rate  TaxRate
+	Net   *Money
+	Tax   *Money
+	Gross *Money
+}
+
+// TaxClass returns the tax class that selects the product's tax rate in each region
+func (p *Product) TaxClass() string {
+	if p.taxClass == "" {
+		return DefaultTaxClass
+	}
+	return p.taxClass
+}
+
+// SetTaxClass changes the product's tax class. An empty class resets it to DefaultTaxClass.
+func (p *Product) SetTaxClass(class string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	class, err := ParseTaxClass(class)
+	if err != nil {
+		return err
+	}
+
+	if p.TaxClass() == class {
+		return nil // Tax class unchanged
+	}
+
+	p.taxClass = class
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldTaxClass)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewProductTaxClassChangedEvent(p.id, class))
+
+	return nil
+}
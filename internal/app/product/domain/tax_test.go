@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaxClassAndRegion(t *testing.T) {
+	class, err := ParseTaxClass("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTaxClass, class)
+
+	class, err = ParseTaxClass("reduced")
+	require.NoError(t, err)
+	assert.Equal(t, "reduced", class)
+
+	for _, invalid := range []string{"Reduced", "1st", "reduced rate"} {
+		_, err = ParseTaxClass(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTaxClass, invalid)
+	}
+
+	region, err := ParseRegion("us-ca")
+	require.NoError(t, err)
+	assert.Equal(t, "US-CA", region)
+
+	for _, invalid := range []string{"", "DEU", "US-CALI", "D1"} {
+		_, err = ParseRegion(invalid)
+		assert.ErrorIs(t, err, ErrInvalidRegion, invalid)
+	}
+}
+
+func TestNewTaxRateValidation(t *testing.T) {
+	rate, err := NewTaxRate("de", "", big.NewRat(19, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "DE", rate.Region())
+	assert.Equal(t, DefaultTaxClass, rate.TaxClass())
+	assert.Zero(t, rate.Percent().Cmp(big.NewRat(19, 1)))
+
+	_, err = NewTaxRate("DE", "zero", new(big.Rat))
+	assert.NoError(t, err, "zero-rated classes are allowed")
+
+	_, err = NewTaxRate("DE", "standard", big.NewRat(-1, 1))
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+	_, err = NewTaxRate("DE", "standard", big.NewRat(101, 1))
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+	_, err = NewTaxRate("DE", "standard", nil)
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+}
+
+func TestProductSetTaxClass(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, _ := NewMoney(10, 1, "EUR")
+
+	product, err := NewProduct("p-1", "Book", "", "books", price, now)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTaxClass, product.TaxClass())
+	product.events = nil
+
+	assert.ErrorIs(t, product.SetTaxClass("Reduced Rate", now), ErrInvalidTaxClass)
+
+	require.NoError(t, product.SetTaxClass("reduced", now))
+	assert.Equal(t, "reduced", product.TaxClass())
+	assert.True(t, product.Changes().Dirty(FieldTaxClass))
+
+	require.NoError(t, product.SetTaxClass("reduced", now), "unchanged class is a no-op")
+	require.NoError(t, product.SetTaxClass("", now))
+	assert.Equal(t, DefaultTaxClass, product.TaxClass(), "empty class resets to the default")
+	assert.Equal(t, []string{"product.tax_class_changed", "product.tax_class_changed"}, eventTypes(product.DomainEvents()))
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SetTaxClass("reduced", now), ErrProductIsArchived)
+}
@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// VariantStatus represents the status of a product variant
+type VariantStatus string
+
+const (
+	VariantStatusActive  VariantStatus = "active"
+	VariantStatusRetired VariantStatus = "retired"
+)
+
+// Variant is a sellable version of a product, e.g. a size or colour, owned by
+// the Product aggregate
+type Variant struct {
+	id            string
+	sku           string
+	options       map[string]string // Option name to value, e.g. "size": "M"
+	priceOverride *Money            // Nil if the variant sells at the product's base price
+	status        VariantStatus
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// ReconstructVariant reconstructs a variant from persistence
+func ReconstructVariant(
+	id, sku string,
+	options map[string]string,
+	priceOverride *Money,
+	status string,
+	createdAt, updatedAt time.Time,
+) *Variant {
+	return &Variant{
+		id:            id,
+		sku:           sku,
+		options:       copyOptions(options),
+		priceOverride: priceOverride,
+		status:        VariantStatus(status),
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Accessor methods
+
+func (v *Variant) ID() string                 { return v.id }
+func (v *Variant) SKU() string                { return v.sku }
+func (v *Variant) PriceOverride() *Money      { return v.priceOverride }
+func (v *Variant) Status() VariantStatus      { return v.status }
+func (v *Variant) CreatedAt() time.Time       { return v.createdAt }
+func (v *Variant) UpdatedAt() time.Time       { return v.updatedAt }
+func (v *Variant) IsRetired() bool            { return v.status == VariantStatusRetired }
+func (v *Variant) Options() map[string]string { return copyOptions(v.options) }
+
+// Price returns the variant's price before discounts: its override, or basePrice
+func (v *Variant) Price(basePrice *Money) *Money {
+	if v.priceOverride != nil {
+		return v.priceOverride
+	}
+	return basePrice
+}
+
+// AddVariant adds a new active variant to the product.
+// A nil priceOverride sells the variant at the product's base price.
+func (p *Product) AddVariant(id, sku string, options map[string]string, priceOverride *Money, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	if p.bundle != nil {
+		return ErrBundleVariants
+	}
+
+	if err := p.validateVariant(id, sku, priceOverride); err != nil {
+		return err
+	}
+
+	variant := &Variant{
+		id:            id,
+		sku:           sku,
+		options:       copyOptions(options),
+		priceOverride: priceOverride,
+		status:        VariantStatusActive,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+	p.variants = append(p.variants, variant)
+
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldVariants)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewVariantAddedEvent(p.id, variant))
+
+	return nil
+}
+
+// UpdateVariant replaces the SKU, options and price override of an active variant
+func (p *Product) UpdateVariant(id, sku string, options map[string]string, priceOverride *Money, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	variant := p.findVariant(id)
+	if variant == nil {
+		return ErrVariantNotFound
+	}
+	if variant.IsRetired() {
+		return ErrVariantRetired
+	}
+
+	if err := p.validateVariant(id, sku, priceOverride); err != nil {
+		return err
+	}
+
+	if variant.sku == sku && optionsEqual(variant.options, options) && variant.priceOverride.Equals(priceOverride) {
+		return nil // Variant unchanged
+	}
+
+	variant.sku = sku
+	variant.options = copyOptions(options)
+	variant.priceOverride = priceOverride
+	variant.updatedAt = now
+
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldVariants)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewVariantUpdatedEvent(p.id, variant))
+
+	return nil
+}
+
+// RetireVariant stops selling a variant. The variant and its SKU are kept.
+func (p *Product) RetireVariant(id string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductIsArchived
+	}
+
+	variant := p.findVariant(id)
+	if variant == nil {
+		return ErrVariantNotFound
+	}
+
+	if variant.IsRetired() {
+		return nil // Already retired
+	}
+
+	variant.status = VariantStatusRetired
+	variant.updatedAt = now
+
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldVariants)
+	p.changes.MarkDirty(FieldStatus) // Status field includes updated_at
+
+	p.recordEvent(NewVariantRetiredEvent(p.id, id))
+
+	return nil
+}
+
+// Variants returns the product's variants ordered by creation
+func (p *Product) Variants() []*Variant {
+	variants := make([]*Variant, len(p.variants))
+	copy(variants, p.variants)
+	return variants
+}
+
+// VariantEffectivePrice calculates a variant's price after applying the
+// product's discount whose window contains now
+func (p *Product) VariantEffectivePrice(id string, now time.Time) (*Money, error) {
+	variant := p.findVariant(id)
+	if variant == nil {
+		return nil, ErrVariantNotFound
+	}
+
+	return effectivePrice(variant.Price(p.basePrice), p.DiscountAt(now), now)
+}
+
+// validateVariant checks a variant's SKU is set and unique within the product
+// and that its price override is in the product's currency
+func (p *Product) validateVariant(id, sku string, priceOverride *Money) error {
+	if id == "" || sku == "" {
+		return ErrInvalidSKU
+	}
+
+	for _, v := range p.variants {
+		if v.id != id && v.sku == sku {
+			return ErrDuplicateSKU
+		}
+	}
+
+	if priceOverride != nil && !p.basePrice.SameCurrency(priceOverride) {
+		return ErrCurrencyMismatch
+	}
+
+	return nil
+}
+
+// findVariant returns the variant with the given ID, or nil
+func (p *Product) findVariant(id string) *Variant {
+	for _, v := range p.variants {
+		if v.id == id {
+			return v
+		}
+	}
+	return nil
+}
+
+// sortVariants orders variants by creation
+func sortVariants(variants []*Variant) {
+	sort.SliceStable(variants, func(i, j int) bool {
+		return variants[i].createdAt.Before(variants[j].createdAt)
+	})
+}
+
+func copyOptions(options map[string]string) map[string]string {
+	c := make(map[string]string, len(options))
+	for k, v := range options {
+		c[k] = v
+	}
+	return c
+}
+
+func optionsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
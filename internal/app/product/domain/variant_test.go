@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantEffectivePriceInheritsProductDiscount(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "T-Shirt", "", "apparel", price, now)
+
+	xl, _ := NewMoney(120, 1, "USD")
+	require.NoError(t, product.AddVariant("v-m", "TS-M", map[string]string{"size": "M"}, nil, now))
+	require.NoError(t, product.AddVariant("v-xl", "TS-XL", map[string]string{"size": "XL"}, xl, now))
+
+	discount, _ := NewDiscount(big.NewRat(25, 1), now, now.AddDate(0, 0, 7))
+	require.NoError(t, product.ApplyDiscount(discount, now))
+
+	for id, want := range map[string]string{"v-m": "75.00 USD", "v-xl": "90.00 USD"} {
+		effective, err := product.VariantEffectivePrice(id, now)
+		require.NoError(t, err)
+		assert.Equal(t, want, effective.String())
+	}
+
+	_, err := product.VariantEffectivePrice("v-missing", now)
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+}
+
+func TestVariantRules(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	price, _ := NewMoney(100, 1, "USD")
+	product, _ := NewProduct("p-1", "Phone", "", "electronics", price, now)
+
+	require.NoError(t, product.AddVariant("v-1", "PH-128", map[string]string{"storage": "128GB"}, nil, now))
+	assert.ErrorIs(t, product.AddVariant("v-2", "PH-128", nil, nil, now), ErrDuplicateSKU)
+	assert.ErrorIs(t, product.AddVariant("v-2", "", nil, nil, now), ErrInvalidSKU)
+
+	eur, _ := NewMoney(90, 1, "EUR")
+	assert.ErrorIs(t, product.AddVariant("v-2", "PH-256", nil, eur, now), ErrCurrencyMismatch)
+
+	// Updating a variant may keep its own SKU
+	require.NoError(t, product.UpdateVariant("v-1", "PH-128", map[string]string{"storage": "128GB", "color": "black"}, nil, now))
+	assert.Equal(t, "black", product.Variants()[0].Options()["color"])
+
+	product.ClearEvents()
+	require.NoError(t, product.RetireVariant("v-1", now))
+	require.NoError(t, product.RetireVariant("v-1", now), "retiring twice is a no-op")
+	assert.Len(t, product.DomainEvents(), 1)
+
+	assert.ErrorIs(t, product.UpdateVariant("v-1", "PH-128", nil, nil, now), ErrVariantRetired)
+	assert.ErrorIs(t, product.AddVariant("v-2", "PH-128", nil, nil, now), ErrDuplicateSKU, "retired variants keep their SKU")
+	assert.ErrorIs(t, product.RetireVariant("v-missing", now), ErrVariantNotFound)
+}
@@ -0,0 +1,119 @@
+package get_catalog_facets
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/queries/list_products"
+)
+
+// MaxPriceBandBounds limits the bounds, and so the price bands, of a request
+const MaxPriceBandBounds = 20
+
+// ReadModel defines the interface for counting products
+type ReadModel interface {
+	GetCatalogFacets(ctx context.Context, filter contracts.CatalogFacetsFilter) (*contracts.CatalogFacetsDTO, error)
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// PriceBound is a bound between two price bands
+type PriceBound struct {
+	Numerator   int64
+	Denominator int64
+	Currency    string
+}
+
+// Request represents the get catalog facets query request
+type Request struct {
+	// Products selects the counted products like a listing; paging, sort
+	// order, price list and region are ignored
+	Products list_products.Request
+
+	// PriceBandBounds are the ascending bounds between price bands in one
+	// currency. n bounds count n+1 bands; none count no bands.
+	PriceBandBounds []PriceBound
+}
+
+// Response represents the get catalog facets query response
+type Response struct {
+	Facets *contracts.CatalogFacetsDTO
+}
+
+// Query handles counting the products of a catalog listing
+type Query struct {
+	readModel ReadModel
+	clock     Clock
+}
+
+// NewQuery creates a new get catalog facets query
+func NewQuery(readModel ReadModel, clock Clock) *Query {
+	return &Query{
+		readModel: readModel,
+		clock:     clock,
+	}
+}
+
+// Execute counts the products matching the request by category, status,
+// active discount and price band
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	products := req.Products
+	products.PageSize = 0
+	products.PageToken = ""
+	products.OrderBy = ""
+	products.PriceListID = ""
+	products.Region = ""
+
+	listing, err := list_products.NewFilter(products, q.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := contracts.CatalogFacetsFilter{Products: listing}
+	if err := setPriceBands(&filter, req.PriceBandBounds); err != nil {
+		return nil, err
+	}
+
+	facets, err := q.readModel.GetCatalogFacets(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Facets: facets,
+	}, nil
+}
+
+// setPriceBands validates the price band bounds into the filter
+func setPriceBands(filter *contracts.CatalogFacetsFilter, bounds []PriceBound) error {
+	if len(bounds) > MaxPriceBandBounds {
+		return domain.ErrInvalidPriceBands
+	}
+
+	var previous *domain.Money
+	for _, b := range bounds {
+		if b.Denominator == 0 {
+			return domain.ErrInvalidPrice
+		}
+
+		bound, err := domain.NewMoneyFromRat(big.NewRat(b.Numerator, b.Denominator), b.Currency)
+		if err != nil {
+			return err
+		}
+		if previous != nil && (!bound.SameCurrency(previous) || !bound.GreaterThan(previous)) {
+			return domain.ErrInvalidPriceBands
+		}
+
+		filter.PriceBandBounds = append(filter.PriceBandBounds, bound.Value())
+		filter.PriceBandCurrency = bound.Currency().Code()
+		previous = bound
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package get_category
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+)
+
+// ReadModel defines the interface for reading categories
+type ReadModel interface {
+	GetCategory(ctx context.Context, categoryID string) (*contracts.CategoryDTO, error)
+}
+
+// Request represents the get category query request
+type Request struct {
+	CategoryID string
+}
+
+// Response represents the get category query response
+type Response struct {
+	Category *contracts.CategoryDTO
+}
+
+// Query handles retrieving a category
+type Query struct {
+	readModel ReadModel
+}
+
+// NewQuery creates a new get category query
+func NewQuery(readModel ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves a category by ID
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	category, err := q.readModel.GetCategory(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Category: category,
+	}, nil
+}
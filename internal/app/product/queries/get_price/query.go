@@ -0,0 +1,207 @@
+package get_price
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+)
+
+// ReadModel defines the interface for reading products
+type ReadModel interface {
+	GetProduct(ctx context.Context, productID string, opts contracts.ReadOptions) (*contracts.ProductDTO, error)
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the get price query request
+type Request struct {
+	ProductID   string
+	Quantity    int64
+	AsOfSec     int64  // Optional, defaults to now
+	PriceListID string // Optional, prices the product from the price list
+	Region      string // Optional, adds the tax on the line total in the region
+}
+
+// Response represents the get price query response
+type Response struct {
+	Price *contracts.QuantityPriceDTO
+}
+
+// Query handles pricing a quantity of a product
+type Query struct {
+	readModel  ReadModel
+	calculator *services.PricingCalculator
+	rounding   domain.RoundingMode
+	clock      Clock
+}
+
+// NewQuery creates a new get price query that renders decimal prices with the
+// given rounding mode
+func NewQuery(readModel ReadModel, calculator *services.PricingCalculator, rounding domain.RoundingMode, clock Clock) *Query {
+	return &Query{
+		readModel:  readModel,
+		calculator: calculator,
+		rounding:   rounding,
+		clock:      clock,
+	}
+}
+
+// Execute prices a quantity of a product from its tier for the quantity and
+// the discount active at the requested time
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	if req.Quantity <= 0 || req.Quantity > domain.MaxPricedQuantity {
+		return nil, domain.ErrInvalidQuantity
+	}
+
+	// Discount dates are read with second precision, so price at a whole second
+	asOf := q.clock.Now().Truncate(time.Second)
+	if req.AsOfSec > 0 {
+		asOf = time.Unix(req.AsOfSec, 0)
+	}
+
+	product, err := q.readModel.GetProduct(ctx, req.ProductID, contracts.ReadOptions{
+		AsOf:        asOf,
+		PriceListID: req.PriceListID,
+		Region:      req.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	basePrice, tiers, discount, err := toPricing(product)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := q.calculator.CalculateQuantityPrice(basePrice, tiers, discount, req.Quantity, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.CheckFractions(price.UnitPrice, price.EffectiveUnitPrice, price.LineTotal); err != nil {
+		return nil, err
+	}
+
+	dto := q.toQuantityPriceDTO(product.ProductID, price)
+
+	// The read model resolved the product's tax rate in the region
+	if product.Tax != nil {
+		rate, err := domain.NewTaxRate(product.Tax.Region, product.Tax.TaxClass, product.Tax.RatePercent)
+		if err != nil {
+			return nil, err
+		}
+
+		taxed, err := q.calculator.CalculateTax(price.LineTotal, rate, q.rounding)
+		if err != nil {
+			return nil, err
+		}
+		if err := domain.CheckFractions(taxed.Net, taxed.Tax, taxed.Gross); err != nil {
+			return nil, err
+		}
+		dto.Tax = q.toTaxDTO(taxed)
+	}
+
+	return &Response{
+		Price: dto,
+	}, nil
+}
+
+// toPricing rebuilds the base price, tiers and active discount of a product
+func toPricing(dto *contracts.ProductDTO) (*domain.Money, []domain.PriceTier, *domain.Discount, error) {
+	basePrice, err := domain.NewMoney(dto.BasePriceNumerator, dto.BasePriceDenominator, dto.Currency)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tiers := make([]domain.PriceTier, 0, len(dto.PriceTiers))
+	for _, t := range dto.PriceTiers {
+		price, err := domain.NewMoney(t.PriceNumerator, t.PriceDenominator, dto.Currency)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		tier, err := domain.NewPriceTier(t.MinQuantity, price)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+
+	var discount *domain.Discount
+	if dto.HasDiscount && dto.DiscountStartDate != nil && dto.DiscountEndDate != nil {
+		var amountNum, amountDenom int64
+		if dto.DiscountAmountNumerator != nil && dto.DiscountAmountDenominator != nil {
+			amountNum, amountDenom = *dto.DiscountAmountNumerator, *dto.DiscountAmountDenominator
+		}
+
+		discount, err = domain.ReconstructDiscount(
+			dto.DiscountKind,
+			dto.DiscountPercent,
+			amountNum,
+			amountDenom,
+			dto.Currency,
+			time.Unix(*dto.DiscountStartDate, 0),
+			time.Unix(*dto.DiscountEndDate, 0),
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return basePrice, tiers, discount, nil
+}
+
+func (q *Query) toQuantityPriceDTO(productID string, price *domain.QuantityPrice) *contracts.QuantityPriceDTO {
+	dto := &contracts.QuantityPriceDTO{
+		ProductID:                     productID,
+		Quantity:                      price.Quantity,
+		TierMinQuantity:               price.TierMinQuantity,
+		Currency:                      price.UnitPrice.Currency().Code(),
+		UnitPriceNumerator:            price.UnitPrice.Numerator(),
+		UnitPriceDenominator:          price.UnitPrice.Denominator(),
+		EffectiveUnitPriceNumerator:   price.EffectiveUnitPrice.Numerator(),
+		EffectiveUnitPriceDenominator: price.EffectiveUnitPrice.Denominator(),
+		LineTotalNumerator:            price.LineTotal.Numerator(),
+		LineTotalDenominator:          price.LineTotal.Denominator(),
+		UnitPriceDecimal:              price.UnitPrice.Format(q.rounding),
+		EffectiveUnitPriceDecimal:     price.EffectiveUnitPrice.Format(q.rounding),
+		LineTotalDecimal:              price.LineTotal.Format(q.rounding),
+	}
+
+	if d := price.Discount; d != nil {
+		dto.HasDiscount = true
+		dto.DiscountKind = string(d.Kind())
+		if amount := d.Amount(); amount != nil {
+			dto.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+			dto.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+		} else {
+			dto.DiscountPercent = d.Percentage()
+		}
+		dto.DiscountStartDate = &[]int64{d.StartDate().Unix()}[0]
+		dto.DiscountEndDate = &[]int64{d.EndDate().Unix()}[0]
+	}
+
+	return dto
+}
+
+func (q *Query) toTaxDTO(taxed *domain.TaxedAmount) *contracts.TaxDTO {
+	return &contracts.TaxDTO{
+		Region:           taxed.Rate.Region(),
+		TaxClass:         taxed.Rate.TaxClass(),
+		RatePercent:      taxed.Rate.Percent(),
+		NetNumerator:     taxed.Net.Numerator(),
+		NetDenominator:   taxed.Net.Denominator(),
+		TaxNumerator:     taxed.Tax.Numerator(),
+		TaxDenominator:   taxed.Tax.Denominator(),
+		GrossNumerator:   taxed.Gross.Numerator(),
+		GrossDenominator: taxed.Gross.Denominator(),
+		NetDecimal:       taxed.Net.Format(q.rounding),
+		TaxDecimal:       taxed.Tax.Format(q.rounding),
+		GrossDecimal:     taxed.Gross.Format(q.rounding),
+	}
+}
@@ -0,0 +1,168 @@
+package get_price_history
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+)
+
+// ReadModel defines the interface for reading price history
+type ReadModel interface {
+	GetPriceHistory(ctx context.Context, productID string, to time.Time) ([]*contracts.PriceSnapshotDTO, error)
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the get price history query request
+type Request struct {
+	ProductID string
+	FromSec   int64 // Optional, defaults to the beginning of history
+	ToSec     int64 // Optional, defaults to now
+}
+
+// Response represents the get price history query response
+type Response struct {
+	Intervals []*contracts.PriceIntervalDTO
+}
+
+// Query handles getting the price history of a product
+type Query struct {
+	readModel  ReadModel
+	calculator *services.PricingCalculator
+	clock      Clock
+}
+
+// NewQuery creates a new get price history query
+func NewQuery(readModel ReadModel, calculator *services.PricingCalculator, clock Clock) *Query {
+	return &Query{
+		readModel:  readModel,
+		calculator: calculator,
+		clock:      clock,
+	}
+}
+
+// Execute retrieves the effective price intervals of a product within a time range
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	from := time.Unix(req.FromSec, 0)
+	to := q.clock.Now()
+	if req.ToSec != 0 {
+		to = time.Unix(req.ToSec, 0)
+	}
+
+	if !from.Before(to) {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	rows, err := q.readModel.GetPriceHistory(ctx, req.ProductID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*domain.PriceSnapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshot, err := toSnapshot(row)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	intervals, err := q.calculator.CalculatePriceIntervals(snapshots, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*contracts.PriceIntervalDTO, 0, len(intervals))
+	for _, interval := range intervals {
+		result = append(result, toIntervalDTO(interval))
+	}
+
+	return &Response{
+		Intervals: result,
+	}, nil
+}
+
+func toSnapshot(row *contracts.PriceSnapshotDTO) (*domain.PriceSnapshot, error) {
+	basePrice, err := domain.NewMoney(row.BasePriceNumerator, row.BasePriceDenominator, row.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var discount *domain.Discount
+	if (row.DiscountPercent != nil || row.DiscountAmountNumerator != nil) && row.DiscountStartDate != nil && row.DiscountEndDate != nil {
+		var amountNum, amountDenom int64
+		if row.DiscountAmountNumerator != nil && row.DiscountAmountDenominator != nil {
+			amountNum, amountDenom = *row.DiscountAmountNumerator, *row.DiscountAmountDenominator
+		}
+
+		discount, err = domain.ReconstructDiscount(
+			row.DiscountKind,
+			row.DiscountPercent,
+			amountNum,
+			amountDenom,
+			row.Currency,
+			*row.DiscountStartDate,
+			*row.DiscountEndDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	schedule := make([]*domain.Discount, 0, len(row.Schedule))
+	for _, s := range row.Schedule {
+		var amountNum, amountDenom int64
+		if s.DiscountAmountNumerator != nil && s.DiscountAmountDenominator != nil {
+			amountNum, amountDenom = *s.DiscountAmountNumerator, *s.DiscountAmountDenominator
+		}
+
+		scheduled, err := domain.ReconstructDiscount(
+			s.DiscountKind,
+			s.DiscountPercent,
+			amountNum,
+			amountDenom,
+			row.Currency,
+			s.StartDate,
+			s.EndDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, scheduled)
+	}
+
+	return domain.NewPriceSnapshot(row.EffectiveFrom, basePrice, discount, schedule)
+}
+
+func toIntervalDTO(interval domain.PriceInterval) *contracts.PriceIntervalDTO {
+	dto := &contracts.PriceIntervalDTO{
+		StartSec:                  interval.Start.Unix(),
+		EndSec:                    interval.End.Unix(),
+		BasePriceNumerator:        interval.BasePrice.Numerator(),
+		BasePriceDenominator:      interval.BasePrice.Denominator(),
+		Currency:                  interval.BasePrice.Currency().Code(),
+		EffectivePriceNumerator:   interval.EffectivePrice.Numerator(),
+		EffectivePriceDenominator: interval.EffectivePrice.Denominator(),
+	}
+
+	if d := interval.Discount; d != nil {
+		dto.HasDiscount = true
+		dto.DiscountKind = string(d.Kind())
+		if amount := d.Amount(); amount != nil {
+			dto.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+			dto.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+		} else {
+			dto.DiscountPercent = d.Percentage()
+		}
+		dto.DiscountStartDate = &[]int64{d.StartDate().Unix()}[0]
+		dto.DiscountEndDate = &[]int64{d.EndDate().Unix()}[0]
+	}
+
+	return dto
+}
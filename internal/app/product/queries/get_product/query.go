@@ -2,17 +2,29 @@ package get_product
 
 import (
 	"context"
+	"time"
+
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
 )
 
 // ReadModel defines the interface for reading products
 type ReadModel interface {
-	GetProduct(ctx context.Context, productID string) (*contracts.ProductDTO, error)
+	GetProduct(ctx context.Context, productID string, opts contracts.ReadOptions) (*contracts.ProductDTO, error)
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
 }
 
 // Request represents the get product query request
 type Request struct {
-	ProductID string
+	ProductID       string
+	AsOfSec         int64  // Optional, defaults to now
+	ReadStoredState bool   // Read the stored state at AsOfSec instead of the latest state
+	PriceListID     string // Optional, prices the product from the price list
+	Region          string // Optional, adds net, tax and gross amounts for the region
 }
 
 // Response represents the get product query response
@@ -23,18 +35,37 @@ type Response struct {
 // Query handles getting a product by ID
 type Query struct {
 	readModel ReadModel
+	clock     Clock
 }
 
 // NewQuery creates a new get product query
-func NewQuery(readModel ReadModel) *Query {
+func NewQuery(readModel ReadModel, clock Clock) *Query {
 	return &Query{
 		readModel: readModel,
+		clock:     clock,
 	}
 }
 
 // Execute retrieves a product by ID
 func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
-	product, err := q.readModel.GetProduct(ctx, req.ProductID)
+	now := q.clock.Now()
+	opts := contracts.ReadOptions{
+		AsOf:        now,
+		PriceListID: req.PriceListID,
+		Region:      req.Region,
+	}
+	if req.AsOfSec > 0 {
+		opts.AsOf = time.Unix(req.AsOfSec, 0)
+	}
+	if req.ReadStoredState {
+		past, err := domain.ResolveStoredStateRead(opts.AsOf, now)
+		if err != nil {
+			return nil, err
+		}
+		opts.ReadStoredState = past
+	}
+
+	product, err := q.readModel.GetProduct(ctx, req.ProductID, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,46 @@
+package list_attribute_definitions
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+)
+
+// ReadModel defines the interface for reading attribute definitions
+type ReadModel interface {
+	ListAttributeDefinitions(ctx context.Context, category string) ([]*contracts.AttributeDefinitionDTO, error)
+}
+
+// Request represents the list attribute definitions query request
+type Request struct {
+	Category string
+}
+
+// Response represents the list attribute definitions query response
+type Response struct {
+	Definitions []*contracts.AttributeDefinitionDTO
+}
+
+// Query handles listing the attribute definitions of a category
+type Query struct {
+	readModel ReadModel
+}
+
+// NewQuery creates a new list attribute definitions query
+func NewQuery(readModel ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves the attribute definitions of a category ordered by name
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	definitions, err := q.readModel.ListAttributeDefinitions(ctx, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Definitions: definitions,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package list_categories
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+)
+
+// ReadModel defines the interface for reading categories
+type ReadModel interface {
+	ListCategories(ctx context.Context, filter contracts.ListCategoriesFilter) ([]*contracts.CategoryDTO, error)
+}
+
+// Request represents the list categories query request
+type Request struct {
+	ParentID        string // Optional, lists only the direct children of this category
+	IncludeArchived bool
+}
+
+// Response represents the list categories query response
+type Response struct {
+	Categories []*contracts.CategoryDTO
+}
+
+// Query handles listing categories
+type Query struct {
+	readModel ReadModel
+}
+
+// NewQuery creates a new list categories query
+func NewQuery(readModel ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves the children of a category ordered by name, or the whole
+// taxonomy in tree order
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	categories, err := q.readModel.ListCategories(ctx, contracts.ListCategoriesFilter{
+		ParentID:        req.ParentID,
+		IncludeArchived: req.IncludeArchived,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Categories: categories,
+	}, nil
+}
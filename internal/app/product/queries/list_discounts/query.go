@@ -0,0 +1,46 @@
+package list_discounts
+
+import (
+	"context"
+
+	"product-catalog-service/internal/app/product/contracts"
+)
+
+// ReadModel defines the interface for reading scheduled discounts
+type ReadModel interface {
+	ListDiscounts(ctx context.Context, productID string) ([]*contracts.ScheduledDiscountDTO, error)
+}
+
+// Request represents the list discounts query request
+type Request struct {
+	ProductID string
+}
+
+// Response represents the list discounts query response
+type Response struct {
+	Discounts []*contracts.ScheduledDiscountDTO
+}
+
+// Query handles listing the scheduled discounts of a product
+type Query struct {
+	readModel ReadModel
+}
+
+// NewQuery creates a new list discounts query
+func NewQuery(readModel ReadModel) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves the scheduled discounts of a product ordered by start date
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	discounts, err := q.readModel.ListDiscounts(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Discounts: discounts,
+	}, nil
+}
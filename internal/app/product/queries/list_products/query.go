@@ -2,7 +2,12 @@ package list_products
 
 import (
 	"context"
+	"math/big"
+	"strings"
+	"time"
+
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
 )
 
 // ReadModel defines the interface for reading products
@@ -10,12 +15,43 @@ type ReadModel interface {
 	ListProducts(ctx context.Context, filter contracts.ListProductsFilter) (*contracts.PaginatedProductsDTO, error)
 }
 
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
 // Request represents the list products query request
 type Request struct {
-	Category  string
-	PageSize  int
-	PageToken string
-	Status    string
+	Category        string // Category ID
+	PageSize        int
+	PageToken       string
+	AsOfSec         int64  // Optional, defaults to now
+	ReadStoredState bool   // Read the stored state at AsOfSec instead of the latest state
+	PriceListID     string // Optional, prices the products from the price list
+	Region          string // Optional, adds net, tax and gross amounts for the region
+
+	// Statuses matches products in any of the statuses. Without statuses,
+	// archived products are excluded unless IncludeArchived is set.
+	Statuses        []string
+	IncludeArchived bool
+
+	// IncludeSubcategories also matches products in descendants of Category
+	IncludeSubcategories bool
+
+	// AttributeFilters restricts products to those whose attributes match every filter
+	AttributeFilters []contracts.AttributeFilter
+
+	// OrderBy is "name", "created_at", "updated_at" or "effective_price",
+	// optionally followed by " asc" or " desc". Defaults to product ID order.
+	OrderBy string
+
+	// Optional inclusive price range; zero leaves a bound unset
+	MinPriceNumerator   int64
+	MinPriceDenominator int64
+	MinPriceCurrency    string
+	MaxPriceNumerator   int64
+	MaxPriceDenominator int64
+	MaxPriceCurrency    string
 }
 
 // Response represents the list products query response
@@ -27,22 +63,22 @@ type Response struct {
 // Query handles listing products
 type Query struct {
 	readModel ReadModel
+	clock     Clock
 }
 
 // NewQuery creates a new list products query
-func NewQuery(readModel ReadModel) *Query {
+func NewQuery(readModel ReadModel, clock Clock) *Query {
 	return &Query{
 		readModel: readModel,
+		clock:     clock,
 	}
 }
 
 // Execute retrieves a list of products
 func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
-	filter := contracts.ListProductsFilter{
-		Category:  req.Category,
-		PageSize:  req.PageSize,
-		PageToken: req.PageToken,
-		Status:    req.Status,
+	filter, err := NewFilter(req, q.clock.Now())
+	if err != nil {
+		return nil, err
 	}
 
 	result, err := q.readModel.ListProducts(ctx, filter)
@@ -55,3 +91,128 @@ func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
 		NextPageToken: result.NextPageToken,
 	}, nil
 }
+
+// NewFilter validates a request into the read model filter, evaluated at now
+// unless the request sets AsOfSec. Catalog facets count the products of the
+// same filters.
+func NewFilter(req Request, now time.Time) (contracts.ListProductsFilter, error) {
+	filter := contracts.ListProductsFilter{
+		Category:  req.Category,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+
+		IncludeArchived: req.IncludeArchived,
+
+		IncludeSubcategories: req.IncludeSubcategories,
+		AttributeFilters:     req.AttributeFilters,
+
+		ReadOptions: contracts.ReadOptions{
+			AsOf:        now,
+			PriceListID: req.PriceListID,
+			Region:      req.Region,
+		},
+	}
+	if req.AsOfSec > 0 {
+		filter.ReadOptions.AsOf = time.Unix(req.AsOfSec, 0)
+	}
+	if req.ReadStoredState {
+		past, err := domain.ResolveStoredStateRead(filter.ReadOptions.AsOf, now)
+		if err != nil {
+			return contracts.ListProductsFilter{}, err
+		}
+		filter.ReadOptions.ReadStoredState = past
+	}
+
+	for _, s := range req.Statuses {
+		status, err := domain.ParseProductStatus(s)
+		if err != nil {
+			return contracts.ListProductsFilter{}, err
+		}
+		filter.Statuses = append(filter.Statuses, string(status))
+	}
+
+	sortBy, descending, err := parseOrderBy(req.OrderBy)
+	if err != nil {
+		return contracts.ListProductsFilter{}, err
+	}
+	filter.SortBy = sortBy
+	filter.Descending = descending
+
+	if err := setPriceRange(&filter, req); err != nil {
+		return contracts.ListProductsFilter{}, err
+	}
+
+	return filter, nil
+}
+
+// parseOrderBy parses an order_by value of the form "field[ asc|desc]"
+func parseOrderBy(orderBy string) (contracts.ProductSort, bool, error) {
+	fields := strings.Fields(orderBy)
+	if len(fields) == 0 {
+		return contracts.SortByProductID, false, nil
+	}
+	if len(fields) > 2 {
+		return "", false, domain.ErrUnsupportedSortOrder
+	}
+
+	var descending bool
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "asc":
+		case "desc":
+			descending = true
+		default:
+			return "", false, domain.ErrUnsupportedSortOrder
+		}
+	}
+
+	switch sort := contracts.ProductSort(fields[0]); sort {
+	case contracts.SortByName, contracts.SortByCreatedAt, contracts.SortByUpdatedAt, contracts.SortByEffectivePrice:
+		return sort, descending, nil
+	default:
+		return "", false, domain.ErrUnsupportedSortOrder
+	}
+}
+
+// setPriceRange sets the filter's price range from the request's bounds. Both
+// bounds must share a currency and min must not exceed max.
+func setPriceRange(filter *contracts.ListProductsFilter, req Request) error {
+	minPrice, err := newPriceBound(req.MinPriceNumerator, req.MinPriceDenominator, req.MinPriceCurrency)
+	if err != nil {
+		return err
+	}
+	maxPrice, err := newPriceBound(req.MaxPriceNumerator, req.MaxPriceDenominator, req.MaxPriceCurrency)
+	if err != nil {
+		return err
+	}
+
+	if minPrice != nil && maxPrice != nil {
+		if !minPrice.SameCurrency(maxPrice) || minPrice.GreaterThan(maxPrice) {
+			return domain.ErrInvalidPriceRange
+		}
+	}
+
+	if minPrice != nil {
+		filter.MinPrice = minPrice.Value()
+		filter.PriceCurrency = minPrice.Currency().Code()
+	}
+	if maxPrice != nil {
+		filter.MaxPrice = maxPrice.Value()
+		filter.PriceCurrency = maxPrice.Currency().Code()
+	}
+
+	return nil
+}
+
+// newPriceBound returns a price range bound, or nil if the request leaves it
+// unset. Zero is a valid lower bound.
+func newPriceBound(numerator, denominator int64, currency string) (*domain.Money, error) {
+	if numerator == 0 && denominator == 0 {
+		return nil, nil
+	}
+	if denominator == 0 {
+		return nil, domain.ErrInvalidPrice
+	}
+
+	return domain.NewMoneyFromRat(big.NewRat(numerator, denominator), currency)
+}
@@ -0,0 +1,199 @@
+package quote_prices
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+)
+
+// ProductReader loads the pricing state of several products
+type ProductReader interface {
+	FindForPricing(ctx context.Context, productIDs []string) (map[string]*domain.Product, error)
+}
+
+// PriceListReader loads a price list with its entries for the given products
+type PriceListReader interface {
+	FindByID(ctx context.Context, priceListID string, productIDs ...string) (*domain.PriceList, error)
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Item is a requested line of a quote
+type Item struct {
+	ProductID string
+	Quantity  int64
+}
+
+// Request represents the quote prices query request
+type Request struct {
+	Items       []Item
+	AsOfSec     int64  // Optional, defaults to now
+	PriceListID string // Optional, prices products from the price list
+}
+
+// Response represents the quote prices query response
+type Response struct {
+	Quote *contracts.QuoteDTO
+}
+
+// Query handles pricing the lines of a cart
+type Query struct {
+	reader     ProductReader
+	priceLists PriceListReader
+	calculator *services.PricingCalculator
+	rounding   domain.RoundingMode
+	clock      Clock
+}
+
+// NewQuery creates a new quote prices query that renders decimal prices with
+// the given rounding mode
+func NewQuery(reader ProductReader, priceLists PriceListReader, calculator *services.PricingCalculator, rounding domain.RoundingMode, clock Clock) *Query {
+	return &Query{
+		reader:     reader,
+		priceLists: priceLists,
+		calculator: calculator,
+		rounding:   rounding,
+		clock:      clock,
+	}
+}
+
+// Execute prices each line from its product's price, in the price list if
+// requested, its tier for the quantity and the discount active at the
+// requested time; bundles are priced from their components. Lines that cannot
+// be priced are rejected individually rather than failing the quote.
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	if len(req.Items) == 0 || len(req.Items) > domain.MaxQuoteLines {
+		return nil, domain.ErrInvalidQuote
+	}
+
+	// Discount dates are stored with second precision, so price at a whole second
+	asOf := q.clock.Now().Truncate(time.Second)
+	if req.AsOfSec > 0 {
+		asOf = time.Unix(req.AsOfSec, 0)
+	}
+
+	items := make([]domain.QuoteItem, 0, len(req.Items))
+	productIDs := make([]string, 0, len(req.Items))
+	seen := make(map[string]bool, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domain.QuoteItem{ProductID: item.ProductID, Quantity: item.Quantity})
+		if !seen[item.ProductID] {
+			seen[item.ProductID] = true
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+
+	products, err := q.reader.FindForPricing(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The list is loaded with the entries of every product read, bundle components included
+	var list *domain.PriceList
+	if req.PriceListID != "" {
+		listed := make([]string, 0, len(products))
+		for id := range products {
+			listed = append(listed, id)
+		}
+
+		list, err = q.priceLists.FindByID(ctx, req.PriceListID, listed...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	quote, err := q.calculator.CalculateQuote(items, products, list, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Quote: q.toQuoteDTO(quote),
+	}, nil
+}
+
+func (q *Query) toQuoteDTO(quote *domain.Quote) *contracts.QuoteDTO {
+	dto := &contracts.QuoteDTO{
+		Lines: make([]*contracts.QuoteLineDTO, 0, len(quote.Lines)),
+	}
+
+	for _, line := range quote.Lines {
+		dto.Lines = append(dto.Lines, q.toQuoteLineDTO(line))
+	}
+
+	if total := quote.Total; total != nil {
+		dto.Currency = total.Currency().Code()
+		dto.TotalNumerator = total.Numerator()
+		dto.TotalDenominator = total.Denominator()
+		dto.TotalDecimal = total.Format(q.rounding)
+	}
+
+	return dto
+}
+
+func (q *Query) toQuoteLineDTO(line domain.QuoteLine) *contracts.QuoteLineDTO {
+	dto := &contracts.QuoteLineDTO{
+		ProductID: line.ProductID,
+		Quantity:  line.Quantity,
+	}
+
+	if line.Err != nil {
+		dto.RejectionReason = rejectionReason(line.Err)
+		return dto
+	}
+
+	dto.TierMinQuantity = line.TierMinQuantity
+	dto.UnitPriceNumerator = line.UnitPrice.Numerator()
+	dto.UnitPriceDenominator = line.UnitPrice.Denominator()
+	dto.EffectiveUnitPriceNumerator = line.EffectiveUnitPrice.Numerator()
+	dto.EffectiveUnitPriceDenominator = line.EffectiveUnitPrice.Denominator()
+	dto.LineTotalNumerator = line.LineTotal.Numerator()
+	dto.LineTotalDenominator = line.LineTotal.Denominator()
+	dto.UnitPriceDecimal = line.UnitPrice.Format(q.rounding)
+	dto.EffectiveUnitPriceDecimal = line.EffectiveUnitPrice.Format(q.rounding)
+	dto.LineTotalDecimal = line.LineTotal.Format(q.rounding)
+
+	if d := line.Discount; d != nil {
+		dto.HasDiscount = true
+		dto.DiscountKind = string(d.Kind())
+		if amount := d.Amount(); amount != nil {
+			dto.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+			dto.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+		} else {
+			dto.DiscountPercent = d.Percentage()
+		}
+		dto.DiscountStartDate = &[]int64{d.StartDate().Unix()}[0]
+		dto.DiscountEndDate = &[]int64{d.EndDate().Unix()}[0]
+	}
+
+	return dto
+}
+
+// rejectionReason names the reason a quote line was rejected
+func rejectionReason(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		return "not_found"
+	case errors.Is(err, domain.ErrProductIsArchived):
+		return "archived"
+	case errors.Is(err, domain.ErrProductNotActive):
+		return "inactive"
+	case errors.Is(err, domain.ErrBundleUnavailable):
+		return "bundle_unavailable"
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		return "invalid_quantity"
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		return "currency_mismatch"
+	case errors.Is(err, domain.ErrAmountOverflow):
+		return "amount_overflow"
+	default:
+		return "price_unavailable"
+	}
+}
@@ -0,0 +1,83 @@
+package search_products
+
+import (
+	"context"
+	"time"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/queries/list_products"
+	"product-catalog-service/internal/pkg/fulltext"
+)
+
+// MaxQueryTerms limits the distinct words of a search query
+const MaxQueryTerms = 16
+
+// ReadModel defines the interface for searching products
+type ReadModel interface {
+	SearchProducts(ctx context.Context, filter contracts.SearchProductsFilter) (*contracts.SearchResultsDTO, error)
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the search products query request
+type Request struct {
+	// Query is the search text; products must contain every word of it
+	Query string
+
+	// Products filters the results like a listing; results are always
+	// ordered by relevance, so OrderBy is ignored
+	Products list_products.Request
+}
+
+// Response represents the search products query response
+type Response struct {
+	Results       []*contracts.SearchResultDTO
+	NextPageToken string
+}
+
+// Query handles searching products
+type Query struct {
+	readModel ReadModel
+	clock     Clock
+}
+
+// NewQuery creates a new search products query
+func NewQuery(readModel ReadModel, clock Clock) *Query {
+	return &Query{
+		readModel: readModel,
+		clock:     clock,
+	}
+}
+
+// Execute retrieves a page of the products matching the search, best match first
+func (q *Query) Execute(ctx context.Context, req Request) (*Response, error) {
+	terms := fulltext.Terms(req.Query)
+	if len(terms) == 0 || len(terms) > MaxQueryTerms {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+
+	products := req.Products
+	products.OrderBy = ""
+
+	listing, err := list_products.NewFilter(products, q.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := q.readModel.SearchProducts(ctx, contracts.SearchProductsFilter{
+		Terms:    terms,
+		Products: listing,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Results:       result.Results,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,334 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_attribute_definition"
+	"product-catalog-service/internal/models/m_product_attribute"
+)
+
+// AttributeMuts returns mutations replacing the product's attribute values,
+// or nil if no attribute changed
+func (r *ProductRepo) AttributeMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldAttributes) {
+		return nil
+	}
+
+	attributes := product.Attributes()
+
+	// Mutations apply in order, so the prefix delete clears the old values first
+	mutations := make([]*spanner.Mutation, 0, len(attributes)+1)
+	mutations = append(mutations, spanner.Delete(m_product_attribute.Table, spanner.Key{product.ID()}.AsPrefix()))
+
+	for name, value := range attributes {
+		a := attributeToModel(product.ID(), name, value)
+		mutations = append(mutations, spanner.InsertMap(m_product_attribute.Table, a.ToMap()))
+	}
+
+	return mutations
+}
+
+// findAttributes reads the attribute values of a product within txn
+func (r *ProductRepo) findAttributes(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID string) (map[string]domain.AttributeValue, error) {
+	attributes := make(map[string]domain.AttributeValue)
+
+	err := txn.Query(ctx, attributesStatement([]string{productID})).Do(func(row *spanner.Row) error {
+		a, err := parseAttributeRow(row)
+		if err != nil {
+			return err
+		}
+
+		value, err := domain.ReconstructAttributeValue(a.AttributeType, a.Value)
+		if err != nil {
+			return err
+		}
+
+		attributes[a.Name] = value
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attributes: %w", err)
+	}
+
+	return attributes, nil
+}
+
+// attachAttributes reads the attribute values of products within txn and sets
+// them on each DTO
+func (r *ProductReadModel) attachAttributes(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*contracts.ProductDTO, len(products))
+	productIDs := make([]string, 0, len(products))
+	for _, dto := range products {
+		dto.Attributes = make([]*contracts.AttributeDTO, 0)
+		byID[dto.ProductID] = dto
+		productIDs = append(productIDs, dto.ProductID)
+	}
+
+	err := txn.Query(ctx, attributesStatement(productIDs)).Do(func(row *spanner.Row) error {
+		a, err := parseAttributeRow(row)
+		if err != nil {
+			return err
+		}
+
+		dto, ok := byID[a.ProductID]
+		if !ok {
+			return nil
+		}
+
+		dto.Attributes = append(dto.Attributes, &contracts.AttributeDTO{
+			Name:  a.Name,
+			Type:  a.AttributeType,
+			Value: a.Value,
+		})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read attributes: %w", err)
+	}
+
+	return nil
+}
+
+// ListAttributeDefinitions retrieves the attribute definitions of a category ordered by name
+func (r *ProductReadModel) ListAttributeDefinitions(ctx context.Context, category string) ([]*contracts.AttributeDefinitionDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	definitions := make([]*contracts.AttributeDefinitionDTO, 0)
+
+	err := r.client.Single().Query(ctx, attributeDefinitionsStatement(category)).Do(func(row *spanner.Row) error {
+		d, err := parseAttributeDefinitionRow(row)
+		if err != nil {
+			return err
+		}
+
+		dto := &contracts.AttributeDefinitionDTO{
+			Category:      d.Category,
+			Name:          d.Name,
+			Type:          d.AttributeType,
+			Required:      d.Required,
+			AllowedValues: d.AllowedValues,
+		}
+		if d.Unit != nil {
+			dto.Unit = *d.Unit
+		}
+
+		definitions = append(definitions, dto)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attribute definitions: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// attributeFilterConditions renders the attribute filters as EXISTS conditions
+// on product_attributes and adds their parameters to params
+func attributeFilterConditions(filters []contracts.AttributeFilter, params map[string]interface{}) ([]string, error) {
+	conditions := make([]string, 0, len(filters))
+
+	for i, f := range filters {
+		if f.Name == "" {
+			return nil, domain.ErrUnknownAttribute
+		}
+
+		prefix := fmt.Sprintf("attr%d", i)
+		params[prefix+"_name"] = f.Name
+
+		var matches []string
+
+		if f.Equals != "" {
+			params[prefix+"_eq"] = f.Equals
+			params[prefix+"_eq_num"] = spanner.NullNumeric{}
+			if number, err := domain.ParseAttributeNumber(f.Equals); err == nil {
+				params[prefix+"_eq_num"] = spanner.NullNumeric{Numeric: *number, Valid: true}
+			}
+			matches = append(matches, fmt.Sprintf("(a.value = @%[1]s_eq OR a.number_value = @%[1]s_eq_num)", prefix))
+		}
+
+		for _, bound := range []struct {
+			raw, suffix, op string
+		}{
+			{f.Min, "_min", ">="},
+			{f.Max, "_max", "<="},
+		} {
+			if bound.raw == "" {
+				continue
+			}
+
+			number, err := domain.ParseAttributeNumber(bound.raw)
+			if err != nil {
+				return nil, err
+			}
+
+			params[prefix+bound.suffix] = spanner.NullNumeric{Numeric: *number, Valid: true}
+			matches = append(matches, fmt.Sprintf("a.number_value %s @%s%s", bound.op, prefix, bound.suffix))
+		}
+
+		condition := fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM product_attributes a WHERE a.product_id = p.product_id AND a.name = @%s_name",
+			prefix,
+		)
+		for _, m := range matches {
+			condition += " AND " + m
+		}
+		conditions = append(conditions, condition+")")
+	}
+
+	return conditions, nil
+}
+
+func attributesStatement(productIDs []string) spanner.Statement {
+	stmt := spanner.NewStatement(`
+		SELECT product_id, name, attribute_type, value
+		FROM product_attributes
+		WHERE product_id IN UNNEST(@product_ids)
+		ORDER BY product_id, name
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+	}
+	return stmt
+}
+
+func parseAttributeRow(row *spanner.Row) (*m_product_attribute.ProductAttribute, error) {
+	var a m_product_attribute.ProductAttribute
+	if err := row.Columns(
+		&a.ProductID,
+		&a.Name,
+		&a.AttributeType,
+		&a.Value,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse attribute row: %w", err)
+	}
+	return &a, nil
+}
+
+func attributeToModel(productID, name string, value domain.AttributeValue) *m_product_attribute.ProductAttribute {
+	a := &m_product_attribute.ProductAttribute{
+		ProductID:     productID,
+		Name:          name,
+		AttributeType: string(value.Type()),
+		Value:         value.String(),
+	}
+
+	if number := value.Number(); number != nil {
+		a.NumberValue = spanner.NullNumeric{Numeric: *number, Valid: true}
+	}
+
+	return a
+}
+
+// AttributeSchemaRepo implements attribute definition persistence for Spanner
+type AttributeSchemaRepo struct {
+	client *spanner.Client
+}
+
+// NewAttributeSchemaRepo creates a new Spanner attribute schema repository
+func NewAttributeSchemaRepo(client *spanner.Client) *AttributeSchemaRepo {
+	return &AttributeSchemaRepo{
+		client: client,
+	}
+}
+
+// FindByCategory retrieves the attribute schema of a category. A category
+// without definitions has an empty schema.
+func (r *AttributeSchemaRepo) FindByCategory(ctx context.Context, category string) (*domain.AttributeSchema, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var definitions []*domain.AttributeDefinition
+
+	err := r.client.Single().Query(ctx, attributeDefinitionsStatement(category)).Do(func(row *spanner.Row) error {
+		d, err := parseAttributeDefinitionRow(row)
+		if err != nil {
+			return err
+		}
+
+		definition, err := modelToAttributeDefinition(d)
+		if err != nil {
+			return err
+		}
+
+		definitions = append(definitions, definition)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attribute schema: %w", err)
+	}
+
+	return domain.NewAttributeSchema(category, definitions), nil
+}
+
+// UpsertMut returns a mutation writing an attribute definition of a category
+func (r *AttributeSchemaRepo) UpsertMut(category string, definition *domain.AttributeDefinition) *spanner.Mutation {
+	d := &m_attribute_definition.AttributeDefinition{
+		Category:      category,
+		Name:          definition.Name(),
+		AttributeType: string(definition.Type()),
+		Required:      definition.Required(),
+		AllowedValues: definition.AllowedValues(),
+	}
+	if unit := definition.Unit(); unit != "" {
+		d.Unit = &unit
+	}
+
+	return spanner.InsertOrUpdateMap(m_attribute_definition.Table, d.ToMap())
+}
+
+// DeleteMut returns a mutation deleting an attribute definition of a category
+func (r *AttributeSchemaRepo) DeleteMut(category, name string) *spanner.Mutation {
+	return spanner.Delete(m_attribute_definition.Table, spanner.Key{category, name})
+}
+
+func attributeDefinitionsStatement(category string) spanner.Statement {
+	stmt := spanner.NewStatement(`
+		SELECT category, name, attribute_type, unit, required, allowed_values
+		FROM attribute_definitions
+		WHERE category = @category
+		ORDER BY name
+	`)
+	stmt.Params = map[string]interface{}{
+		"category": category,
+	}
+	return stmt
+}
+
+func parseAttributeDefinitionRow(row *spanner.Row) (*m_attribute_definition.AttributeDefinition, error) {
+	var d m_attribute_definition.AttributeDefinition
+	if err := row.Columns(
+		&d.Category,
+		&d.Name,
+		&d.AttributeType,
+		&d.Unit,
+		&d.Required,
+		&d.AllowedValues,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse attribute definition row: %w", err)
+	}
+	return &d, nil
+}
+
+func modelToAttributeDefinition(d *m_attribute_definition.AttributeDefinition) (*domain.AttributeDefinition, error) {
+	var unit string
+	if d.Unit != nil {
+		unit = *d.Unit
+	}
+
+	typ, err := domain.ParseAttributeType(d.AttributeType)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewAttributeDefinition(d.Name, typ, unit, d.Required, d.AllowedValues)
+}
@@ -0,0 +1,326 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_bundle_component"
+	"product-catalog-service/internal/models/m_product_bundle"
+)
+
+// BundleMuts returns mutations writing the product's bundle definition, or nil
+// if the bundle did not change
+func (r *ProductRepo) BundleMuts(product *domain.Product) []*spanner.Mutation {
+	bundle := product.Bundle()
+	if bundle == nil || !product.Changes().Dirty(domain.FieldBundle) {
+		return nil
+	}
+
+	components := bundle.Components()
+
+	// Mutations apply in order, so the prefix delete clears the old components first
+	mutations := make([]*spanner.Mutation, 0, len(components)+2)
+	mutations = append(mutations,
+		spanner.InsertOrUpdateMap(m_product_bundle.Table, bundleToModel(product.ID(), bundle).ToMap()),
+		spanner.Delete(m_bundle_component.Table, spanner.Key{product.ID()}.AsPrefix()),
+	)
+
+	for _, c := range components {
+		m := &m_bundle_component.BundleComponent{
+			ProductID:          product.ID(),
+			ComponentProductID: c.ProductID(),
+			Quantity:           c.Quantity(),
+		}
+		mutations = append(mutations, spanner.InsertMap(m_bundle_component.Table, m.ToMap()))
+	}
+
+	return mutations
+}
+
+// findBundle reads the bundle definition of a product within txn, or nil if
+// the product is not a bundle
+func (r *ProductRepo) findBundle(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID, currencyCode string) (*domain.Bundle, error) {
+	bundles, err := readBundles(ctx, txn, map[string]string{productID: currencyCode})
+	if err != nil {
+		return nil, err
+	}
+	return bundles[productID], nil
+}
+
+// attachBundles sets the product type on each DTO and, for bundles, the bundle
+// definition along with the price and availability derived from the
+// components at opts.AsOf, priced from the price list opts.PriceListID if set.
+// Bundles keep their own discount, which is applied to the derived price.
+func (r *ProductReadModel) attachBundles(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO, discounts map[string]discountColumns, opts contracts.ReadOptions) error {
+	currencies := make(map[string]string, len(products))
+	for _, dto := range products {
+		dto.ProductType = string(domain.ProductTypeStandard)
+		currencies[dto.ProductID] = dto.Currency
+	}
+
+	if len(products) == 0 {
+		return nil
+	}
+
+	bundles, err := readBundles(ctx, txn, currencies)
+	if err != nil {
+		return err
+	}
+	if len(bundles) == 0 {
+		return nil
+	}
+
+	components, err := r.findComponents(ctx, txn, bundles, opts)
+	if err != nil {
+		return err
+	}
+
+	prices := make(map[string]*domain.Money, len(components))
+	stock := make(map[string]domain.ComponentStock, len(components))
+	for id, c := range components {
+		price, err := domain.NewMoneyFromRat(big.NewRat(c.EffectivePriceNumerator, c.EffectivePriceDenominator), c.Currency)
+		if err != nil {
+			return err
+		}
+		prices[id] = price
+		stock[id] = domain.ComponentStock{
+			Active:       c.Status == string(domain.ProductStatusActive),
+			Availability: domain.Availability(c.Availability),
+			Available:    c.AvailableQuantity,
+		}
+	}
+
+	for _, dto := range products {
+		bundle, ok := bundles[dto.ProductID]
+		if !ok {
+			continue
+		}
+
+		dto.ProductType = string(domain.ProductTypeBundle)
+		dto.Bundle = bundleDTO(bundle)
+
+		availability, available := bundle.Availability(stock)
+		dto.Availability = string(availability)
+		dto.AvailableQuantity = available
+
+		price, err := r.calculator.CalculateBundlePrice(bundle, prices)
+		if err != nil {
+			// A component that cannot be priced leaves the bundle unavailable at its stored price
+			continue
+		}
+
+		if err := domain.CheckFractions(price); err != nil {
+			return err
+		}
+
+		dto.BasePriceNumerator = price.Numerator()
+		dto.BasePriceDenominator = price.Denominator()
+		dto.EffectivePriceNumerator = price.Numerator()
+		dto.EffectivePriceDenominator = price.Denominator()
+		if err := applyDiscountAt(dto, discounts[dto.ProductID], opts.AsOf); err != nil {
+			return err
+		}
+		r.formatPrices(dto)
+	}
+
+	return nil
+}
+
+// findComponents reads the component products of bundles within txn, priced
+// and stocked at opts.AsOf, keyed by product ID
+func (r *ProductReadModel) findComponents(ctx context.Context, txn *spanner.ReadOnlyTransaction, bundles map[string]*domain.Bundle, opts contracts.ReadOptions) (map[string]*contracts.ProductDTO, error) {
+	seen := make(map[string]bool)
+	productIDs := make([]string, 0)
+	for _, bundle := range bundles {
+		for _, c := range bundle.Components() {
+			if !seen[c.ProductID()] {
+				seen[c.ProductID()] = true
+				productIDs = append(productIDs, c.ProductID())
+			}
+		}
+	}
+
+	stmt := spanner.NewStatement(productSelect() + `
+		WHERE p.product_id IN UNNEST(@product_ids)
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+		"as_of":       opts.AsOf,
+	}
+
+	var components []*contracts.ProductDTO
+	discounts := make(map[string]discountColumns)
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		dto, discount, err := r.parseProductRow(row, opts.AsOf)
+		if err != nil {
+			return err
+		}
+		components = append(components, dto)
+		discounts[dto.ProductID] = discount
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle components: %w", err)
+	}
+
+	if err := r.applyPriceList(ctx, txn, components, discounts, opts); err != nil {
+		return nil, err
+	}
+
+	if err := r.attachStock(ctx, txn, components); err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*contracts.ProductDTO, len(components))
+	for _, dto := range components {
+		byID[dto.ProductID] = dto
+	}
+
+	return byID, nil
+}
+
+// readBundles reads the bundle definitions of products within txn, keyed by
+// product ID. currencies maps each product ID to the product's currency.
+func readBundles(ctx context.Context, txn *spanner.ReadOnlyTransaction, currencies map[string]string) (map[string]*domain.Bundle, error) {
+	productIDs := make([]string, 0, len(currencies))
+	for id := range currencies {
+		productIDs = append(productIDs, id)
+	}
+
+	stmt := spanner.NewStatement(`
+		SELECT
+			b.product_id, b.discount_percent,
+			b.discount_amount_numerator, b.discount_amount_denominator,
+			b.price_override_numerator, b.price_override_denominator,
+			c.component_product_id, c.quantity
+		FROM product_bundles b
+		JOIN bundle_components c ON c.product_id = b.product_id
+		WHERE b.product_id IN UNNEST(@product_ids)
+		ORDER BY b.product_id, c.component_product_id
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+	}
+
+	rows := make(map[string]*m_product_bundle.ProductBundle)
+	components := make(map[string][]domain.BundleComponent)
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var (
+			b m_product_bundle.ProductBundle
+			c m_bundle_component.BundleComponent
+		)
+		if err := row.Columns(
+			&b.ProductID,
+			&b.DiscountPercent,
+			&b.DiscountAmountNumerator,
+			&b.DiscountAmountDenominator,
+			&b.PriceOverrideNumerator,
+			&b.PriceOverrideDenominator,
+			&c.ComponentProductID,
+			&c.Quantity,
+		); err != nil {
+			return fmt.Errorf("failed to parse bundle row: %w", err)
+		}
+
+		component, err := domain.NewBundleComponent(c.ComponentProductID, c.Quantity)
+		if err != nil {
+			return err
+		}
+
+		rows[b.ProductID] = &b
+		components[b.ProductID] = append(components[b.ProductID], component)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundles: %w", err)
+	}
+
+	bundles := make(map[string]*domain.Bundle, len(rows))
+	for id, b := range rows {
+		bundle, err := modelToBundle(b, components[id], currencies[id])
+		if err != nil {
+			return nil, err
+		}
+		bundles[id] = bundle
+	}
+
+	return bundles, nil
+}
+
+func bundleToModel(productID string, bundle *domain.Bundle) *m_product_bundle.ProductBundle {
+	b := &m_product_bundle.ProductBundle{
+		ProductID: productID,
+	}
+
+	if percent := bundle.DiscountPercent(); percent != nil {
+		b.DiscountPercent = spanner.NullNumeric{Numeric: *percent, Valid: true}
+	}
+	if amount := bundle.DiscountAmount(); amount != nil {
+		b.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+		b.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+	}
+	if override := bundle.PriceOverride(); override != nil {
+		b.PriceOverrideNumerator = &[]int64{override.Numerator()}[0]
+		b.PriceOverrideDenominator = &[]int64{override.Denominator()}[0]
+	}
+
+	return b
+}
+
+func modelToBundle(b *m_product_bundle.ProductBundle, components []domain.BundleComponent, currencyCode string) (*domain.Bundle, error) {
+	var (
+		discountPercent *big.Rat
+		discountAmount  *domain.Money
+		priceOverride   *domain.Money
+		err             error
+	)
+
+	if b.DiscountPercent.Valid {
+		discountPercent = &b.DiscountPercent.Numeric
+	}
+	if b.DiscountAmountNumerator != nil && b.DiscountAmountDenominator != nil {
+		discountAmount, err = domain.NewMoney(*b.DiscountAmountNumerator, *b.DiscountAmountDenominator, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if b.PriceOverrideNumerator != nil && b.PriceOverrideDenominator != nil {
+		priceOverride, err = domain.NewMoney(*b.PriceOverrideNumerator, *b.PriceOverrideDenominator, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.NewBundle(components, discountPercent, discountAmount, priceOverride)
+}
+
+func bundleDTO(bundle *domain.Bundle) *contracts.BundleDTO {
+	dto := &contracts.BundleDTO{
+		Components:      make([]*contracts.BundleComponentDTO, 0, len(bundle.Components())),
+		DiscountPercent: bundle.DiscountPercent(),
+	}
+
+	for _, c := range bundle.Components() {
+		dto.Components = append(dto.Components, &contracts.BundleComponentDTO{
+			ProductID: c.ProductID(),
+			Quantity:  c.Quantity(),
+		})
+	}
+
+	if amount := bundle.DiscountAmount(); amount != nil {
+		dto.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+		dto.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+	}
+	if override := bundle.PriceOverride(); override != nil {
+		dto.PriceOverrideNumerator = &[]int64{override.Numerator()}[0]
+		dto.PriceOverrideDenominator = &[]int64{override.Denominator()}[0]
+	}
+
+	return dto
+}
@@ -0,0 +1,210 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+)
+
+// activeDiscountCondition matches products with their own or a scheduled
+// discount active at @as_of
+const activeDiscountCondition = `(
+			((p.discount_percent IS NOT NULL OR p.discount_amount_numerator IS NOT NULL)
+				AND p.discount_start_date <= @as_of AND p.discount_end_date >= @as_of)
+			OR EXISTS (
+				SELECT 1 FROM product_discounts d
+				WHERE d.product_id = p.product_id AND d.start_date <= @as_of AND d.end_date >= @as_of))`
+
+// GetCatalogFacets counts the products matching filter by category, status,
+// active discount and price band. Every count is an aggregate query in one
+// read-only transaction, so the counts are consistent with each other.
+func (r *ProductReadModel) GetCatalogFacets(ctx context.Context, filter contracts.CatalogFacetsFilter) (*contracts.CatalogFacetsDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	txn := r.readOnlyTransaction(filter.Products.ReadOptions)
+	defer txn.Close()
+
+	facets := &contracts.CatalogFacetsDTO{}
+
+	// Total and discount counts apply the whole filter
+	var total, discounted int64
+	err := r.queryFacet(ctx, txn, filter.Products, facetQuery{
+		columns: "COUNT(*), COUNTIF" + activeDiscountCondition,
+	}, func(row *spanner.Row) error {
+		return row.Columns(&total, &discounted)
+	})
+	if err != nil {
+		return nil, err
+	}
+	facets.Total = total
+	facets.HasActiveDiscount = []*contracts.FacetCountDTO{
+		{Value: "true", Count: discounted},
+		{Value: "false", Count: total - discounted},
+	}
+
+	// Count every category the listing could be narrowed to
+	anyCategory := filter.Products
+	anyCategory.Category = ""
+	anyCategory.IncludeSubcategories = false
+	facets.Categories, err = r.countBy(ctx, txn, anyCategory, "p.category")
+	if err != nil {
+		return nil, err
+	}
+
+	// Count every status the listing could be narrowed to
+	anyStatus := filter.Products
+	anyStatus.Statuses = nil
+	anyStatus.IncludeArchived = true
+	facets.Statuses, err = r.countBy(ctx, txn, anyStatus, "p.status")
+	if err != nil {
+		return nil, err
+	}
+
+	facets.PriceBands, err = r.countPriceBands(ctx, txn, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// countBy counts the products matching filter by the value of column
+func (r *ProductReadModel) countBy(ctx context.Context, txn *spanner.ReadOnlyTransaction, filter contracts.ListProductsFilter, column string) ([]*contracts.FacetCountDTO, error) {
+	counts := make([]*contracts.FacetCountDTO, 0)
+
+	err := r.queryFacet(ctx, txn, filter, facetQuery{
+		columns: column + ", COUNT(*)",
+		groupBy: column,
+	}, func(row *spanner.Row) error {
+		var count contracts.FacetCountDTO
+		if err := row.Columns(&count.Value, &count.Count); err != nil {
+			return err
+		}
+		counts = append(counts, &count)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// countPriceBands counts the products priced in the band currency by the band
+// their stored effective price falls in. The price range filter is ignored so
+// every band can be chosen. Bundles fall in no band, as the price filter does
+// not match them.
+func (r *ProductReadModel) countPriceBands(ctx context.Context, txn *spanner.ReadOnlyTransaction, filter contracts.CatalogFacetsFilter) ([]*contracts.PriceBandDTO, error) {
+	if len(filter.PriceBandBounds) == 0 {
+		return nil, nil
+	}
+
+	anyPrice := filter.Products
+	anyPrice.MinPrice = nil
+	anyPrice.MaxPrice = nil
+	anyPrice.PriceCurrency = ""
+
+	bounds := make([]big.Rat, len(filter.PriceBandBounds))
+	for i, b := range filter.PriceBandBounds {
+		bounds[i].Set(b)
+	}
+
+	// A product's band is the number of bounds at or below its price
+	counts := make([]int64, len(bounds)+1)
+	err := r.queryFacet(ctx, txn, anyPrice, facetQuery{
+		columns:    "(SELECT COUNT(*) FROM UNNEST(@band_bounds) AS b WHERE b <= p.effective_price) AS band, COUNT(*)",
+		groupBy:    "band",
+		conditions: []string{"p.currency = @band_currency", notBundleCondition},
+		params: map[string]interface{}{
+			"band_bounds":   bounds,
+			"band_currency": filter.PriceBandCurrency,
+		},
+	}, func(row *spanner.Row) error {
+		var band, count int64
+		if err := row.Columns(&band, &count); err != nil {
+			return err
+		}
+		counts[band] = count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bands := make([]*contracts.PriceBandDTO, len(counts))
+	for i, count := range counts {
+		band := &contracts.PriceBandDTO{Currency: filter.PriceBandCurrency, Count: count}
+		if i > 0 {
+			band.MinNumerator, band.MinDenominator, band.MinDecimal, err = r.priceBound(filter.PriceBandBounds[i-1], filter.PriceBandCurrency)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if i < len(bounds) {
+			band.MaxNumerator, band.MaxDenominator, band.MaxDecimal, err = r.priceBound(filter.PriceBandBounds[i], filter.PriceBandCurrency)
+			if err != nil {
+				return nil, err
+			}
+		}
+		bands[i] = band
+	}
+
+	return bands, nil
+}
+
+// priceBound renders a price band bound
+func (r *ProductReadModel) priceBound(bound *big.Rat, currency string) (*int64, *int64, string, error) {
+	price, err := domain.NewMoneyFromRat(bound, currency)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	num, denom := price.Numerator(), price.Denominator()
+	return &num, &denom, price.Format(r.rounding), nil
+}
+
+// facetQuery is an aggregate over the products matching a listing filter
+type facetQuery struct {
+	columns    string                 // Selected aggregates
+	groupBy    string                 // Optional grouping column, also the result order
+	conditions []string               // Optional, narrow the filter's products further
+	params     map[string]interface{} // Parameters of the columns and conditions
+}
+
+// queryFacet runs q over the products matching filter, calling fn for each row
+func (r *ProductReadModel) queryFacet(ctx context.Context, txn *spanner.ReadOnlyTransaction, filter contracts.ListProductsFilter, q facetQuery, fn func(row *spanner.Row) error) error {
+	params := map[string]interface{}{
+		"as_of": filter.ReadOptions.AsOf,
+	}
+	for k, v := range q.params {
+		params[k] = v
+	}
+
+	table, conditions, err := filterConditions(filter, params)
+	if err != nil {
+		return err
+	}
+	conditions = append(conditions, q.conditions...)
+
+	sql := `SELECT ` + q.columns + `
+		FROM ` + table + ` p
+		WHERE ` + whereClause(conditions)
+	if q.groupBy != "" {
+		sql += `
+		GROUP BY ` + q.groupBy + `
+		ORDER BY ` + q.groupBy
+	}
+
+	stmt := spanner.Statement{SQL: sql, Params: params}
+	if err := txn.Query(ctx, stmt).Do(fn); err != nil {
+		return fmt.Errorf("failed to count products: %w", err)
+	}
+
+	return nil
+}
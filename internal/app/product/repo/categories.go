@@ -0,0 +1,361 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_category"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+var categoryColumns = []string{
+	m_category.CategoryID,
+	m_category.Name,
+	m_category.ParentID,
+	m_category.Path,
+	m_category.Status,
+	m_category.CreatedAt,
+	m_category.UpdatedAt,
+	m_category.ArchivedAt,
+	m_category.Version,
+}
+
+// CategoryRepo implements category persistence for Spanner
+type CategoryRepo struct {
+	client *spanner.Client
+}
+
+// NewCategoryRepo creates a new Spanner category repository
+func NewCategoryRepo(client *spanner.Client) *CategoryRepo {
+	return &CategoryRepo{
+		client: client,
+	}
+}
+
+// InsertMut returns a mutation to insert a category
+func (r *CategoryRepo) InsertMut(category *domain.Category) *spanner.Mutation {
+	c := categoryToModel(category)
+	return spanner.InsertMap(m_category.Table, c.ToMap())
+}
+
+// UpdateMut returns a mutation to update a category (targeted by change tracker)
+func (r *CategoryRepo) UpdateMut(category *domain.Category) *spanner.Mutation {
+	if !category.Changes().HasChanges() {
+		return nil // No changes to apply
+	}
+
+	updates := map[string]interface{}{
+		m_category.CategoryID: category.ID(),
+		m_category.Status:     string(category.Status()),
+		m_category.UpdatedAt:  time.Now(),
+		m_category.Version:    int64(category.Version()) + 1,
+	}
+
+	if category.Changes().Dirty(domain.FieldName) {
+		updates[m_category.Name] = category.Name()
+		updates[m_category.NameKey] = category.NameKey()
+	}
+
+	if category.Changes().Dirty(domain.FieldCategoryPath) {
+		updates[m_category.ParentID] = nullableID(category.ParentID())
+		updates[m_category.Path] = category.Path()
+	}
+
+	if category.Changes().Dirty(domain.FieldArchivedAt) {
+		updates[m_category.ArchivedAt] = category.ArchivedAt()
+	}
+
+	return spanner.UpdateMap(m_category.Table, updates)
+}
+
+// VersionPrecondition returns a precondition requiring the stored version to
+// still match the version the category was loaded with
+func (r *CategoryRepo) VersionPrecondition(category *domain.Category) commitplan.Precondition {
+	return commitplan.Precondition{
+		Table:    m_category.Table,
+		Key:      spanner.Key{category.ID()},
+		Column:   m_category.Version,
+		Expected: int64(category.Version()),
+		Err:      domain.ErrConcurrentModification,
+	}
+}
+
+// FindByID retrieves a category by ID
+func (r *CategoryRepo) FindByID(ctx context.Context, categoryID string) (*domain.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	row, err := r.client.Single().ReadRow(ctx, m_category.Table, spanner.Key{categoryID}, categoryColumns)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to read category: %w", err)
+	}
+
+	c, err := parseCategoryRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return modelToCategory(c), nil
+}
+
+// FindDescendants retrieves every category below category in the tree
+func (r *CategoryRepo) FindDescendants(ctx context.Context, category *domain.Category) ([]*domain.Category, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(categoryColumns, ", ") + `
+			FROM categories
+			WHERE STARTS_WITH(path, @path) AND category_id != @category_id`,
+		Params: map[string]interface{}{
+			"path":        category.Path(),
+			"category_id": category.ID(),
+		},
+	}
+	return r.findCategories(ctx, stmt)
+}
+
+// FindChildren retrieves the direct children of a category
+func (r *CategoryRepo) FindChildren(ctx context.Context, categoryID string) ([]*domain.Category, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(categoryColumns, ", ") + `
+			FROM categories
+			WHERE parent_id = @parent_id`,
+		Params: map[string]interface{}{
+			"parent_id": categoryID,
+		},
+	}
+	return r.findCategories(ctx, stmt)
+}
+
+// FindRootByName retrieves the root category whose name matches name case-insensitively
+func (r *CategoryRepo) FindRootByName(ctx context.Context, name string) (*domain.Category, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(categoryColumns, ", ") + `
+			FROM categories
+			WHERE parent_id IS NULL AND name_key = @name_key`,
+		Params: map[string]interface{}{
+			"name_key": domain.CategoryNameKey(name),
+		},
+	}
+
+	categories, err := r.findCategories(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	return categories[0], nil
+}
+
+// FindUncategorized returns up to limit products, ordered by ID and after
+// afterID, whose category does not reference a category. These are products
+// created before categories existed, which hold a free-text category name.
+func (r *ProductRepo) FindUncategorized(ctx context.Context, afterID string, limit int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stmt := spanner.Statement{
+		SQL: `
+			SELECT p.product_id FROM products AS p
+			LEFT JOIN categories AS c ON c.category_id = p.category
+			WHERE c.category_id IS NULL AND p.product_id > @after
+			ORDER BY p.product_id
+			LIMIT @limit
+		`,
+		Params: map[string]interface{}{
+			"after": afterID,
+			"limit": int64(limit),
+		},
+	}
+
+	var productIDs []string
+
+	err := r.client.Single().Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var productID string
+		if err := row.Columns(&productID); err != nil {
+			return fmt.Errorf("failed to parse product row: %w", err)
+		}
+		productIDs = append(productIDs, productID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find uncategorized products: %w", err)
+	}
+
+	return productIDs, nil
+}
+
+func (r *CategoryRepo) findCategories(ctx context.Context, stmt spanner.Statement) ([]*domain.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var categories []*domain.Category
+
+	err := r.client.Single().Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		c, err := parseCategoryRow(row)
+		if err != nil {
+			return err
+		}
+		categories = append(categories, modelToCategory(c))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetCategory retrieves a category by ID
+func (r *ProductReadModel) GetCategory(ctx context.Context, categoryID string) (*contracts.CategoryDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	row, err := r.client.Single().ReadRow(ctx, m_category.Table, spanner.Key{categoryID}, categoryColumns)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to read category: %w", err)
+	}
+
+	c, err := parseCategoryRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return categoryDTO(c), nil
+}
+
+// ListCategories retrieves the children of filter.ParentID ordered by name, or
+// the whole taxonomy in tree order if no parent is given
+func (r *ProductReadModel) ListCategories(ctx context.Context, filter contracts.ListCategoriesFilter) ([]*contracts.CategoryDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(categoryColumns, ", ") + `
+			FROM categories
+			WHERE (@include_archived OR status != @archived)`,
+		Params: map[string]interface{}{
+			"include_archived": filter.IncludeArchived,
+			"archived":         string(domain.CategoryStatusArchived),
+		},
+	}
+
+	if filter.ParentID != "" {
+		stmt.SQL += ` AND parent_id = @parent_id ORDER BY name_key`
+		stmt.Params["parent_id"] = filter.ParentID
+	} else {
+		stmt.SQL += ` ORDER BY path`
+	}
+
+	categories := make([]*contracts.CategoryDTO, 0)
+
+	err := r.client.Single().Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		c, err := parseCategoryRow(row)
+		if err != nil {
+			return err
+		}
+		categories = append(categories, categoryDTO(c))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func parseCategoryRow(row *spanner.Row) (*m_category.Category, error) {
+	var c m_category.Category
+	if err := row.Columns(
+		&c.CategoryID,
+		&c.Name,
+		&c.ParentID,
+		&c.Path,
+		&c.Status,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+		&c.ArchivedAt,
+		&c.Version,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse category row: %w", err)
+	}
+	return &c, nil
+}
+
+func categoryToModel(category *domain.Category) *m_category.Category {
+	return &m_category.Category{
+		CategoryID: category.ID(),
+		Name:       category.Name(),
+		NameKey:    category.NameKey(),
+		ParentID:   nullableID(category.ParentID()),
+		Path:       category.Path(),
+		Status:     string(category.Status()),
+		CreatedAt:  category.CreatedAt(),
+		UpdatedAt:  category.UpdatedAt(),
+		ArchivedAt: category.ArchivedAt(),
+		Version:    int64(category.Version()),
+	}
+}
+
+func modelToCategory(c *m_category.Category) *domain.Category {
+	var parentID string
+	if c.ParentID != nil {
+		parentID = *c.ParentID
+	}
+
+	return domain.ReconstructCategory(
+		c.CategoryID,
+		c.Name,
+		parentID,
+		c.Path,
+		c.Status,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.ArchivedAt,
+		int(c.Version),
+	)
+}
+
+func categoryDTO(c *m_category.Category) *contracts.CategoryDTO {
+	dto := &contracts.CategoryDTO{
+		CategoryID:   c.CategoryID,
+		Name:         c.Name,
+		AncestorIDs:  make([]string, 0),
+		Status:       c.Status,
+		CreatedAtSec: c.CreatedAt.Unix(),
+		UpdatedAtSec: c.UpdatedAt.Unix(),
+	}
+
+	if c.ParentID != nil {
+		dto.ParentID = *c.ParentID
+	}
+
+	// The path lists the IDs from the root down to the category itself
+	for _, id := range strings.Split(strings.Trim(c.Path, "/"), "/") {
+		if id != "" && id != c.CategoryID {
+			dto.AncestorIDs = append(dto.AncestorIDs, id)
+		}
+	}
+
+	return dto
+}
+
+// nullableID stores an empty ID as NULL
+func nullableID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/models/m_product_discount"
+)
+
+// dueDiscountQueries find the products with a discount due for a lifecycle
+// event, one query per discount phase, so each is a range read of its phase
+// in the phase index up to the windows started by @now rather than an OR
+// across phases. Archived products are skipped.
+var dueDiscountQueries = []string{
+	// Applied discounts that started, including those stored before phases
+	// were tracked, whose phase is NULL
+	`SELECT product_id FROM ` + m_product.Table + `@{FORCE_INDEX=` + m_product.DiscountPhaseIndex + `}
+		WHERE discount_phase = @pending AND discount_start_date <= @now AND status != @archived
+		LIMIT @limit`,
+	`SELECT product_id FROM ` + m_product.Table + `@{FORCE_INDEX=` + m_product.DiscountPhaseIndex + `}
+		WHERE discount_phase IS NULL AND discount_start_date <= @now AND status != @archived
+		LIMIT @limit`,
+
+	// Applied discounts that ended
+	`SELECT product_id FROM ` + m_product.Table + `@{FORCE_INDEX=` + m_product.DiscountPhaseIndex + `}
+		WHERE discount_phase = @started AND discount_start_date <= @now AND discount_end_date < @now
+			AND status != @archived
+		LIMIT @limit`,
+
+	// Scheduled discounts that started, then those that ended
+	`SELECT DISTINCT d.product_id FROM ` + m_product_discount.Table + `@{FORCE_INDEX=` + m_product_discount.PhaseIndex + `} AS d
+		JOIN products AS p ON p.product_id = d.product_id
+		WHERE d.phase = @pending AND d.start_date <= @now AND p.status != @archived
+		LIMIT @limit`,
+	`SELECT DISTINCT d.product_id FROM ` + m_product_discount.Table + `@{FORCE_INDEX=` + m_product_discount.PhaseIndex + `} AS d
+		JOIN products AS p ON p.product_id = d.product_id
+		WHERE d.phase = @started AND d.start_date <= @now AND d.end_date < @now AND p.status != @archived
+		LIMIT @limit`,
+}
+
+// FindDueDiscounts returns up to limit products with an applied or scheduled
+// discount whose window crossed its start or end boundary by now without the
+// matching lifecycle event having been emitted. Archived products are skipped.
+func (r *ProductRepo) FindDueDiscounts(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// One snapshot for every phase
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	var productIDs []string
+	seen := make(map[string]bool)
+
+	for _, sql := range dueDiscountQueries {
+		if len(productIDs) >= limit {
+			break
+		}
+
+		stmt := spanner.Statement{
+			SQL: sql,
+			Params: map[string]interface{}{
+				"archived": string(domain.ProductStatusArchived),
+				"pending":  string(domain.DiscountPhasePending),
+				"started":  string(domain.DiscountPhaseStarted),
+				"now":      now,
+				"limit":    int64(limit - len(productIDs)),
+			},
+		}
+
+		err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+			var productID string
+			if err := row.Columns(&productID); err != nil {
+				return fmt.Errorf("failed to parse product row: %w", err)
+			}
+			if !seen[productID] && len(productIDs) < limit {
+				seen[productID] = true
+				productIDs = append(productIDs, productID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find due discounts: %w", err)
+		}
+	}
+
+	return productIDs, nil
+}
@@ -0,0 +1,274 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/models/m_product_discount"
+)
+
+// DiscountScheduleMuts returns mutations replacing the product's scheduled discounts,
+// or nil if the schedule did not change
+func (r *ProductRepo) DiscountScheduleMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldDiscountSchedule) {
+		return nil
+	}
+
+	schedule := product.DiscountSchedule()
+
+	// Mutations apply in order, so the prefix delete clears the old schedule first
+	mutations := make([]*spanner.Mutation, 0, len(schedule)+1)
+	mutations = append(mutations, spanner.Delete(m_product_discount.Table, spanner.Key{product.ID()}.AsPrefix()))
+
+	for _, s := range schedule {
+		d := scheduledDiscountToModel(product.ID(), s)
+		mutations = append(mutations, spanner.InsertMap(m_product_discount.Table, d.ToMap()))
+	}
+
+	return mutations
+}
+
+// findDiscountSchedule reads the scheduled discounts of a product within txn
+func (r *ProductRepo) findDiscountSchedule(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID, currencyCode string) ([]*domain.ScheduledDiscount, error) {
+	var schedule []*domain.ScheduledDiscount
+
+	err := txn.Query(ctx, discountScheduleStatement(productID)).Do(func(row *spanner.Row) error {
+		d, err := parseDiscountScheduleRow(row)
+		if err != nil {
+			return err
+		}
+
+		discount, err := modelToDiscount(d, currencyCode)
+		if err != nil {
+			return err
+		}
+
+		phase, err := domain.ParseDiscountPhase(d.Phase)
+		if err != nil {
+			return err
+		}
+
+		schedule = append(schedule, domain.ReconstructScheduledDiscount(d.DiscountID, discount, phase))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read discount schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// findDiscountSchedules reads the scheduled discounts of several products
+// within txn, keyed by product ID. currencies holds each product's currency.
+func (r *ProductRepo) findDiscountSchedules(ctx context.Context, txn *spanner.ReadOnlyTransaction, currencies map[string]string) (map[string][]*domain.ScheduledDiscount, error) {
+	schedules := make(map[string][]*domain.ScheduledDiscount, len(currencies))
+	if len(currencies) == 0 {
+		return schedules, nil
+	}
+
+	productIDs := make([]string, 0, len(currencies))
+	for id := range currencies {
+		productIDs = append(productIDs, id)
+	}
+
+	stmt := spanner.NewStatement(`
+		SELECT
+			product_id, discount_id, discount_kind, discount_percent,
+			discount_amount_numerator, discount_amount_denominator,
+			start_date, end_date, phase
+		FROM product_discounts
+		WHERE product_id IN UNNEST(@product_ids)
+		ORDER BY product_id, start_date
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+	}
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		d, err := parseDiscountScheduleRow(row)
+		if err != nil {
+			return err
+		}
+
+		discount, err := modelToDiscount(d, currencies[d.ProductID])
+		if err != nil {
+			return err
+		}
+
+		phase, err := domain.ParseDiscountPhase(d.Phase)
+		if err != nil {
+			return err
+		}
+
+		schedules[d.ProductID] = append(schedules[d.ProductID], domain.ReconstructScheduledDiscount(d.DiscountID, discount, phase))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read discount schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// ListDiscounts retrieves the scheduled discounts of a product ordered by start date
+func (r *ProductReadModel) ListDiscounts(ctx context.Context, productID string) ([]*contracts.ScheduledDiscountDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := txn.ReadRow(ctx, m_product.Table, spanner.Key{productID}, []string{m_product.Currency})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	var currency string
+	if err := row.Columns(&currency); err != nil {
+		return nil, fmt.Errorf("failed to parse product row: %w", err)
+	}
+
+	discounts := make([]*contracts.ScheduledDiscountDTO, 0)
+
+	err = txn.Query(ctx, discountScheduleStatement(productID)).Do(func(row *spanner.Row) error {
+		d, err := parseDiscountScheduleRow(row)
+		if err != nil {
+			return err
+		}
+
+		dto := &contracts.ScheduledDiscountDTO{
+			DiscountID:                d.DiscountID,
+			DiscountKind:              d.DiscountKind,
+			DiscountAmountNumerator:   d.DiscountAmountNumerator,
+			DiscountAmountDenominator: d.DiscountAmountDenominator,
+			Currency:                  currency,
+			StartSec:                  d.StartDate.Unix(),
+			EndSec:                    d.EndDate.Unix(),
+		}
+		if d.DiscountPercent.Valid {
+			dto.DiscountPercent = &d.DiscountPercent.Numeric
+		}
+
+		discounts = append(discounts, dto)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read discount schedule: %w", err)
+	}
+
+	return discounts, nil
+}
+
+// scheduledDiscountAt reads the scheduled discount of a product whose window contains t
+func (r *ProductReadModel) scheduledDiscountAt(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID string, t time.Time) (discountColumns, error) {
+	stmt := spanner.NewStatement(`
+		SELECT
+			discount_kind, discount_percent, discount_amount_numerator, discount_amount_denominator,
+			start_date, end_date
+		FROM product_discounts
+		WHERE product_id = @product_id AND start_date <= @at AND end_date >= @at
+		LIMIT 1
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_id": productID,
+		"at":         t,
+	}
+
+	var d discountColumns
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		return row.Columns(&d.kind, &d.percent, &d.amountNum, &d.amountDenom, &d.start, &d.end)
+	})
+	if err != nil {
+		return discountColumns{}, fmt.Errorf("failed to read discount schedule: %w", err)
+	}
+
+	return d, nil
+}
+
+func discountScheduleStatement(productID string) spanner.Statement {
+	stmt := spanner.NewStatement(`
+		SELECT
+			product_id, discount_id, discount_kind, discount_percent,
+			discount_amount_numerator, discount_amount_denominator,
+			start_date, end_date, phase
+		FROM product_discounts
+		WHERE product_id = @product_id
+		ORDER BY start_date
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_id": productID,
+	}
+	return stmt
+}
+
+func parseDiscountScheduleRow(row *spanner.Row) (*m_product_discount.ProductDiscount, error) {
+	var d m_product_discount.ProductDiscount
+	if err := row.Columns(
+		&d.ProductID,
+		&d.DiscountID,
+		&d.DiscountKind,
+		&d.DiscountPercent,
+		&d.DiscountAmountNumerator,
+		&d.DiscountAmountDenominator,
+		&d.StartDate,
+		&d.EndDate,
+		&d.Phase,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse scheduled discount row: %w", err)
+	}
+	return &d, nil
+}
+
+func scheduledDiscountToModel(productID string, s *domain.ScheduledDiscount) *m_product_discount.ProductDiscount {
+	d := s.Discount()
+	m := &m_product_discount.ProductDiscount{
+		ProductID:    productID,
+		DiscountID:   s.ID(),
+		DiscountKind: string(d.Kind()),
+		StartDate:    d.StartDate(),
+		EndDate:      d.EndDate(),
+		Phase:        string(s.Phase()),
+	}
+
+	if amount := d.Amount(); amount != nil {
+		m.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+		m.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+	} else {
+		m.DiscountPercent = spanner.NullNumeric{Numeric: *d.Percentage(), Valid: true}
+	}
+
+	return m
+}
+
+func modelToDiscount(d *m_product_discount.ProductDiscount, currencyCode string) (*domain.Discount, error) {
+	var amountNum, amountDenom int64
+	if d.DiscountAmountNumerator != nil && d.DiscountAmountDenominator != nil {
+		amountNum, amountDenom = *d.DiscountAmountNumerator, *d.DiscountAmountDenominator
+	}
+
+	var percent *big.Rat
+	if d.DiscountPercent.Valid {
+		percent = &d.DiscountPercent.Numeric
+	}
+
+	return domain.ReconstructDiscount(
+		d.DiscountKind,
+		percent,
+		amountNum,
+		amountDenom,
+		currencyCode,
+		d.StartDate,
+		d.EndDate,
+	)
+}
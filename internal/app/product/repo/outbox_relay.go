@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/models/m_outbox"
+	"product-catalog-service/internal/pkg/relay"
+)
+
+// ClaimPending leases the oldest pending outbox events to owner.
+// The read and the lease write share a read-write transaction, so concurrent
+// relays claiming the same rows conflict and one of them retries.
+func (r *OutboxRepo) ClaimPending(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]relay.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var events []relay.Event
+
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		events = events[:0]
+
+		stmt := spanner.Statement{
+			SQL: `
+				SELECT event_id, event_type, aggregate_id, TO_JSON_STRING(payload), created_at
+				FROM ` + m_outbox.Table + `@{FORCE_INDEX=` + m_outbox.StatusIndex + `}
+				WHERE status = @status
+					AND (lease_expires_at IS NULL OR lease_expires_at <= @now)
+				ORDER BY created_at
+				LIMIT @limit
+			`,
+			Params: map[string]interface{}{
+				"status": m_outbox.StatusPending,
+				"now":    now,
+				"limit":  int64(limit),
+			},
+		}
+
+		err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+			var (
+				event   relay.Event
+				payload string
+			)
+			if err := row.Columns(
+				&event.EventID,
+				&event.EventType,
+				&event.AggregateID,
+				&payload,
+				&event.CreatedAt,
+			); err != nil {
+				return fmt.Errorf("failed to parse outbox row: %w", err)
+			}
+			event.Payload = []byte(payload)
+			events = append(events, event)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		mutations := make([]*spanner.Mutation, 0, len(events))
+		for _, event := range events {
+			mutations = append(mutations, spanner.UpdateMap(m_outbox.Table, map[string]interface{}{
+				m_outbox.EventID:        event.EventID,
+				m_outbox.LeaseOwner:     owner,
+				m_outbox.LeaseExpiresAt: leaseUntil,
+			}))
+		}
+
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkProcessed marks events leased by owner as processed
+func (r *OutboxRepo) MarkProcessed(ctx context.Context, owner string, eventIDs []string) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	stmt := spanner.Statement{
+		SQL: `
+			UPDATE outbox_events
+			SET status = @processed,
+				processed_at = CURRENT_TIMESTAMP(),
+				lease_owner = NULL,
+				lease_expires_at = NULL
+			WHERE event_id IN UNNEST(@event_ids)
+				AND status = @pending
+				AND lease_owner = @owner
+		`,
+		Params: map[string]interface{}{
+			"processed": m_outbox.StatusProcessed,
+			"pending":   m_outbox.StatusPending,
+			"event_ids": eventIDs,
+			"owner":     owner,
+		},
+	}
+
+	return r.updateLeased(ctx, stmt)
+}
+
+// Release drops the lease owner holds on events so another relay can claim them
+func (r *OutboxRepo) Release(ctx context.Context, owner string, eventIDs []string) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	stmt := spanner.Statement{
+		SQL: `
+			UPDATE outbox_events
+			SET lease_owner = NULL,
+				lease_expires_at = NULL
+			WHERE event_id IN UNNEST(@event_ids)
+				AND lease_owner = @owner
+		`,
+		Params: map[string]interface{}{
+			"event_ids": eventIDs,
+			"owner":     owner,
+		},
+	}
+
+	return r.updateLeased(ctx, stmt)
+}
+
+func (r *OutboxRepo) updateLeased(ctx context.Context, stmt spanner.Statement) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		_, err := txn.Update(ctx, stmt)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update outbox events: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package repo
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+)
+
+// sortByProductID is the sort key of product listings without a sort order
+const sortByProductID = "product_id"
+
+// sortByRelevance is the sort key of search results, whose tokens hold the
+// offset of the next page
+const sortByRelevance = "relevance"
+
+// sortColumns are the columns products are sorted by in each sort order, most
+// significant first. Every column is NOT NULL, so rows compare totally once
+// ties break by product ID. Prices only compare within a currency, so the
+// price order groups products by currency first.
+var sortColumns = map[contracts.ProductSort][]string{
+	contracts.SortByName:           {"p.name"},
+	contracts.SortByCreatedAt:      {"p.created_at"},
+	contracts.SortByUpdatedAt:      {"p.updated_at"},
+	contracts.SortByEffectivePrice: {"p.currency", "p.effective_price"},
+}
+
+// sortKeyColumn is the alias of the i-th sort column in listing queries
+func sortKeyColumn(i int) string {
+	return fmt.Sprintf("sort_key_%d", i)
+}
+
+// sortName identifies the sort order of a listing in its page tokens
+func sortName(filter contracts.ListProductsFilter) string {
+	if filter.SortBy == contracts.SortByProductID {
+		return sortByProductID
+	}
+	if filter.Descending {
+		return string(filter.SortBy) + " desc"
+	}
+	return string(filter.SortBy)
+}
+
+// encodeSortKey reads the i-th sort column of a listing row as a page token key
+func encodeSortKey(row *spanner.Row, i int, column string) (string, error) {
+	switch column {
+	case "p.created_at", "p.updated_at":
+		var t time.Time
+		if err := row.ColumnByName(sortKeyColumn(i), &t); err != nil {
+			return "", fmt.Errorf("failed to parse sort key: %w", err)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case "p.effective_price":
+		var v big.Rat
+		if err := row.ColumnByName(sortKeyColumn(i), &v); err != nil {
+			return "", fmt.Errorf("failed to parse sort key: %w", err)
+		}
+		return v.RatString(), nil
+	default:
+		var s string
+		if err := row.ColumnByName(sortKeyColumn(i), &s); err != nil {
+			return "", fmt.Errorf("failed to parse sort key: %w", err)
+		}
+		return s, nil
+	}
+}
+
+// decodeSortKey parses a page token key into a query parameter of the sort
+// column's type
+func decodeSortKey(key string, column string) (interface{}, error) {
+	switch column {
+	case "p.created_at", "p.updated_at":
+		t, err := time.Parse(time.RFC3339Nano, key)
+		if err != nil {
+			return nil, domain.ErrInvalidPageToken
+		}
+		return t, nil
+	case "p.effective_price":
+		v, ok := new(big.Rat).SetString(key)
+		if !ok {
+			return nil, domain.ErrInvalidPageToken
+		}
+		return v, nil
+	default:
+		return key, nil
+	}
+}
+
+// afterSortKey returns the condition matching rows after the last row of the
+// previous page, whose sort column values are @after_value_<i> and whose
+// product ID is @after_product_id. after is ">" or "<" by sort direction;
+// ties always break by ascending product ID.
+func afterSortKey(columns []string, after string) string {
+	condition := "p.product_id > @after_product_id"
+	for i := len(columns) - 1; i >= 0; i-- {
+		condition = fmt.Sprintf("(%[1]s %[2]s @after_value_%[3]d OR (%[1]s = @after_value_%[3]d AND %[4]s))",
+			columns[i], after, i, condition)
+	}
+	return condition
+}
+
+// pageToken is the cursor of a product listing. It resumes after the last
+// returned row of the sort order it was issued for, and only for the filters
+// it was issued for.
+type pageToken struct {
+	Sort    string   `json:"s"` // Sort key of the listing
+	LastKey []string `json:"k"` // Sort key values of the last returned row
+	Filter  string   `json:"f"` // Fingerprint of the listing's filters
+}
+
+// newPageTokenKey returns key, or a random key if key is empty. Random keys
+// only verify tokens issued by the same process.
+func newPageTokenKey(key []byte) []byte {
+	if len(key) > 0 {
+		return key
+	}
+
+	key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic("failed to generate page token key: " + err.Error())
+	}
+	return key
+}
+
+// encodePageToken signs a page token so clients cannot alter it
+func (r *ProductReadModel) encodePageToken(token pageToken) (string, error) {
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(r.signPageToken(payload)), nil
+}
+
+// decodePageToken verifies a page token and checks it was issued for the sort
+// order and filters with the given fingerprint
+func (r *ProductReadModel) decodePageToken(s, sort, fingerprint string) (pageToken, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(s, ".")
+	if !ok {
+		return pageToken{}, domain.ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return pageToken{}, domain.ErrInvalidPageToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return pageToken{}, domain.ErrInvalidPageToken
+	}
+	if !hmac.Equal(signature, r.signPageToken(payload)) {
+		return pageToken{}, domain.ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(payload, &token); err != nil {
+		return pageToken{}, domain.ErrInvalidPageToken
+	}
+	if token.Sort != sort || token.Filter != fingerprint || len(token.LastKey) == 0 {
+		return pageToken{}, domain.ErrInvalidPageToken
+	}
+
+	return token, nil
+}
+
+func (r *ProductReadModel) signPageToken(payload []byte) []byte {
+	mac := hmac.New(sha256.New, r.pageTokenKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// filterFingerprint identifies the filters that select the products of a
+// listing. Page size and read options do not change which products match and
+// are left out, so they may differ between pages.
+func filterFingerprint(filter contracts.ListProductsFilter) string {
+	data, _ := json.Marshal(struct {
+		Category             string
+		Statuses             []string
+		IncludeArchived      bool
+		IncludeSubcategories bool
+		AttributeFilters     []contracts.AttributeFilter
+		MinPrice             *big.Rat
+		MaxPrice             *big.Rat
+		PriceCurrency        string
+	}{
+		Category:             filter.Category,
+		Statuses:             filter.Statuses,
+		IncludeArchived:      filter.IncludeArchived,
+		IncludeSubcategories: filter.IncludeSubcategories,
+		AttributeFilters:     filter.AttributeFilters,
+		MinPrice:             filter.MinPrice,
+		MaxPrice:             filter.MaxPrice,
+		PriceCurrency:        filter.PriceCurrency,
+	})
+
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
+
+// searchFingerprint identifies the terms and filters of a search
+func searchFingerprint(filter contracts.SearchProductsFilter) string {
+	sum := sha256.Sum256([]byte(strings.Join(filter.Terms, " ") + "\x00" + filterFingerprint(filter.Products)))
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
@@ -0,0 +1,239 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_price_history"
+	"product-catalog-service/internal/models/m_price_history_discount"
+	"product-catalog-service/internal/models/m_product"
+)
+
+// PriceHistoryMuts returns the mutations recording the product's pricing
+// state with its scheduled discount windows, or nil if neither the base price,
+// the discount nor the schedule changed
+func (r *ProductRepo) PriceHistoryMuts(product *domain.Product) []*spanner.Mutation {
+	changes := product.Changes()
+	if !changes.Dirty(domain.FieldBasePrice) && !changes.Dirty(domain.FieldDiscount) && !changes.Dirty(domain.FieldDiscountSchedule) {
+		return nil
+	}
+
+	snapshot := product.PriceSnapshot()
+	h := &m_price_history.PriceHistory{
+		ProductID:            product.ID(),
+		EffectiveFrom:        snapshot.EffectiveFrom(),
+		BasePriceNumerator:   snapshot.BasePrice().Numerator(),
+		BasePriceDenominator: snapshot.BasePrice().Denominator(),
+		Currency:             snapshot.BasePrice().Currency().Code(),
+	}
+
+	if d := snapshot.Discount(); d != nil {
+		kind := string(d.Kind())
+		h.DiscountKind = &kind
+		if amount := d.Amount(); amount != nil {
+			h.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+			h.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+		} else {
+			h.DiscountPercent = spanner.NullNumeric{Numeric: *d.Percentage(), Valid: true}
+		}
+		h.DiscountStartDate = &[]time.Time{d.StartDate()}[0]
+		h.DiscountEndDate = &[]time.Time{d.EndDate()}[0]
+	}
+
+	// Several changes at the same instant collapse into the final state,
+	// replacing the windows recorded by the earlier ones
+	muts := []*spanner.Mutation{
+		spanner.InsertOrUpdateMap(m_price_history.Table, h.ToMap()),
+		spanner.Delete(m_price_history_discount.Table, spanner.Key{product.ID(), snapshot.EffectiveFrom()}.AsPrefix()),
+	}
+
+	for _, d := range snapshot.Schedule() {
+		w := &m_price_history_discount.PriceHistoryDiscount{
+			ProductID:     product.ID(),
+			EffectiveFrom: snapshot.EffectiveFrom(),
+			StartDate:     d.StartDate(),
+			EndDate:       d.EndDate(),
+			DiscountKind:  string(d.Kind()),
+		}
+		if amount := d.Amount(); amount != nil {
+			w.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+			w.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+		} else {
+			w.DiscountPercent = spanner.NullNumeric{Numeric: *d.Percentage(), Valid: true}
+		}
+		muts = append(muts, spanner.InsertMap(m_price_history_discount.Table, w.ToMap()))
+	}
+
+	return muts
+}
+
+// GetPriceHistory retrieves the price snapshots of a product that took effect before to.
+// Products created before price history was recorded fall back to their current pricing.
+func (r *ProductReadModel) GetPriceHistory(ctx context.Context, productID string, to time.Time) ([]*contracts.PriceSnapshotDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	stmt := spanner.NewStatement(`
+		SELECT
+			effective_from, base_price_numerator, base_price_denominator, currency,
+			discount_kind, discount_percent, discount_amount_numerator, discount_amount_denominator,
+			discount_start_date, discount_end_date
+		FROM product_price_history
+		WHERE product_id = @product_id AND effective_from < @to
+		ORDER BY effective_from
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_id": productID,
+		"to":         to,
+	}
+
+	var snapshots []*contracts.PriceSnapshotDTO
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var (
+			dto                 contracts.PriceSnapshotDTO
+			discountKind        spanner.NullString
+			discountPercent     spanner.NullNumeric
+			discountAmountNum   *int64
+			discountAmountDenom *int64
+			discountStart       *time.Time
+			discountEnd         *time.Time
+		)
+
+		if err := row.Columns(
+			&dto.EffectiveFrom,
+			&dto.BasePriceNumerator,
+			&dto.BasePriceDenominator,
+			&dto.Currency,
+			&discountKind,
+			&discountPercent,
+			&discountAmountNum,
+			&discountAmountDenom,
+			&discountStart,
+			&discountEnd,
+		); err != nil {
+			return fmt.Errorf("failed to parse price history row: %w", err)
+		}
+
+		if (discountPercent.Valid || discountAmountNum != nil) && discountStart != nil && discountEnd != nil {
+			dto.DiscountKind = discountKind.StringVal
+			if discountPercent.Valid {
+				dto.DiscountPercent = &discountPercent.Numeric
+			}
+			dto.DiscountAmountNumerator = discountAmountNum
+			dto.DiscountAmountDenominator = discountAmountDenom
+			dto.DiscountStartDate = discountStart
+			dto.DiscountEndDate = discountEnd
+		}
+
+		snapshots = append(snapshots, &dto)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price history: %w", err)
+	}
+
+	if len(snapshots) > 0 {
+		if err := r.attachHistorySchedules(ctx, txn, productID, snapshots); err != nil {
+			return nil, err
+		}
+		return snapshots, nil
+	}
+
+	// No recorded history: treat the current pricing as effective since creation
+	row, err := txn.ReadRow(ctx, m_product.Table, spanner.Key{productID},
+		[]string{
+			m_product.CreatedAt,
+			m_product.BasePriceNumerator,
+			m_product.BasePriceDenominator,
+			m_product.Currency,
+		},
+	)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("failed to read product: %w", err)
+	}
+
+	var dto contracts.PriceSnapshotDTO
+	if err := row.Columns(&dto.EffectiveFrom, &dto.BasePriceNumerator, &dto.BasePriceDenominator, &dto.Currency); err != nil {
+		return nil, fmt.Errorf("failed to parse product row: %w", err)
+	}
+	if !dto.EffectiveFrom.Before(to) {
+		return nil, nil
+	}
+
+	return []*contracts.PriceSnapshotDTO{&dto}, nil
+}
+
+// attachHistorySchedules adds the scheduled discount windows recorded with
+// each snapshot, ordered by start date
+func (r *ProductReadModel) attachHistorySchedules(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID string, snapshots []*contracts.PriceSnapshotDTO) error {
+	byEffectiveFrom := make(map[int64]*contracts.PriceSnapshotDTO, len(snapshots))
+	for _, s := range snapshots {
+		byEffectiveFrom[s.EffectiveFrom.UnixNano()] = s
+	}
+
+	stmt := spanner.NewStatement(`
+		SELECT
+			effective_from, start_date, end_date,
+			discount_kind, discount_percent, discount_amount_numerator, discount_amount_denominator
+		FROM product_price_history_discounts
+		WHERE product_id = @product_id AND effective_from <= @last
+		ORDER BY effective_from, start_date
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_id": productID,
+		"last":       snapshots[len(snapshots)-1].EffectiveFrom,
+	}
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var (
+			w               m_price_history_discount.PriceHistoryDiscount
+			discountPercent spanner.NullNumeric
+		)
+		if err := row.Columns(
+			&w.EffectiveFrom,
+			&w.StartDate,
+			&w.EndDate,
+			&w.DiscountKind,
+			&discountPercent,
+			&w.DiscountAmountNumerator,
+			&w.DiscountAmountDenominator,
+		); err != nil {
+			return fmt.Errorf("failed to parse price history discount row: %w", err)
+		}
+
+		snapshot, ok := byEffectiveFrom[w.EffectiveFrom.UnixNano()]
+		if !ok {
+			return nil
+		}
+
+		dto := &contracts.PriceSnapshotDiscountDTO{
+			DiscountKind:              w.DiscountKind,
+			DiscountAmountNumerator:   w.DiscountAmountNumerator,
+			DiscountAmountDenominator: w.DiscountAmountDenominator,
+			StartDate:                 w.StartDate,
+			EndDate:                   w.EndDate,
+		}
+		if discountPercent.Valid {
+			dto.DiscountPercent = &discountPercent.Numeric
+		}
+		snapshot.Schedule = append(snapshot.Schedule, dto)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read price history discounts: %w", err)
+	}
+
+	return nil
+}
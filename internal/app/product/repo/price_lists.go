@@ -0,0 +1,276 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_price_list"
+	"product-catalog-service/internal/models/m_price_list_entry"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+var priceListColumns = []string{
+	m_price_list.PriceListID,
+	m_price_list.Name,
+	m_price_list.Currency,
+	m_price_list.Channel,
+	m_price_list.Segment,
+	m_price_list.ValidFrom,
+	m_price_list.ValidTo,
+	m_price_list.CreatedAt,
+	m_price_list.UpdatedAt,
+	m_price_list.Version,
+}
+
+// PriceListRepo implements price list persistence for Spanner
+type PriceListRepo struct {
+	client *spanner.Client
+}
+
+// NewPriceListRepo creates a new Spanner price list repository
+func NewPriceListRepo(client *spanner.Client) *PriceListRepo {
+	return &PriceListRepo{
+		client: client,
+	}
+}
+
+// InsertMut returns a mutation to insert a price list
+func (r *PriceListRepo) InsertMut(list *domain.PriceList) *spanner.Mutation {
+	l := priceListToModel(list)
+	return spanner.InsertMap(m_price_list.Table, l.ToMap())
+}
+
+// UpdateMut returns a mutation to update a price list (targeted by change tracker)
+func (r *PriceListRepo) UpdateMut(list *domain.PriceList) *spanner.Mutation {
+	if !list.Changes().HasChanges() {
+		return nil // No changes to apply
+	}
+
+	updates := map[string]interface{}{
+		m_price_list.PriceListID: list.ID(),
+		m_price_list.UpdatedAt:   time.Now(),
+		m_price_list.Version:     int64(list.Version()) + 1,
+	}
+
+	return spanner.UpdateMap(m_price_list.Table, updates)
+}
+
+// EntryMuts returns mutations writing the entries set or removed since the
+// list was loaded
+func (r *PriceListRepo) EntryMuts(list *domain.PriceList) []*spanner.Mutation {
+	if !list.Changes().Dirty(domain.FieldPriceListEntries) {
+		return nil
+	}
+
+	changed := list.ChangedEntries()
+	mutations := make([]*spanner.Mutation, 0, len(changed))
+
+	for _, productID := range changed {
+		price := list.Price(productID)
+		if price == nil {
+			mutations = append(mutations, spanner.Delete(m_price_list_entry.Table, spanner.Key{list.ID(), productID}))
+			continue
+		}
+
+		e := &m_price_list_entry.PriceListEntry{
+			PriceListID:      list.ID(),
+			ProductID:        productID,
+			PriceNumerator:   price.Numerator(),
+			PriceDenominator: price.Denominator(),
+			UpdatedAt:        list.UpdatedAt(),
+		}
+		mutations = append(mutations, spanner.InsertOrUpdateMap(m_price_list_entry.Table, e.ToMap()))
+	}
+
+	return mutations
+}
+
+// VersionPrecondition returns a precondition requiring the stored version to
+// still match the version the price list was loaded with
+func (r *PriceListRepo) VersionPrecondition(list *domain.PriceList) commitplan.Precondition {
+	return commitplan.Precondition{
+		Table:    m_price_list.Table,
+		Key:      spanner.Key{list.ID()},
+		Column:   m_price_list.Version,
+		Expected: int64(list.Version()),
+		Err:      domain.ErrConcurrentModification,
+	}
+}
+
+// FindByID retrieves a price list by ID along with its entries for productIDs.
+// Entries of other products are not loaded.
+func (r *PriceListRepo) FindByID(ctx context.Context, priceListID string, productIDs ...string) (*domain.PriceList, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	return readPriceList(ctx, txn, priceListID, productIDs)
+}
+
+// applyPriceList replaces the base price of each DTO with its price in the
+// list opts.PriceListID, when the list is valid at opts.AsOf, and reprices the
+// DTO with its discount. Products the list does not price keep their default
+// price.
+func (r *ProductReadModel) applyPriceList(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO, discounts map[string]discountColumns, opts contracts.ReadOptions) error {
+	if opts.PriceListID == "" || len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]string, 0, len(products))
+	for _, dto := range products {
+		productIDs = append(productIDs, dto.ProductID)
+	}
+
+	list, err := readPriceList(ctx, txn, opts.PriceListID, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, dto := range products {
+		defaultPrice, err := domain.NewMoneyFromRat(big.NewRat(dto.BasePriceNumerator, dto.BasePriceDenominator), dto.Currency)
+		if err != nil {
+			return err
+		}
+
+		price := r.calculator.ResolveBasePrice(defaultPrice, list, dto.ProductID, opts.AsOf)
+		if price == defaultPrice {
+			continue
+		}
+
+		dto.PriceListID = list.ID()
+		dto.BasePriceNumerator = price.Numerator()
+		dto.BasePriceDenominator = price.Denominator()
+		dto.EffectivePriceNumerator = price.Numerator()
+		dto.EffectivePriceDenominator = price.Denominator()
+		if err := applyDiscountAt(dto, discounts[dto.ProductID], opts.AsOf); err != nil {
+			return err
+		}
+		r.formatPrices(dto)
+	}
+
+	return nil
+}
+
+// readPriceList reads a price list within txn along with its entries for productIDs
+func readPriceList(ctx context.Context, txn *spanner.ReadOnlyTransaction, priceListID string, productIDs []string) (*domain.PriceList, error) {
+	row, err := txn.ReadRow(ctx, m_price_list.Table, spanner.Key{priceListID}, priceListColumns)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrPriceListNotFound
+		}
+		return nil, fmt.Errorf("failed to read price list: %w", err)
+	}
+
+	l, err := parsePriceListRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*domain.Money, len(productIDs))
+
+	if len(productIDs) > 0 {
+		stmt := spanner.Statement{
+			SQL: `SELECT product_id, price_numerator, price_denominator
+				FROM price_list_entries
+				WHERE price_list_id = @price_list_id AND product_id IN UNNEST(@product_ids)`,
+			Params: map[string]interface{}{
+				"price_list_id": priceListID,
+				"product_ids":   productIDs,
+			},
+		}
+
+		err = txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+			var e m_price_list_entry.PriceListEntry
+			if err := row.Columns(&e.ProductID, &e.PriceNumerator, &e.PriceDenominator); err != nil {
+				return fmt.Errorf("failed to parse price list entry row: %w", err)
+			}
+
+			price, err := domain.NewMoney(e.PriceNumerator, e.PriceDenominator, l.Currency)
+			if err != nil {
+				return err
+			}
+
+			entries[e.ProductID] = price
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price list entries: %w", err)
+		}
+	}
+
+	return modelToPriceList(l, entries)
+}
+
+func parsePriceListRow(row *spanner.Row) (*m_price_list.PriceList, error) {
+	var l m_price_list.PriceList
+	if err := row.Columns(
+		&l.PriceListID,
+		&l.Name,
+		&l.Currency,
+		&l.Channel,
+		&l.Segment,
+		&l.ValidFrom,
+		&l.ValidTo,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+		&l.Version,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse price list row: %w", err)
+	}
+	return &l, nil
+}
+
+func priceListToModel(list *domain.PriceList) *m_price_list.PriceList {
+	return &m_price_list.PriceList{
+		PriceListID: list.ID(),
+		Name:        list.Name(),
+		Currency:    list.Currency().Code(),
+		Channel:     nullableString(list.Channel()),
+		Segment:     nullableString(list.Segment()),
+		ValidFrom:   list.ValidFrom(),
+		ValidTo:     list.ValidTo(),
+		CreatedAt:   list.CreatedAt(),
+		UpdatedAt:   list.UpdatedAt(),
+		Version:     int64(list.Version()),
+	}
+}
+
+func modelToPriceList(l *m_price_list.PriceList, entries map[string]*domain.Money) (*domain.PriceList, error) {
+	var channel, segment string
+	if l.Channel != nil {
+		channel = *l.Channel
+	}
+	if l.Segment != nil {
+		segment = *l.Segment
+	}
+
+	return domain.ReconstructPriceList(
+		l.PriceListID,
+		l.Name,
+		l.Currency,
+		channel,
+		segment,
+		l.ValidFrom,
+		l.ValidTo,
+		entries,
+		l.CreatedAt,
+		l.UpdatedAt,
+		int(l.Version),
+	)
+}
+
+// nullableString stores an empty string as NULL
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
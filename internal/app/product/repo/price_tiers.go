@@ -0,0 +1,177 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product_price_tier"
+)
+
+// PriceTierMuts returns mutations replacing the product's price tiers, or nil
+// if the tiers did not change
+func (r *ProductRepo) PriceTierMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldPriceTiers) {
+		return nil
+	}
+
+	tiers := product.PriceTiers()
+
+	// Mutations apply in order, so the prefix delete clears the old tiers first
+	mutations := make([]*spanner.Mutation, 0, len(tiers)+1)
+	mutations = append(mutations, spanner.Delete(m_product_price_tier.Table, spanner.Key{product.ID()}.AsPrefix()))
+
+	for _, t := range tiers {
+		m := &m_product_price_tier.ProductPriceTier{
+			ProductID:        product.ID(),
+			MinQuantity:      t.MinQuantity(),
+			PriceNumerator:   t.Price().Numerator(),
+			PriceDenominator: t.Price().Denominator(),
+		}
+		mutations = append(mutations, spanner.InsertMap(m_product_price_tier.Table, m.ToMap()))
+	}
+
+	return mutations
+}
+
+// findPriceTiers reads the price tiers of a product within txn
+func (r *ProductRepo) findPriceTiers(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID, currencyCode string) ([]domain.PriceTier, error) {
+	tiers, err := readPriceTiers(ctx, txn, map[string]string{productID: currencyCode})
+	if err != nil {
+		return nil, err
+	}
+	return tiers[productID], nil
+}
+
+// readPriceTiers reads the price tiers of products within txn, keyed by
+// product ID. currencies maps each product ID to the product's currency.
+func readPriceTiers(ctx context.Context, txn *spanner.ReadOnlyTransaction, currencies map[string]string) (map[string][]domain.PriceTier, error) {
+	productIDs := make([]string, 0, len(currencies))
+	for id := range currencies {
+		productIDs = append(productIDs, id)
+	}
+
+	tiers := make(map[string][]domain.PriceTier)
+
+	err := txn.Query(ctx, priceTiersStatement(productIDs)).Do(func(row *spanner.Row) error {
+		t, err := parsePriceTierRow(row)
+		if err != nil {
+			return err
+		}
+
+		price, err := domain.NewMoney(t.PriceNumerator, t.PriceDenominator, currencies[t.ProductID])
+		if err != nil {
+			return err
+		}
+
+		tier, err := domain.NewPriceTier(t.MinQuantity, price)
+		if err != nil {
+			return err
+		}
+
+		tiers[t.ProductID] = append(tiers[t.ProductID], tier)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price tiers: %w", err)
+	}
+
+	return tiers, nil
+}
+
+// attachPriceTiers reads the price tiers of products within txn and sets them
+// on each DTO, priced with the discount active on the product at t
+func (r *ProductReadModel) attachPriceTiers(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO, discounts map[string]discountColumns, t time.Time) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*contracts.ProductDTO, len(products))
+	productIDs := make([]string, 0, len(products))
+	for _, dto := range products {
+		dto.PriceTiers = make([]*contracts.PriceTierDTO, 0)
+		byID[dto.ProductID] = dto
+		productIDs = append(productIDs, dto.ProductID)
+	}
+
+	err := txn.Query(ctx, priceTiersStatement(productIDs)).Do(func(row *spanner.Row) error {
+		m, err := parsePriceTierRow(row)
+		if err != nil {
+			return err
+		}
+
+		dto, ok := byID[m.ProductID]
+		if !ok {
+			return nil
+		}
+
+		tier, err := r.priceTierDTO(dto, m, discounts[m.ProductID], t)
+		if err != nil {
+			return err
+		}
+		dto.PriceTiers = append(dto.PriceTiers, tier)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read price tiers: %w", err)
+	}
+
+	return nil
+}
+
+// priceTierDTO prices a tier row of product, applying d if it is active at t
+func (r *ProductReadModel) priceTierDTO(product *contracts.ProductDTO, m *m_product_price_tier.ProductPriceTier, d discountColumns, t time.Time) (*contracts.PriceTierDTO, error) {
+	dto := &contracts.PriceTierDTO{
+		MinQuantity:               m.MinQuantity,
+		PriceNumerator:            m.PriceNumerator,
+		PriceDenominator:          m.PriceDenominator,
+		EffectivePriceNumerator:   m.PriceNumerator,
+		EffectivePriceDenominator: m.PriceDenominator,
+	}
+
+	if d.activeAt(t) {
+		num, denom, err := d.discount(m.PriceNumerator, m.PriceDenominator)
+		if err != nil {
+			return nil, err
+		}
+		dto.EffectivePriceNumerator, dto.EffectivePriceDenominator = num, denom
+	}
+
+	if s, err := domain.FormatAmount(dto.PriceNumerator, dto.PriceDenominator, product.Currency, r.rounding); err == nil {
+		dto.PriceDecimal = s
+	}
+	if s, err := domain.FormatAmount(dto.EffectivePriceNumerator, dto.EffectivePriceDenominator, product.Currency, r.rounding); err == nil {
+		dto.EffectivePriceDecimal = s
+	}
+
+	return dto, nil
+}
+
+func priceTiersStatement(productIDs []string) spanner.Statement {
+	stmt := spanner.NewStatement(`
+		SELECT product_id, min_quantity, price_numerator, price_denominator
+		FROM product_price_tiers
+		WHERE product_id IN UNNEST(@product_ids)
+		ORDER BY product_id, min_quantity
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+	}
+	return stmt
+}
+
+func parsePriceTierRow(row *spanner.Row) (*m_product_price_tier.ProductPriceTier, error) {
+	var t m_product_price_tier.ProductPriceTier
+	if err := row.Columns(
+		&t.ProductID,
+		&t.MinQuantity,
+		&t.PriceNumerator,
+		&t.PriceDenominator,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse price tier row: %w", err)
+	}
+	return &t, nil
+}
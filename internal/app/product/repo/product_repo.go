@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -12,6 +13,7 @@ import (
 	"product-catalog-service/internal/app/product/domain"
 	"product-catalog-service/internal/models/m_outbox"
 	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/pkg/commitplan"
 )
 
 type spannerContext = context.Context
@@ -56,23 +58,49 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) *spanner.Mutation {
 	if product.Changes().Dirty(domain.FieldBasePrice) {
 		updates[m_product.BasePriceNumerator] = product.BasePrice().Numerator()
 		updates[m_product.BasePriceDenominator] = product.BasePrice().Denominator()
+		updates[m_product.Currency] = product.BasePrice().Currency().Code()
+	}
+
+	if product.Changes().Dirty(domain.FieldTaxClass) {
+		updates[m_product.TaxClass] = product.TaxClass()
 	}
 
 	if product.Changes().Dirty(domain.FieldDiscount) {
 		if d := product.Discount(); d != nil {
-			updates[m_product.DiscountPercent] = d.Percentage()
+			updates[m_product.DiscountKind] = string(d.Kind())
 			updates[m_product.DiscountStartDate] = d.StartDate()
 			updates[m_product.DiscountEndDate] = d.EndDate()
+			updates[m_product.DiscountPhase] = string(product.DiscountPhase())
+			if amount := d.Amount(); amount != nil {
+				updates[m_product.DiscountPercent] = nil
+				updates[m_product.DiscountAmountNumerator] = amount.Numerator()
+				updates[m_product.DiscountAmountDenominator] = amount.Denominator()
+			} else {
+				updates[m_product.DiscountPercent] = spanner.NullNumeric{Numeric: *d.Percentage(), Valid: true}
+				updates[m_product.DiscountAmountNumerator] = nil
+				updates[m_product.DiscountAmountDenominator] = nil
+			}
 		} else {
+			updates[m_product.DiscountKind] = nil
 			updates[m_product.DiscountPercent] = nil
+			updates[m_product.DiscountAmountNumerator] = nil
+			updates[m_product.DiscountAmountDenominator] = nil
 			updates[m_product.DiscountStartDate] = nil
 			updates[m_product.DiscountEndDate] = nil
+			updates[m_product.DiscountPhase] = nil
 		}
 	}
 
+	// Listings sort and filter by the materialised effective price
+	if product.Changes().Dirty(domain.FieldBasePrice) || product.Changes().Dirty(domain.FieldDiscount) ||
+		product.Changes().Dirty(domain.FieldDiscountSchedule) || product.Changes().Dirty(domain.FieldListingPrice) {
+		updates[m_product.EffectivePrice] = product.ListingPrice().Value()
+	}
+
 	if product.Changes().Dirty(domain.FieldStatus) || product.Changes().HasChanges() {
 		updates[m_product.Status] = string(product.Status())
 		updates[m_product.UpdatedAt] = time.Now()
+		updates[m_product.Version] = int64(product.Version()) + 1
 	}
 
 	if product.Changes().Dirty(domain.FieldArchivedAt) {
@@ -83,10 +111,24 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) *spanner.Mutation {
 		return nil // No changes to apply
 	}
 
+	updates[m_product.ProductID] = product.ID()
+
 	mutation := spanner.UpdateMap(m_product.Table, updates)
 	return mutation
 }
 
+// VersionPrecondition returns a precondition requiring the stored version to
+// still match the version the product was loaded with
+func (r *ProductRepo) VersionPrecondition(product *domain.Product) commitplan.Precondition {
+	return commitplan.Precondition{
+		Table:    m_product.Table,
+		Key:      spanner.Key{product.ID()},
+		Column:   m_product.Version,
+		Expected: int64(product.Version()),
+		Err:      domain.ErrConcurrentModification,
+	}
+}
+
 // FindByID retrieves a product by ID
 func (r *ProductRepo) FindByID(ctx spannerContext, productID string) (*domain.Product, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -95,24 +137,7 @@ func (r *ProductRepo) FindByID(ctx spannerContext, productID string) (*domain.Pr
 	txn := r.client.ReadOnlyTransaction()
 	defer txn.Close()
 
-	row, err := txn.ReadRow(ctx, m_product.Table, spanner.Key{productID},
-		[]string{
-			m_product.ProductID,
-			m_product.Name,
-			m_product.Description,
-			m_product.Category,
-			m_product.BasePriceNumerator,
-			m_product.BasePriceDenominator,
-			m_product.DiscountPercent,
-			m_product.DiscountStartDate,
-			m_product.DiscountEndDate,
-			m_product.Status,
-			m_product.CreatedAt,
-			m_product.UpdatedAt,
-			m_product.ArchivedAt,
-		},
-	)
-
+	row, err := txn.ReadRow(ctx, m_product.Table, spanner.Key{productID}, productColumns)
 	if err != nil {
 		// Check for not found error
 		if spanner.ErrCode(err) == codes.NotFound {
@@ -121,8 +146,164 @@ func (r *ProductRepo) FindByID(ctx spannerContext, productID string) (*domain.Pr
 		return nil, fmt.Errorf("failed to read product: %w", err)
 	}
 
+	p, err := parseProductRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := r.findDiscountSchedule(ctx, txn, p.ProductID, p.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := r.findVariants(ctx, txn, p.ProductID, p.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := r.findAttributes(ctx, txn, p.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := r.findBundle(ctx, txn, p.ProductID, p.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	priceTiers, err := r.findPriceTiers(ctx, txn, p.ProductID, p.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.modelToDomain(p, schedule, variants, attributes, bundle, priceTiers)
+}
+
+// FindForPricing retrieves the products with the given IDs, keyed by ID, along
+// with the components of any bundles among them. Only the pricing state is
+// loaded: the base price, status, applied and scheduled discounts, price tiers
+// and bundle definitions, but no variants or attributes, so the products must
+// not be written back. Unknown IDs are skipped.
+func (r *ProductRepo) FindForPricing(ctx spannerContext, productIDs []string) (map[string]*domain.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	models, err := readProducts(ctx, txn, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := make(map[string]string, len(models))
+	for _, p := range models {
+		currencies[p.ProductID] = p.Currency
+	}
+
+	bundles, err := readBundles(ctx, txn, currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bundles are priced from their components, which may not be requested themselves
+	var componentIDs []string
+	seen := make(map[string]bool)
+	for _, bundle := range bundles {
+		for _, c := range bundle.Components() {
+			if _, ok := currencies[c.ProductID()]; !ok && !seen[c.ProductID()] {
+				seen[c.ProductID()] = true
+				componentIDs = append(componentIDs, c.ProductID())
+			}
+		}
+	}
+	if len(componentIDs) > 0 {
+		components, err := readProducts(ctx, txn, componentIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range components {
+			models = append(models, p)
+			currencies[p.ProductID] = p.Currency
+		}
+	}
+
+	schedules, err := r.findDiscountSchedules(ctx, txn, currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := readPriceTiers(ctx, txn, currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make(map[string]*domain.Product, len(models))
+	for _, p := range models {
+		product, err := r.modelToDomain(p, schedules[p.ProductID], nil, nil, bundles[p.ProductID], tiers[p.ProductID])
+		if err != nil {
+			return nil, err
+		}
+		products[p.ProductID] = product
+	}
+
+	return products, nil
+}
+
+// readProducts reads the products with the given IDs within txn, skipping unknown IDs
+func readProducts(ctx context.Context, txn *spanner.ReadOnlyTransaction, productIDs []string) ([]*m_product.Product, error) {
+	keys := make([]spanner.KeySet, 0, len(productIDs))
+	for _, id := range productIDs {
+		keys = append(keys, spanner.Key{id})
+	}
+
+	models := make([]*m_product.Product, 0, len(productIDs))
+
+	err := txn.Read(ctx, m_product.Table, spanner.KeySets(keys...), productColumns).Do(func(row *spanner.Row) error {
+		p, err := parseProductRow(row)
+		if err != nil {
+			return err
+		}
+		models = append(models, p)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+
+	return models, nil
+}
+
+// productColumns are the columns of the products table read into a domain product
+var productColumns = []string{
+	m_product.ProductID,
+	m_product.Name,
+	m_product.Description,
+	m_product.Category,
+	m_product.BasePriceNumerator,
+	m_product.BasePriceDenominator,
+	m_product.Currency,
+	m_product.TaxClass,
+	m_product.DiscountKind,
+	m_product.DiscountPercent,
+	m_product.DiscountAmountNumerator,
+	m_product.DiscountAmountDenominator,
+	m_product.DiscountStartDate,
+	m_product.DiscountEndDate,
+	m_product.DiscountPhase,
+	m_product.Status,
+	m_product.CreatedAt,
+	m_product.UpdatedAt,
+	m_product.ArchivedAt,
+	m_product.Version,
+}
+
+// parseProductRow parses a row of productColumns
+func parseProductRow(row *spanner.Row) (*m_product.Product, error) {
 	var p m_product.Product
-	var discountPercent *int64
+	var discountKind, discountPhase *string
+	var discountPercent spanner.NullNumeric
+	var discountAmountNum, discountAmountDenom *int64
 	var discountStart, discountEnd, archivedAt *time.Time
 
 	if err := row.Columns(
@@ -132,23 +313,34 @@ func (r *ProductRepo) FindByID(ctx spannerContext, productID string) (*domain.Pr
 		&p.Category,
 		&p.BasePriceNumerator,
 		&p.BasePriceDenominator,
+		&p.Currency,
+		&p.TaxClass,
+		&discountKind,
 		&discountPercent,
+		&discountAmountNum,
+		&discountAmountDenom,
 		&discountStart,
 		&discountEnd,
+		&discountPhase,
 		&p.Status,
 		&p.CreatedAt,
 		&p.UpdatedAt,
 		&archivedAt,
+		&p.Version,
 	); err != nil {
 		return nil, fmt.Errorf("failed to parse product row: %w", err)
 	}
 
+	p.DiscountKind = discountKind
 	p.DiscountPercent = discountPercent
+	p.DiscountAmountNumerator = discountAmountNum
+	p.DiscountAmountDenominator = discountAmountDenom
 	p.DiscountStartDate = discountStart
 	p.DiscountEndDate = discountEnd
+	p.DiscountPhase = discountPhase
 	p.ArchivedAt = archivedAt
 
-	return r.modelToDomain(&p)
+	return &p, nil
 }
 
 // Exists checks if a product exists
@@ -171,6 +363,41 @@ func (r *ProductRepo) Exists(ctx spannerContext, productID string) (bool, error)
 	return true, nil
 }
 
+// ListProductIDs returns up to limit product IDs after afterID, in ID order
+func (r *ProductRepo) ListProductIDs(ctx spannerContext, afterID string, limit int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stmt := spanner.Statement{
+		SQL: `
+			SELECT product_id FROM products
+			WHERE product_id > @after
+			ORDER BY product_id
+			LIMIT @limit
+		`,
+		Params: map[string]interface{}{
+			"after": afterID,
+			"limit": int64(limit),
+		},
+	}
+
+	var productIDs []string
+
+	err := r.client.Single().Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var productID string
+		if err := row.Columns(&productID); err != nil {
+			return fmt.Errorf("failed to parse product row: %w", err)
+		}
+		productIDs = append(productIDs, productID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product IDs: %w", err)
+	}
+
+	return productIDs, nil
+}
+
 // OutboxRepo implements OutboxRepository for Spanner
 type OutboxRepo struct {
 	client *spanner.Client
@@ -214,35 +441,60 @@ func (r *ProductRepo) domainToModel(product *domain.Product) *m_product.Product
 		Category:             product.Category(),
 		BasePriceNumerator:   product.BasePrice().Numerator(),
 		BasePriceDenominator: product.BasePrice().Denominator(),
+		Currency:             product.BasePrice().Currency().Code(),
+		TaxClass:             product.TaxClass(),
+		EffectivePrice:       *product.ListingPrice().Value(),
 		Status:               string(product.Status()),
 		CreatedAt:            product.CreatedAt(),
 		UpdatedAt:            product.UpdatedAt(),
 		ArchivedAt:           product.ArchivedAt(),
+		Version:              int64(product.Version()),
 	}
 
 	if d := product.Discount(); d != nil {
-		percent := d.Percentage()
-		p.DiscountPercent = &percent
+		kind := string(d.Kind())
+		p.DiscountKind = &kind
+		if amount := d.Amount(); amount != nil {
+			p.DiscountAmountNumerator = &[]int64{amount.Numerator()}[0]
+			p.DiscountAmountDenominator = &[]int64{amount.Denominator()}[0]
+		} else {
+			p.DiscountPercent = spanner.NullNumeric{Numeric: *d.Percentage(), Valid: true}
+		}
 		p.DiscountStartDate = &[]time.Time{d.StartDate()}[0]
 		p.DiscountEndDate = &[]time.Time{d.EndDate()}[0]
+		p.DiscountPhase = &[]string{string(product.DiscountPhase())}[0]
 	}
 
 	return p
 }
 
-func (r *ProductRepo) modelToDomain(p *m_product.Product) (*domain.Product, error) {
-	var discountPercent int64
+func (r *ProductRepo) modelToDomain(p *m_product.Product, schedule []*domain.ScheduledDiscount, variants []*domain.Variant, attributes map[string]domain.AttributeValue, bundle *domain.Bundle, priceTiers []domain.PriceTier) (*domain.Product, error) {
+	var discountKind, discountPhase string
+	var discountPercent *big.Rat
+	var discountAmountNum, discountAmountDenom int64
 	var discountStart, discountEnd time.Time
 
-	hasDiscount := p.DiscountPercent != nil
+	hasDiscount := p.DiscountPercent.Valid || p.DiscountAmountNumerator != nil
 	if hasDiscount {
-		discountPercent = *p.DiscountPercent
+		if p.DiscountKind != nil {
+			discountKind = *p.DiscountKind
+		}
+		if p.DiscountPercent.Valid {
+			discountPercent = &p.DiscountPercent.Numeric
+		}
+		if p.DiscountAmountNumerator != nil && p.DiscountAmountDenominator != nil {
+			discountAmountNum = *p.DiscountAmountNumerator
+			discountAmountDenom = *p.DiscountAmountDenominator
+		}
 		if p.DiscountStartDate != nil {
 			discountStart = *p.DiscountStartDate
 		}
 		if p.DiscountEndDate != nil {
 			discountEnd = *p.DiscountEndDate
 		}
+		if p.DiscountPhase != nil {
+			discountPhase = *p.DiscountPhase
+		}
 	}
 
 	return domain.ReconstructProduct(
@@ -252,13 +504,24 @@ func (r *ProductRepo) modelToDomain(p *m_product.Product) (*domain.Product, erro
 		p.Category,
 		p.BasePriceNumerator,
 		p.BasePriceDenominator,
+		p.Currency,
+		discountKind,
 		discountPercent,
+		discountAmountNum,
+		discountAmountDenom,
 		discountStart,
 		discountEnd,
+		discountPhase,
 		p.Status,
 		p.CreatedAt,
 		p.UpdatedAt,
 		p.ArchivedAt,
-		0, // version
+		int(p.Version),
+		schedule,
+		variants,
+		attributes,
+		bundle,
+		priceTiers,
+		p.TaxClass,
 	)
 }
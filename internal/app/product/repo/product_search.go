@@ -0,0 +1,177 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/fulltext"
+)
+
+// maxSearchResults bounds how deep search results can be paged
+const maxSearchResults = 1000
+
+// descriptionExcerptRunes is the length of description highlights
+const descriptionExcerptRunes = 160
+
+// ProductSearch implements full-text product search. The index ranks the
+// matching products; the read model prices and renders them like listings.
+type ProductSearch struct {
+	readModel *ProductReadModel
+	index     SearchIndex
+}
+
+// NewProductSearch creates a product search over index, reading products and
+// signing page tokens through readModel
+func NewProductSearch(readModel *ProductReadModel, index SearchIndex) *ProductSearch {
+	return &ProductSearch{
+		readModel: readModel,
+		index:     index,
+	}
+}
+
+// SearchProducts retrieves a page of the products matching every search term
+// and the filters, best match first
+func (s *ProductSearch) SearchProducts(ctx context.Context, filter contracts.SearchProductsFilter) (*contracts.SearchResultsDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	opts := filter.Products.ReadOptions
+
+	pageSize := filter.Products.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	// Pages continue at the offset of the previous page's end
+	offset := 0
+	fingerprint := searchFingerprint(filter)
+	if filter.Products.PageToken != "" {
+		token, err := s.readModel.decodePageToken(filter.Products.PageToken, sortByRelevance, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		offset, err = strconv.Atoi(token.LastKey[0])
+		if err != nil || offset <= 0 || offset >= maxSearchResults {
+			return nil, domain.ErrInvalidPageToken
+		}
+	}
+	if offset+pageSize > maxSearchResults {
+		pageSize = maxSearchResults - offset
+	}
+
+	params := map[string]interface{}{}
+	table, conditions, err := filterConditions(filter.Products, params)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := s.readModel.readOnlyTransaction(opts)
+	defer txn.Close()
+
+	hits, err := s.index.Search(ctx, txn, searchQuery{
+		terms:      filter.Terms,
+		table:      table,
+		conditions: conditions,
+		params:     params,
+		offset:     offset,
+		limit:      pageSize + 1, // Fetch one extra to determine if there's a next page
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	more := len(hits) > pageSize
+	if more {
+		hits = hits[:pageSize]
+	}
+
+	var nextPageToken string
+	if more && offset+pageSize < maxSearchResults {
+		nextPageToken, err = s.readModel.encodePageToken(pageToken{
+			Sort:    sortByRelevance,
+			LastKey: []string{strconv.Itoa(offset + pageSize)},
+			Filter:  fingerprint,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %w", err)
+		}
+	}
+
+	products, discounts, err := s.readProducts(ctx, txn, hits, opts.AsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.readModel.attachDetails(ctx, txn, products, discounts, opts); err != nil {
+		return nil, err
+	}
+
+	results := make([]*contracts.SearchResultDTO, len(products))
+	for i, dto := range products {
+		results[i] = &contracts.SearchResultDTO{
+			Product:       dto,
+			Score:         hits[i].Score,
+			NameHighlight: fulltext.Highlight(dto.Name, filter.Terms, 0),
+		}
+		if fulltext.ContainsAny(dto.Description, filter.Terms) {
+			results[i].DescriptionHighlight = fulltext.Highlight(dto.Description, filter.Terms, descriptionExcerptRunes)
+		}
+	}
+
+	return &contracts.SearchResultsDTO{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// readProducts reads the products of hits, in the order of hits, priced at t
+func (s *ProductSearch) readProducts(ctx context.Context, txn *spanner.ReadOnlyTransaction, hits []fulltext.Hit, t time.Time) ([]*contracts.ProductDTO, map[string]discountColumns, error) {
+	discounts := make(map[string]discountColumns)
+	if len(hits) == 0 {
+		return nil, discounts, nil
+	}
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+
+	stmt := spanner.NewStatement(productSelect() + `
+		WHERE p.product_id IN UNNEST(@product_ids)
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": ids,
+		"as_of":       t,
+	}
+
+	byID := make(map[string]*contracts.ProductDTO, len(ids))
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		dto, discount, err := s.readModel.parseProductRow(row, t)
+		if err != nil {
+			return err
+		}
+
+		byID[dto.ProductID] = dto
+		discounts[dto.ProductID] = discount
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read search results: %w", err)
+	}
+
+	products := make([]*contracts.ProductDTO, 0, len(ids))
+	for _, id := range ids {
+		dto, ok := byID[id]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %s", domain.ErrProductNotFound, id)
+		}
+		products = append(products, dto)
+	}
+
+	return products, discounts, nil
+}
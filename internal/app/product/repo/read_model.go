@@ -2,35 +2,46 @@ package repo
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc/codes"
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
 	"product-catalog-service/internal/models/m_product"
 )
 
 // ProductReadModel implements ProductReadModel for Spanner
 type ProductReadModel struct {
-	client *spanner.Client
+	client       *spanner.Client
+	rounding     domain.RoundingMode
+	calculator   *services.PricingCalculator // Prices bundles from their components
+	pageTokenKey []byte                      // Signs listing page tokens
 }
 
-// NewProductReadModel creates a new Spanner product read model
-func NewProductReadModel(client *spanner.Client) *ProductReadModel {
+// NewProductReadModel creates a new Spanner product read model that renders
+// decimal prices with the given rounding mode and signs page tokens with
+// pageTokenKey. Without a key, page tokens are signed with a random key and
+// only accepted by this read model.
+func NewProductReadModel(client *spanner.Client, rounding domain.RoundingMode, pageTokenKey []byte) *ProductReadModel {
 	return &ProductReadModel{
-		client: client,
+		client:       client,
+		rounding:     rounding,
+		calculator:   services.NewPricingCalculator(),
+		pageTokenKey: newPageTokenKey(pageTokenKey),
 	}
 }
 
-// GetProduct retrieves a product by ID with effective price calculated
-func (r *ProductReadModel) GetProduct(ctx context.Context, productID string) (*contracts.ProductDTO, error) {
+// GetProduct retrieves a product by ID with effective price calculated at opts.AsOf
+func (r *ProductReadModel) GetProduct(ctx context.Context, productID string, opts contracts.ReadOptions) (*contracts.ProductDTO, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	txn := r.client.ReadOnlyTransaction()
+	txn := r.readOnlyTransaction(opts)
 	defer txn.Close()
 
 	row, err := txn.ReadRow(ctx, m_product.Table, spanner.Key{productID},
@@ -41,7 +52,12 @@ func (r *ProductReadModel) GetProduct(ctx context.Context, productID string) (*c
 			m_product.Category,
 			m_product.BasePriceNumerator,
 			m_product.BasePriceDenominator,
+			m_product.Currency,
+			m_product.TaxClass,
+			m_product.DiscountKind,
 			m_product.DiscountPercent,
+			m_product.DiscountAmountNumerator,
+			m_product.DiscountAmountDenominator,
 			m_product.DiscountStartDate,
 			m_product.DiscountEndDate,
 			m_product.Status,
@@ -59,18 +75,18 @@ func (r *ProductReadModel) GetProduct(ctx context.Context, productID string) (*c
 	}
 
 	var (
-		productIDVal            string
-		name                    string
-		description             string
-		category                string
-		basePriceNum            int64
-		basePriceDenom           int64
-		discountPercent         *int64
-		discountStart           *time.Time
-		discountEnd             *time.Time
-		status                  string
-		createdAt               time.Time
-		updatedAt               time.Time
+		productIDVal   string
+		name           string
+		description    string
+		category       string
+		basePriceNum   int64
+		basePriceDenom int64
+		currency       string
+		taxClass       string
+		discount       discountColumns
+		status         string
+		createdAt      time.Time
+		updatedAt      time.Time
 	)
 
 	if err := row.Columns(
@@ -80,9 +96,14 @@ func (r *ProductReadModel) GetProduct(ctx context.Context, productID string) (*c
 		&category,
 		&basePriceNum,
 		&basePriceDenom,
-		&discountPercent,
-		&discountStart,
-		&discountEnd,
+		&currency,
+		&taxClass,
+		&discount.kind,
+		&discount.percent,
+		&discount.amountNum,
+		&discount.amountDenom,
+		&discount.start,
+		&discount.end,
 		&status,
 		&createdAt,
 		&updatedAt,
@@ -91,41 +112,75 @@ func (r *ProductReadModel) GetProduct(ctx context.Context, productID string) (*c
 	}
 
 	dto := &contracts.ProductDTO{
-		ProductID:               productIDVal,
-		Name:                    name,
-		Description:             description,
-		Category:                category,
-		BasePriceNumerator:      basePriceNum,
-		BasePriceDenominator:    basePriceDenom,
-		Status:                  status,
-		CreatedAtSec:            createdAt.Unix(),
-		UpdatedAtSec:            updatedAt.Unix(),
-		EffectivePriceNumerator: basePriceNum,
+		ProductID:                 productIDVal,
+		Name:                      name,
+		Description:               description,
+		Category:                  category,
+		BasePriceNumerator:        basePriceNum,
+		BasePriceDenominator:      basePriceDenom,
+		Currency:                  currency,
+		TaxClass:                  taxClass,
+		Status:                    status,
+		CreatedAtSec:              createdAt.Unix(),
+		UpdatedAtSec:              updatedAt.Unix(),
+		EffectivePriceNumerator:   basePriceNum,
 		EffectivePriceDenominator: basePriceDenom,
 	}
 
 	// Calculate effective price if discount is active
-	now := time.Now()
-	if discountPercent != nil && discountStart != nil && discountEnd != nil {
-		if (now.Equal(*discountStart) || now.After(*discountStart)) &&
-		   (now.Before(*discountEnd) || now.Equal(*discountEnd)) {
-			dto.HasDiscount = true
-			dto.DiscountPercent = discountPercent
-			dto.DiscountStartDate = &[]int64{discountStart.Unix()}[0]
-			dto.DiscountEndDate = &[]int64{discountEnd.Unix()}[0]
-
-			// Calculate effective price: base * (1 - discount/100)
-			// effectiveNum = baseNum * (100 - discountPercent)
-			// effectiveDenom = baseDenom * 100
-			discountFactor := 100 - *discountPercent
-			dto.EffectivePriceNumerator = basePriceNum * discountFactor
-			dto.EffectivePriceDenominator = basePriceDenom * 100
+	// Fall back to a scheduled discount when the product's own discount is not active
+	if !discount.activeAt(opts.AsOf) {
+		discount, err = r.scheduledDiscountAt(ctx, txn, productID, opts.AsOf)
+		if err != nil {
+			return nil, err
 		}
 	}
 
+	if err := applyDiscountAt(dto, discount, opts.AsOf); err != nil {
+		return nil, err
+	}
+	r.formatPrices(dto)
+
+	discounts := map[string]discountColumns{productID: discount}
+	if err := r.attachDetails(ctx, txn, []*contracts.ProductDTO{dto}, discounts, opts); err != nil {
+		return nil, err
+	}
+
 	return dto, nil
 }
 
+// attachDetails prices products from opts' price list and attaches their
+// variants, price tiers, attributes, stock, bundle components and taxes
+func (r *ProductReadModel) attachDetails(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO, discounts map[string]discountColumns, opts contracts.ReadOptions) error {
+	// Variants and price tiers inherit their product's base price and discount
+	if err := r.applyPriceList(ctx, txn, products, discounts, opts); err != nil {
+		return err
+	}
+
+	if err := r.attachVariants(ctx, txn, products, discounts, opts.AsOf); err != nil {
+		return err
+	}
+
+	if err := r.attachPriceTiers(ctx, txn, products, discounts, opts.AsOf); err != nil {
+		return err
+	}
+
+	if err := r.attachAttributes(ctx, txn, products); err != nil {
+		return err
+	}
+
+	if err := r.attachStock(ctx, txn, products); err != nil {
+		return err
+	}
+
+	if err := r.attachBundles(ctx, txn, products, discounts, opts); err != nil {
+		return err
+	}
+
+	// Tax applies to the final effective price, including derived bundle prices
+	return r.attachTax(ctx, txn, products, opts)
+}
+
 // ListProducts retrieves a paginated list of products
 func (r *ProductReadModel) ListProducts(ctx context.Context, filter contracts.ListProductsFilter) (*contracts.PaginatedProductsDTO, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -137,117 +192,115 @@ func (r *ProductReadModel) ListProducts(ctx context.Context, filter contracts.Li
 		pageSize = 50
 	}
 
-	// Build query
-	stmt := spanner.NewStatement(`
-		SELECT
-			product_id, name, description, category,
-			base_price_numerator, base_price_denominator,
-			discount_percent, discount_start_date, discount_end_date,
-			status, created_at, updated_at
-		FROM products
-		WHERE @status IS NULL OR status = @status
-			AND (@category IS NULL OR category = @category)
-		ORDER BY product_id
-		LIMIT @limit
-	`)
-
 	params := map[string]interface{}{
-		"status":   filter.Status,
-		"category": filter.Category,
-		"limit":    pageSize + 1, // Fetch one extra to determine if there's a next page
+		"as_of": filter.ReadOptions.AsOf,
+		"limit": pageSize + 1, // Fetch one extra to determine if there's a next page
 	}
 
-	stmt.Params = params
-
-	txn := r.client.ReadOnlyTransaction()
-	defer txn.Close()
+	table, conditions, err := filterConditions(filter, params)
+	if err != nil {
+		return nil, err
+	}
 
-	iter := txn.Query(ctx, stmt)
-	defer iter.Stop()
+	columns, sorted := sortColumns[filter.SortBy]
+	if !sorted && filter.SortBy != contracts.SortByProductID {
+		return nil, domain.ErrUnsupportedSortOrder
+	}
 
-	var products []*contracts.ProductDTO
+	direction, after := "ASC", ">"
+	if filter.Descending {
+		direction, after = "DESC", "<"
+	}
 
-	for {
-		row, err := iter.Next()
+	// Resume after the last product of the previous page. Products sharing its
+	// sort value follow it in product ID order.
+	sort := sortName(filter)
+	fingerprint := filterFingerprint(filter)
+	if filter.PageToken != "" {
+		token, err := r.decodePageToken(filter.PageToken, sort, fingerprint)
 		if err != nil {
-			if spanner.ErrCode(err) == codes.NotFound {
-				break
+			return nil, err
+		}
+		if len(token.LastKey) != len(columns)+1 {
+			return nil, domain.ErrInvalidPageToken
+		}
+		params["after_product_id"] = token.LastKey[len(columns)]
+		for i, column := range columns {
+			afterValue, err := decodeSortKey(token.LastKey[i], column)
+			if err != nil {
+				return nil, err
 			}
-			return nil, fmt.Errorf("failed to iterate products: %w", err)
+			params[fmt.Sprintf("after_value_%d", i)] = afterValue
 		}
+		conditions = append(conditions, afterSortKey(columns, after))
+	}
 
-		var (
-			productIDVal            string
-			name                    string
-			description             string
-			category                string
-			basePriceNum            int64
-			basePriceDenom           int64
-			discountPercent         *int64
-			discountStart           *time.Time
-			discountEnd             *time.Time
-			status                  string
-			createdAt               time.Time
-			updatedAt               time.Time
-		)
-
-		if err := row.Columns(
-			&productIDVal,
-			&name,
-			&description,
-			&category,
-			&basePriceNum,
-			&basePriceDenom,
-			&discountPercent,
-			&discountStart,
-			&discountEnd,
-			&status,
-			&createdAt,
-			&updatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("failed to parse product row: %w", err)
-		}
+	// Build query
+	selected := make([]string, len(columns))
+	orderBy := make([]string, 0, len(columns)+1)
+	for i, column := range columns {
+		selected[i] = column + " AS " + sortKeyColumn(i)
+		orderBy = append(orderBy, column+" "+direction)
+	}
+	orderBy = append(orderBy, "p.product_id")
+	selectSQL := productSelectFrom(table, selected...)
 
-		dto := &contracts.ProductDTO{
-			ProductID:               productIDVal,
-			Name:                    name,
-			Description:             description,
-			Category:                category,
-			BasePriceNumerator:      basePriceNum,
-			BasePriceDenominator:    basePriceDenom,
-			Status:                  status,
-			CreatedAtSec:            createdAt.Unix(),
-			UpdatedAtSec:            updatedAt.Unix(),
-			EffectivePriceNumerator: basePriceNum,
-			EffectivePriceDenominator: basePriceDenom,
+	stmt := spanner.NewStatement(selectSQL + `
+		WHERE ` + whereClause(conditions) + `
+		ORDER BY ` + strings.Join(orderBy, ", ") + `
+		LIMIT @limit
+	`)
+
+	stmt.Params = params
+
+	txn := r.readOnlyTransaction(filter.ReadOptions)
+	defer txn.Close()
+
+	var products []*contracts.ProductDTO
+	var sortKeys [][]string
+	discounts := make(map[string]discountColumns)
+
+	err = txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		dto, discount, err := r.parseProductRow(row, filter.ReadOptions.AsOf)
+		if err != nil {
+			return err
 		}
 
-		// Calculate effective price if discount is active
-		now := time.Now()
-		if discountPercent != nil && discountStart != nil && discountEnd != nil {
-			if (now.Equal(*discountStart) || now.After(*discountStart)) &&
-			   (now.Before(*discountEnd) || now.Equal(*discountEnd)) {
-				dto.HasDiscount = true
-				dto.DiscountPercent = discountPercent
-				dto.DiscountStartDate = &[]int64{discountStart.Unix()}[0]
-				dto.DiscountEndDate = &[]int64{discountEnd.Unix()}[0]
-
-				// Calculate effective price
-				discountFactor := 100 - *discountPercent
-				dto.EffectivePriceNumerator = basePriceNum * discountFactor
-				dto.EffectivePriceDenominator = basePriceDenom * 100
+		var key []string
+		for i, column := range columns {
+			value, err := encodeSortKey(row, i, column)
+			if err != nil {
+				return err
 			}
+			key = append(key, value)
 		}
+		sortKeys = append(sortKeys, key)
 
 		products = append(products, dto)
+		discounts[dto.ProductID] = discount
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate products: %w", err)
 	}
 
 	// Check if there's a next page
 	var nextPageToken string
 	if len(products) > pageSize {
 		products = products[:pageSize]
-		lastProduct := products[len(products)-1]
-		nextPageToken = encodePageToken(lastProduct.ProductID)
+		lastKey := append(sortKeys[pageSize-1], products[pageSize-1].ProductID)
+		nextPageToken, err = r.encodePageToken(pageToken{
+			Sort:    sort,
+			LastKey: lastKey,
+			Filter:  fingerprint,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %w", err)
+		}
+	}
+
+	if err := r.attachDetails(ctx, txn, products, discounts, filter.ReadOptions); err != nil {
+		return nil, err
 	}
 
 	return &contracts.PaginatedProductsDTO{
@@ -256,22 +309,275 @@ func (r *ProductReadModel) ListProducts(ctx context.Context, filter contracts.Li
 	}, nil
 }
 
-const iteratorDone = "spanner: iterator done"
+// filterConditions returns the table, with its index hint, and the conditions
+// selecting the products matching filter, binding their parameters in params.
+// Unset filters add no condition, so every bound parameter is non-NULL.
+func filterConditions(filter contracts.ListProductsFilter, params map[string]interface{}) (string, []string, error) {
+	var conditions []string
+	table := "products"
+
+	switch {
+	case filter.Category != "" && filter.IncludeSubcategories:
+		// Match the category's subtree by path prefix instead of the exact category
+		params["category_root"] = filter.Category
+		conditions = append(conditions, `p.category IN (
+			SELECT c.category_id FROM categories c
+			WHERE STARTS_WITH(c.path, (SELECT path FROM categories WHERE category_id = @category_root)))`)
+	case filter.Category != "":
+		// Seek the category's products, and filter their statuses, in the index
+		params["category"] = filter.Category
+		conditions = append(conditions, "p.category = @category")
+		table = "products@{FORCE_INDEX=idx_products_category}"
+	}
+
+	switch {
+	case len(filter.Statuses) > 0:
+		params["statuses"] = filter.Statuses
+		conditions = append(conditions, "p.status IN UNNEST(@statuses)")
+	case !filter.IncludeArchived:
+		params["archived"] = string(domain.ProductStatusArchived)
+		conditions = append(conditions, "p.status != @archived")
+	}
+
+	attributeConditions, err := attributeFilterConditions(filter.AttributeFilters, params)
+	if err != nil {
+		return "", nil, err
+	}
+	conditions = append(conditions, attributeConditions...)
+
+	// Bundles are priced from their components when read, so their stored
+	// price goes stale as components change; they neither sort nor match by it
+	if filter.MinPrice != nil || filter.MaxPrice != nil || filter.SortBy == contracts.SortByEffectivePrice {
+		conditions = append(conditions, notBundleCondition)
+	}
+
+	// Match the stored effective price, which is also the price sort column
+	if filter.MinPrice != nil || filter.MaxPrice != nil {
+		params["price_currency"] = filter.PriceCurrency
+		conditions = append(conditions, "p.currency = @price_currency")
+	}
+	if filter.MinPrice != nil {
+		params["min_price"] = filter.MinPrice
+		conditions = append(conditions, "p.effective_price >= @min_price")
+	}
+	if filter.MaxPrice != nil {
+		params["max_price"] = filter.MaxPrice
+		conditions = append(conditions, "p.effective_price <= @max_price")
+	}
+
+	return table, conditions, nil
+}
+
+// notBundleCondition excludes bundles, whose stored effective price is not
+// the price they are returned at
+const notBundleCondition = `NOT EXISTS (SELECT 1 FROM product_bundles b WHERE b.product_id = p.product_id)`
+
+// whereClause joins conditions, matching every row if there are none
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return "TRUE"
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// productSelect selects the product columns read by parseProductRow, followed
+// by the extra columns, joined with the scheduled discount active at @as_of
+func productSelect(extra ...string) string {
+	return productSelectFrom("products", extra...)
+}
+
+// productSelectFrom is productSelect reading products from table, which may
+// carry a table hint
+func productSelectFrom(table string, extra ...string) string {
+	var columns string
+	for _, c := range extra {
+		columns += ", " + c
+	}
 
-func encodePageToken(productID string) string {
-	return base64.StdEncoding.EncodeToString([]byte(productID))
+	return `
+		SELECT
+			p.product_id, p.name, p.description, p.category,
+			p.base_price_numerator, p.base_price_denominator, p.currency, p.tax_class,
+			p.discount_kind, p.discount_percent, p.discount_amount_numerator, p.discount_amount_denominator,
+			p.discount_start_date, p.discount_end_date,
+			p.status, p.created_at, p.updated_at,
+			d.discount_kind, d.discount_percent, d.discount_amount_numerator, d.discount_amount_denominator,
+			d.start_date, d.end_date` + columns + `
+		FROM ` + table + ` p
+		LEFT JOIN product_discounts d
+			ON d.product_id = p.product_id AND d.start_date <= @as_of AND d.end_date >= @as_of`
 }
 
-func decodePageToken(token string) (string, error) {
-	data, err := base64.StdEncoding.DecodeString(token)
+// parseProductRow parses the product columns of a row selected by productSelect
+// into a DTO priced at t, returning the discount active at t
+func (r *ProductReadModel) parseProductRow(row *spanner.Row, t time.Time) (*contracts.ProductDTO, discountColumns, error) {
+	var (
+		productIDVal   string
+		name           string
+		description    string
+		category       string
+		basePriceNum   int64
+		basePriceDenom int64
+		currency       string
+		taxClass       string
+		discount       discountColumns
+		scheduled      discountColumns
+		status         string
+		createdAt      time.Time
+		updatedAt      time.Time
+	)
+
+	columns := []interface{}{
+		&productIDVal,
+		&name,
+		&description,
+		&category,
+		&basePriceNum,
+		&basePriceDenom,
+		&currency,
+		&taxClass,
+		&discount.kind,
+		&discount.percent,
+		&discount.amountNum,
+		&discount.amountDenom,
+		&discount.start,
+		&discount.end,
+		&status,
+		&createdAt,
+		&updatedAt,
+		&scheduled.kind,
+		&scheduled.percent,
+		&scheduled.amountNum,
+		&scheduled.amountDenom,
+		&scheduled.start,
+		&scheduled.end,
+	}
+	for i, ptr := range columns {
+		if err := row.Column(i, ptr); err != nil {
+			return nil, discountColumns{}, fmt.Errorf("failed to parse product row: %w", err)
+		}
+	}
+
+	dto := &contracts.ProductDTO{
+		ProductID:                 productIDVal,
+		Name:                      name,
+		Description:               description,
+		Category:                  category,
+		BasePriceNumerator:        basePriceNum,
+		BasePriceDenominator:      basePriceDenom,
+		Currency:                  currency,
+		TaxClass:                  taxClass,
+		Status:                    status,
+		CreatedAtSec:              createdAt.Unix(),
+		UpdatedAtSec:              updatedAt.Unix(),
+		EffectivePriceNumerator:   basePriceNum,
+		EffectivePriceDenominator: basePriceDenom,
+	}
+
+	// Calculate effective price if the product's own or a scheduled discount is active
+	if !discount.activeAt(t) {
+		discount = scheduled
+	}
+	if err := applyDiscountAt(dto, discount, t); err != nil {
+		return nil, discountColumns{}, err
+	}
+	r.formatPrices(dto)
+
+	return dto, discount, nil
+}
+
+// readOnlyTransaction returns a transaction reading the latest state, or the
+// stored state at opts.AsOf when a past snapshot is requested
+func (r *ProductReadModel) readOnlyTransaction(opts contracts.ReadOptions) *spanner.ReadOnlyTransaction {
+	txn := r.client.ReadOnlyTransaction()
+	if opts.ReadStoredState {
+		txn = txn.WithTimestampBound(spanner.ReadTimestamp(opts.AsOf))
+	}
+	return txn
+}
+
+// discountColumns holds the nullable discount columns of a products row
+type discountColumns struct {
+	kind        spanner.NullString
+	percent     spanner.NullNumeric
+	amountNum   *int64
+	amountDenom *int64
+	start       *time.Time
+	end         *time.Time
+}
+
+// fixedAmount checks if the columns hold a fixed-amount discount
+func (d discountColumns) fixedAmount() bool {
+	return d.kind.StringVal == string(domain.DiscountKindFixedAmount) &&
+		d.amountNum != nil && d.amountDenom != nil && *d.amountDenom != 0
+}
+
+// activeAt checks if the columns hold a discount whose window contains t
+func (d discountColumns) activeAt(t time.Time) bool {
+	if (!d.percent.Valid && !d.fixedAmount()) || d.start == nil || d.end == nil {
+		return false
+	}
+	return !t.Before(*d.start) && !t.After(*d.end)
+}
+
+// applyDiscountAt sets the discount and effective price on dto if the discount is active at t
+func applyDiscountAt(dto *contracts.ProductDTO, d discountColumns, t time.Time) error {
+	if !d.activeAt(t) {
+		return nil
+	}
+
+	dto.HasDiscount = true
+	dto.DiscountStartDate = &[]int64{d.start.Unix()}[0]
+	dto.DiscountEndDate = &[]int64{d.end.Unix()}[0]
+
+	if d.fixedAmount() {
+		dto.DiscountKind = string(domain.DiscountKindFixedAmount)
+		dto.DiscountAmountNumerator = d.amountNum
+		dto.DiscountAmountDenominator = d.amountDenom
+	} else {
+		dto.DiscountKind = string(domain.DiscountKindPercentage)
+		dto.DiscountPercent = &d.percent.Numeric
+	}
+
+	num, denom, err := d.discount(dto.BasePriceNumerator, dto.BasePriceDenominator)
 	if err != nil {
-		return "", err
+		return err
 	}
-	return string(data), nil
+	dto.EffectivePriceNumerator, dto.EffectivePriceDenominator = num, denom
+	return nil
 }
 
-// Helper for pagination - you can extend this to decode tokens
-func init() {
-	// Register custom JSON encoders if needed
-	json.Marshal(struct{}{})
+// discount applies the discount held by the columns to the price num/denom
+// exactly. It returns ErrAmountOverflow if the discounted price does not fit
+// an int64 fraction, e.g. for a percentage with many decimals.
+func (d discountColumns) discount(num, denom int64) (int64, int64, error) {
+	price := big.NewRat(num, denom)
+
+	var effective *big.Rat
+	if d.fixedAmount() {
+		// Calculate effective price: max(price - amount, 0)
+		effective = new(big.Rat).Sub(price, big.NewRat(*d.amountNum, *d.amountDenom))
+		if effective.Sign() < 0 {
+			effective.SetInt64(0)
+		}
+	} else {
+		// Calculate effective price: price * (1 - discount/100)
+		discountFactor := new(big.Rat).Sub(big.NewRat(1, 1), new(big.Rat).Quo(&d.percent.Numeric, big.NewRat(100, 1)))
+		effective = new(big.Rat).Mul(price, discountFactor)
+	}
+
+	if !effective.Num().IsInt64() || !effective.Denom().IsInt64() {
+		return 0, 0, domain.ErrAmountOverflow
+	}
+	return effective.Num().Int64(), effective.Denom().Int64(), nil
+}
+
+// formatPrices renders the base and effective prices as decimal strings
+func (r *ProductReadModel) formatPrices(dto *contracts.ProductDTO) {
+	if s, err := domain.FormatAmount(dto.BasePriceNumerator, dto.BasePriceDenominator, dto.Currency, r.rounding); err == nil {
+		dto.BasePriceDecimal = s
+	}
+	if s, err := domain.FormatAmount(dto.EffectivePriceNumerator, dto.EffectivePriceDenominator, dto.Currency, r.rounding); err == nil {
+		dto.EffectivePriceDecimal = s
+	}
 }
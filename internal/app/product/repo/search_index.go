@@ -0,0 +1,213 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/models/m_product"
+	"product-catalog-service/internal/pkg/fulltext"
+)
+
+// searchNameWeight is how much more a match in a product's name counts than
+// one in its description
+const searchNameWeight = 2
+
+// SearchIndex ranks the products matching a full-text search
+type SearchIndex interface {
+	// Search returns the products containing every term that match the
+	// query's conditions, best first, skipping q.offset and returning up to
+	// q.limit products
+	Search(ctx context.Context, txn *spanner.ReadOnlyTransaction, q searchQuery) ([]fulltext.Hit, error)
+}
+
+// searchQuery is a full-text search among the products selected by listing
+// conditions, see filterConditions
+type searchQuery struct {
+	terms      []string
+	table      string
+	conditions []string
+	params     map[string]interface{}
+	offset     int
+	limit      int
+}
+
+// SpannerSearchIndex searches the products' Spanner search index, created by
+// migrations/production/020_product_search.sql. The emulator does not support
+// search indexes; use MemorySearchIndex there.
+type SpannerSearchIndex struct{}
+
+// NewSpannerSearchIndex creates a search index backed by Spanner
+func NewSpannerSearchIndex() *SpannerSearchIndex {
+	return &SpannerSearchIndex{}
+}
+
+// Search matches the terms against the tokens of the product's name and
+// description and ranks the products by Spanner's SCORE
+func (ix *SpannerSearchIndex) Search(ctx context.Context, txn *spanner.ReadOnlyTransaction, q searchQuery) ([]fulltext.Hit, error) {
+	params := map[string]interface{}{
+		"search_query":  strings.Join(q.terms, " "),
+		"search_offset": q.offset,
+		"search_limit":  q.limit,
+	}
+	for k, v := range q.params {
+		params[k] = v
+	}
+
+	// The search index stores the filtered columns, so it replaces the
+	// listing's index hint
+	conditions := append([]string{"SEARCH(p.search_tokens, @search_query)"}, q.conditions...)
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT p.product_id,
+				SCORE(p.name_tokens, @search_query) * %d + SCORE(p.description_tokens, @search_query) AS score
+			FROM products@{FORCE_INDEX=idx_products_search} p
+			WHERE %s
+			ORDER BY score DESC, p.product_id
+			LIMIT @search_limit OFFSET @search_offset`, searchNameWeight, whereClause(conditions)),
+		Params: params,
+	}
+
+	var hits []fulltext.Hit
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var hit fulltext.Hit
+		if err := row.Columns(&hit.ID, &hit.Score); err != nil {
+			return err
+		}
+		hits = append(hits, hit)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search products: %w", err)
+	}
+
+	return hits, nil
+}
+
+// MemorySearchIndex is an in-process search index for the emulator and tests
+// only. Before each search it syncs with the products table under a global
+// lock, re-indexing the products whose version changed, so every search scans
+// every product; cmd/server refuses it outside the emulator.
+type MemorySearchIndex struct {
+	mu       sync.Mutex
+	index    *fulltext.Index
+	versions map[string]int64 // Indexed version of each product
+}
+
+// NewMemorySearchIndex creates an empty in-process search index
+func NewMemorySearchIndex() *MemorySearchIndex {
+	return &MemorySearchIndex{
+		index:    fulltext.NewIndex(),
+		versions: make(map[string]int64),
+	}
+}
+
+// Search ranks the products in process, then keeps those matching the
+// query's conditions in Spanner
+func (ix *MemorySearchIndex) Search(ctx context.Context, txn *spanner.ReadOnlyTransaction, q searchQuery) ([]fulltext.Hit, error) {
+	if err := ix.sync(ctx, txn); err != nil {
+		return nil, err
+	}
+
+	hits := ix.index.Search(q.terms)
+	if len(hits) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+
+	params := map[string]interface{}{
+		"search_ids": ids,
+	}
+	for k, v := range q.params {
+		params[k] = v
+	}
+
+	conditions := append([]string{"p.product_id IN UNNEST(@search_ids)"}, q.conditions...)
+	stmt := spanner.Statement{
+		SQL: `SELECT p.product_id
+			FROM ` + q.table + ` p
+			WHERE ` + whereClause(conditions),
+		Params: params,
+	}
+
+	matching := make(map[string]bool)
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		matching[id] = true
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter search results: %w", err)
+	}
+
+	var filtered []fulltext.Hit
+	for _, hit := range hits {
+		if matching[hit.ID] {
+			filtered = append(filtered, hit)
+		}
+	}
+
+	if q.offset >= len(filtered) {
+		return nil, nil
+	}
+	filtered = filtered[q.offset:]
+	if len(filtered) > q.limit {
+		filtered = filtered[:q.limit]
+	}
+
+	return filtered, nil
+}
+
+// sync re-indexes the products whose version differs from the indexed one
+// and drops products that no longer exist
+func (ix *MemorySearchIndex) sync(ctx context.Context, txn *spanner.ReadOnlyTransaction) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	seen := make(map[string]bool, len(ix.versions))
+
+	iter := txn.Read(ctx, m_product.Table, spanner.AllKeys(), []string{
+		m_product.ProductID,
+		m_product.Version,
+		m_product.Name,
+		m_product.Description,
+	})
+	err := iter.Do(func(row *spanner.Row) error {
+		var p m_product.Product
+		if err := row.Columns(&p.ProductID, &p.Version, &p.Name, &p.Description); err != nil {
+			return err
+		}
+
+		seen[p.ProductID] = true
+		if version, ok := ix.versions[p.ProductID]; ok && version == p.Version {
+			return nil
+		}
+
+		ix.index.Put(p.ProductID,
+			fulltext.Field{Text: p.Name, Weight: searchNameWeight},
+			fulltext.Field{Text: p.Description, Weight: 1},
+		)
+		ix.versions[p.ProductID] = p.Version
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync search index: %w", err)
+	}
+
+	for id := range ix.versions {
+		if !seen[id] {
+			ix.index.Remove(id)
+			delete(ix.versions, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product_stock"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+var stockColumns = []string{
+	m_product_stock.ProductID,
+	m_product_stock.OnHand,
+	m_product_stock.Reserved,
+	m_product_stock.LowStockThreshold,
+	m_product_stock.UpdatedAt,
+	m_product_stock.Version,
+}
+
+// StockRepo implements stock persistence for Spanner
+type StockRepo struct {
+	client *spanner.Client
+}
+
+// NewStockRepo creates a new Spanner stock repository
+func NewStockRepo(client *spanner.Client) *StockRepo {
+	return &StockRepo{
+		client: client,
+	}
+}
+
+// InsertMut returns a mutation to insert a new stock record. A concurrent
+// insert for the same product fails the commit with AlreadyExists.
+func (r *StockRepo) InsertMut(stock *domain.Stock) *spanner.Mutation {
+	s := stockToModel(stock)
+	return spanner.InsertMap(m_product_stock.Table, s.ToMap())
+}
+
+// UpdateMut returns a mutation to update a stock record (targeted by change tracker)
+func (r *StockRepo) UpdateMut(stock *domain.Stock) *spanner.Mutation {
+	if !stock.Changes().HasChanges() {
+		return nil // No changes to apply
+	}
+
+	updates := map[string]interface{}{
+		m_product_stock.ProductID: stock.ProductID(),
+		m_product_stock.UpdatedAt: time.Now(),
+		m_product_stock.Version:   int64(stock.Version()) + 1,
+	}
+
+	if stock.Changes().Dirty(domain.FieldStockLevels) {
+		updates[m_product_stock.OnHand] = stock.OnHand()
+		updates[m_product_stock.Reserved] = stock.Reserved()
+	}
+
+	if stock.Changes().Dirty(domain.FieldStockThreshold) {
+		updates[m_product_stock.LowStockThreshold] = stock.LowStockThreshold()
+	}
+
+	return spanner.UpdateMap(m_product_stock.Table, updates)
+}
+
+// VersionPrecondition returns a precondition requiring the stored version to
+// still match the version the stock record was loaded with
+func (r *StockRepo) VersionPrecondition(stock *domain.Stock) commitplan.Precondition {
+	return commitplan.Precondition{
+		Table:    m_product_stock.Table,
+		Key:      spanner.Key{stock.ProductID()},
+		Column:   m_product_stock.Version,
+		Expected: int64(stock.Version()),
+		Err:      domain.ErrConcurrentModification,
+	}
+}
+
+// FindByProductID retrieves the stock record of a product
+func (r *StockRepo) FindByProductID(ctx context.Context, productID string) (*domain.Stock, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	row, err := r.client.Single().ReadRow(ctx, m_product_stock.Table, spanner.Key{productID}, stockColumns)
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrStockNotFound
+		}
+		return nil, fmt.Errorf("failed to read stock: %w", err)
+	}
+
+	s, err := parseStockRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructStock(
+		s.ProductID,
+		s.OnHand,
+		s.Reserved,
+		s.LowStockThreshold,
+		s.UpdatedAt,
+		int(s.Version),
+	), nil
+}
+
+// attachStock reads the stock records of products within txn and sets their
+// availability on each DTO. Products without a record are out of stock.
+func (r *ProductReadModel) attachStock(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*contracts.ProductDTO, len(products))
+	productIDs := make([]string, 0, len(products))
+	for _, dto := range products {
+		dto.Availability = string(domain.AvailabilityOutOfStock)
+		dto.AvailableQuantity = 0
+		byID[dto.ProductID] = dto
+		productIDs = append(productIDs, dto.ProductID)
+	}
+
+	stmt := spanner.NewStatement(`
+		SELECT product_id, on_hand, reserved, low_stock_threshold, updated_at, version
+		FROM product_stock
+		WHERE product_id IN UNNEST(@product_ids)
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+	}
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		s, err := parseStockRow(row)
+		if err != nil {
+			return err
+		}
+
+		dto, ok := byID[s.ProductID]
+		if !ok {
+			return nil
+		}
+
+		available := s.OnHand - s.Reserved
+		dto.Availability = string(domain.AvailabilityOf(available, s.LowStockThreshold))
+		dto.AvailableQuantity = available
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read stock: %w", err)
+	}
+
+	return nil
+}
+
+func parseStockRow(row *spanner.Row) (*m_product_stock.ProductStock, error) {
+	var s m_product_stock.ProductStock
+	if err := row.Columns(
+		&s.ProductID,
+		&s.OnHand,
+		&s.Reserved,
+		&s.LowStockThreshold,
+		&s.UpdatedAt,
+		&s.Version,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse stock row: %w", err)
+	}
+	return &s, nil
+}
+
+func stockToModel(stock *domain.Stock) *m_product_stock.ProductStock {
+	return &m_product_stock.ProductStock{
+		ProductID:         stock.ProductID(),
+		OnHand:            stock.OnHand(),
+		Reserved:          stock.Reserved(),
+		LowStockThreshold: stock.LowStockThreshold(),
+		UpdatedAt:         stock.UpdatedAt(),
+		Version:           int64(stock.Version()),
+	}
+}
@@ -0,0 +1,142 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_tax_rate"
+)
+
+// TaxRateRepo implements tax rate persistence for Spanner
+type TaxRateRepo struct {
+	client *spanner.Client
+}
+
+// NewTaxRateRepo creates a new Spanner tax rate repository
+func NewTaxRateRepo(client *spanner.Client) *TaxRateRepo {
+	return &TaxRateRepo{
+		client: client,
+	}
+}
+
+// UpsertMut returns a mutation inserting the tax rate or replacing the rate
+// stored for its region and tax class
+func (r *TaxRateRepo) UpsertMut(rate domain.TaxRate, now time.Time) *spanner.Mutation {
+	m := &m_tax_rate.TaxRate{
+		Region:      rate.Region(),
+		TaxClass:    rate.TaxClass(),
+		RatePercent: *rate.Percent(),
+		UpdatedAt:   now,
+	}
+	return spanner.InsertOrUpdateMap(m_tax_rate.Table, m.ToMap())
+}
+
+// attachTax sets on each DTO the net, tax and gross amounts of its effective
+// price at the rate of its tax class in opts.Region. Every product's tax class
+// must have a rate in the region.
+func (r *ProductReadModel) attachTax(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO, opts contracts.ReadOptions) error {
+	if opts.Region == "" || len(products) == 0 {
+		return nil
+	}
+
+	region, err := domain.ParseRegion(opts.Region)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	taxClasses := make([]string, 0)
+	for _, dto := range products {
+		if !seen[dto.TaxClass] {
+			seen[dto.TaxClass] = true
+			taxClasses = append(taxClasses, dto.TaxClass)
+		}
+	}
+
+	rates, err := readTaxRates(ctx, txn, region, taxClasses)
+	if err != nil {
+		return err
+	}
+
+	for _, dto := range products {
+		rate, ok := rates[dto.TaxClass]
+		if !ok {
+			return fmt.Errorf("%w: %s in %s", domain.ErrTaxRateNotFound, dto.TaxClass, region)
+		}
+
+		net, err := domain.NewMoneyFromRat(big.NewRat(dto.EffectivePriceNumerator, dto.EffectivePriceDenominator), dto.Currency)
+		if err != nil {
+			return err
+		}
+
+		taxed, err := r.calculator.CalculateTax(net, rate, r.rounding)
+		if err != nil {
+			return err
+		}
+		if err := domain.CheckFractions(taxed.Net, taxed.Tax, taxed.Gross); err != nil {
+			return err
+		}
+
+		dto.Tax = r.taxDTO(taxed)
+	}
+
+	return nil
+}
+
+// taxDTO renders a taxed amount
+func (r *ProductReadModel) taxDTO(taxed *domain.TaxedAmount) *contracts.TaxDTO {
+	return &contracts.TaxDTO{
+		Region:           taxed.Rate.Region(),
+		TaxClass:         taxed.Rate.TaxClass(),
+		RatePercent:      taxed.Rate.Percent(),
+		NetNumerator:     taxed.Net.Numerator(),
+		NetDenominator:   taxed.Net.Denominator(),
+		TaxNumerator:     taxed.Tax.Numerator(),
+		TaxDenominator:   taxed.Tax.Denominator(),
+		GrossNumerator:   taxed.Gross.Numerator(),
+		GrossDenominator: taxed.Gross.Denominator(),
+		NetDecimal:       taxed.Net.Format(r.rounding),
+		TaxDecimal:       taxed.Tax.Format(r.rounding),
+		GrossDecimal:     taxed.Gross.Format(r.rounding),
+	}
+}
+
+// readTaxRates reads the rates of taxClasses in region within txn, keyed by tax class
+func readTaxRates(ctx context.Context, txn *spanner.ReadOnlyTransaction, region string, taxClasses []string) (map[string]domain.TaxRate, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT region, tax_class, rate_percent
+			FROM tax_rates
+			WHERE region = @region AND tax_class IN UNNEST(@tax_classes)`,
+		Params: map[string]interface{}{
+			"region":      region,
+			"tax_classes": taxClasses,
+		},
+	}
+
+	rates := make(map[string]domain.TaxRate, len(taxClasses))
+
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var m m_tax_rate.TaxRate
+		if err := row.Columns(&m.Region, &m.TaxClass, &m.RatePercent); err != nil {
+			return fmt.Errorf("failed to parse tax rate row: %w", err)
+		}
+
+		rate, err := domain.NewTaxRate(m.Region, m.TaxClass, &m.RatePercent)
+		if err != nil {
+			return err
+		}
+
+		rates[rate.TaxClass()] = rate
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tax rates: %w", err)
+	}
+
+	return rates, nil
+}
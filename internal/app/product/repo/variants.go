@@ -0,0 +1,226 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/models/m_product_variant"
+)
+
+// VariantMuts returns mutations writing the product's variants, or nil if no
+// variant changed. Variants are never deleted, so every variant is upserted.
+func (r *ProductRepo) VariantMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldVariants) {
+		return nil
+	}
+
+	variants := product.Variants()
+
+	mutations := make([]*spanner.Mutation, 0, len(variants))
+	for _, v := range variants {
+		m := variantToModel(product.ID(), v)
+		mutations = append(mutations, spanner.InsertOrUpdateMap(m_product_variant.Table, m.ToMap()))
+	}
+
+	return mutations
+}
+
+// findVariants reads the variants of a product within txn
+func (r *ProductRepo) findVariants(ctx context.Context, txn *spanner.ReadOnlyTransaction, productID, currencyCode string) ([]*domain.Variant, error) {
+	var variants []*domain.Variant
+
+	err := txn.Query(ctx, variantsStatement([]string{productID})).Do(func(row *spanner.Row) error {
+		v, err := parseVariantRow(row)
+		if err != nil {
+			return err
+		}
+
+		variant, err := modelToVariant(v, currencyCode)
+		if err != nil {
+			return err
+		}
+
+		variants = append(variants, variant)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read variants: %w", err)
+	}
+
+	return variants, nil
+}
+
+// attachVariants reads the variants of products within txn and sets them on
+// each DTO, priced with the discount active on the product at t
+func (r *ProductReadModel) attachVariants(ctx context.Context, txn *spanner.ReadOnlyTransaction, products []*contracts.ProductDTO, discounts map[string]discountColumns, t time.Time) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*contracts.ProductDTO, len(products))
+	productIDs := make([]string, 0, len(products))
+	for _, dto := range products {
+		dto.Variants = make([]*contracts.VariantDTO, 0)
+		byID[dto.ProductID] = dto
+		productIDs = append(productIDs, dto.ProductID)
+	}
+
+	err := txn.Query(ctx, variantsStatement(productIDs)).Do(func(row *spanner.Row) error {
+		v, err := parseVariantRow(row)
+		if err != nil {
+			return err
+		}
+
+		dto, ok := byID[v.ProductID]
+		if !ok {
+			return nil
+		}
+
+		variant, err := r.variantDTO(dto, v, discounts[v.ProductID], t)
+		if err != nil {
+			return err
+		}
+		dto.Variants = append(dto.Variants, variant)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read variants: %w", err)
+	}
+
+	return nil
+}
+
+// variantDTO prices a variant row of product, applying d if it is active at t
+func (r *ProductReadModel) variantDTO(product *contracts.ProductDTO, v *m_product_variant.ProductVariant, d discountColumns, t time.Time) (*contracts.VariantDTO, error) {
+	dto := &contracts.VariantDTO{
+		VariantID:        v.VariantID,
+		SKU:              v.SKU,
+		Options:          optionsFromJSON(v.Options),
+		PriceNumerator:   product.BasePriceNumerator,
+		PriceDenominator: product.BasePriceDenominator,
+		Status:           v.Status,
+		CreatedAtSec:     v.CreatedAt.Unix(),
+		UpdatedAtSec:     v.UpdatedAt.Unix(),
+	}
+
+	if v.PriceOverrideNumerator != nil && v.PriceOverrideDenominator != nil {
+		dto.HasPriceOverride = true
+		dto.PriceNumerator = *v.PriceOverrideNumerator
+		dto.PriceDenominator = *v.PriceOverrideDenominator
+	}
+
+	dto.EffectivePriceNumerator, dto.EffectivePriceDenominator = dto.PriceNumerator, dto.PriceDenominator
+	if d.activeAt(t) {
+		num, denom, err := d.discount(dto.PriceNumerator, dto.PriceDenominator)
+		if err != nil {
+			return nil, err
+		}
+		dto.EffectivePriceNumerator, dto.EffectivePriceDenominator = num, denom
+	}
+
+	if s, err := domain.FormatAmount(dto.PriceNumerator, dto.PriceDenominator, product.Currency, r.rounding); err == nil {
+		dto.PriceDecimal = s
+	}
+	if s, err := domain.FormatAmount(dto.EffectivePriceNumerator, dto.EffectivePriceDenominator, product.Currency, r.rounding); err == nil {
+		dto.EffectivePriceDecimal = s
+	}
+
+	return dto, nil
+}
+
+func variantsStatement(productIDs []string) spanner.Statement {
+	stmt := spanner.NewStatement(`
+		SELECT
+			product_id, variant_id, sku, options,
+			price_override_numerator, price_override_denominator,
+			status, created_at, updated_at
+		FROM product_variants
+		WHERE product_id IN UNNEST(@product_ids)
+		ORDER BY product_id, created_at
+	`)
+	stmt.Params = map[string]interface{}{
+		"product_ids": productIDs,
+	}
+	return stmt
+}
+
+func parseVariantRow(row *spanner.Row) (*m_product_variant.ProductVariant, error) {
+	var v m_product_variant.ProductVariant
+	if err := row.Columns(
+		&v.ProductID,
+		&v.VariantID,
+		&v.SKU,
+		&v.Options,
+		&v.PriceOverrideNumerator,
+		&v.PriceOverrideDenominator,
+		&v.Status,
+		&v.CreatedAt,
+		&v.UpdatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to parse variant row: %w", err)
+	}
+	return &v, nil
+}
+
+func variantToModel(productID string, v *domain.Variant) *m_product_variant.ProductVariant {
+	m := &m_product_variant.ProductVariant{
+		ProductID: productID,
+		VariantID: v.ID(),
+		SKU:       v.SKU(),
+		Options:   spanner.NullJSON{Value: v.Options(), Valid: true},
+		Status:    string(v.Status()),
+		CreatedAt: v.CreatedAt(),
+		UpdatedAt: v.UpdatedAt(),
+	}
+
+	if price := v.PriceOverride(); price != nil {
+		m.PriceOverrideNumerator = &[]int64{price.Numerator()}[0]
+		m.PriceOverrideDenominator = &[]int64{price.Denominator()}[0]
+	}
+
+	return m
+}
+
+func modelToVariant(v *m_product_variant.ProductVariant, currencyCode string) (*domain.Variant, error) {
+	var priceOverride *domain.Money
+	if v.PriceOverrideNumerator != nil && v.PriceOverrideDenominator != nil {
+		var err error
+		priceOverride, err = domain.NewMoney(*v.PriceOverrideNumerator, *v.PriceOverrideDenominator, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.ReconstructVariant(
+		v.VariantID,
+		v.SKU,
+		optionsFromJSON(v.Options),
+		priceOverride,
+		v.Status,
+		v.CreatedAt,
+		v.UpdatedAt,
+	), nil
+}
+
+// optionsFromJSON converts a JSON object column to variant options,
+// skipping values that are not strings
+func optionsFromJSON(j spanner.NullJSON) map[string]string {
+	options := make(map[string]string)
+	if !j.Valid {
+		return options
+	}
+
+	if obj, ok := j.Value.(map[string]interface{}); ok {
+		for k, v := range obj {
+			if s, ok := v.(string); ok {
+				options[k] = s
+			}
+		}
+	}
+
+	return options
+}
@@ -0,0 +1,128 @@
+package advance_discount_lifecycle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	DiscountScheduleMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the advance discount lifecycle request
+type Request struct {
+	ProductID string
+}
+
+// Response represents the advance discount lifecycle response
+type Response struct {
+	EventCount int // Lifecycle events emitted, zero if no boundary was crossed
+}
+
+// Interactor handles emitting discount start and end events
+type Interactor struct {
+	reader     ProductReader
+	writer     ProductWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// NewInteractor creates a new advance discount lifecycle interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute records the discount window boundaries a product crossed and emits
+// their lifecycle events
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advance discount phases via domain
+	if !product.AdvanceDiscountLifecycle(it.clock.Now()) {
+		return &Response{}, nil
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	// Replace the stored schedule to persist scheduled discount phases
+	for _, mut := range it.writer.DiscountScheduleMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Add outbox events
+	for _, event := range product.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{EventCount: len(product.DomainEvents())}, nil
+}
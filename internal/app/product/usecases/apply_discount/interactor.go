@@ -2,6 +2,7 @@ package apply_discount
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,6 +20,8 @@ type ProductReader interface {
 // ProductWriter defines the interface for writing products
 type ProductWriter interface {
 	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	PriceHistoryMuts(product *domain.Product) []*spanner.Mutation
 }
 
 // OutboxRepository defines the repository interface for outbox events
@@ -39,9 +42,15 @@ type Clock interface {
 // Request represents the apply discount request
 type Request struct {
 	ProductID        string
-	DiscountPercent  int64
+	DiscountKind     string // Optional, defaults to percentage
+	DiscountPercent  string // Exact decimal or fraction, e.g. "12.5" or "25/2", at most 9 decimal places
 	DiscountStartSec int64
 	DiscountEndSec   int64
+
+	// Fixed amount taken off the base price, for fixed-amount discounts
+	AmountNumerator   int64
+	AmountDenominator int64
+	AmountCurrency    string // Optional, must match the product's currency
 }
 
 // Response represents the apply discount response
@@ -49,12 +58,12 @@ type Response struct{}
 
 // Interactor handles applying discounts to products
 type Interactor struct {
-	reader    ProductReader
-	writer    ProductWriter
+	reader     ProductReader
+	writer     ProductWriter
 	outboxRepo OutboxRepository
-	committer Committer
-	clock     Clock
-	enricher  EventEnricher
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
 }
 
 // EventEnricher enriches domain events for the outbox
@@ -72,8 +81,8 @@ func NewInteractor(
 	enricher EventEnricher,
 ) *Interactor {
 	return &Interactor{
-		reader:    reader,
-		writer:    writer,
+		reader:     reader,
+		writer:     writer,
 		outboxRepo: outboxRepo,
 		committer:  committer,
 		clock:      clock,
@@ -90,11 +99,7 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 	}
 
 	// Create discount value object
-	discount, err := domain.NewDiscount(
-		req.DiscountPercent,
-		time.Unix(req.DiscountStartSec, 0),
-		time.Unix(req.DiscountEndSec, 0),
-	)
+	discount, err := newDiscount(req, product)
 	if err != nil {
 		return nil, err
 	}
@@ -107,9 +112,15 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 	// Build commit plan
 	plan := commitplan.NewPlan()
 
-	// Add update mutation
+	// Add update mutation guarded by the loaded version
 	if mut := it.writer.UpdateMut(product); mut != nil {
 		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	// Record the new pricing state in the price history
+	for _, mut := range it.writer.PriceHistoryMuts(product) {
+		plan.Add(mut)
 	}
 
 	// Add outbox events
@@ -128,3 +139,37 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 
 	return &Response{}, nil
 }
+
+// newDiscount creates the requested percentage or fixed-amount discount
+func newDiscount(req Request, product *domain.Product) (*domain.Discount, error) {
+	kind, err := domain.ParseDiscountKind(req.DiscountKind)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate := time.Unix(req.DiscountStartSec, 0)
+	endDate := time.Unix(req.DiscountEndSec, 0)
+
+	if kind == domain.DiscountKindPercentage {
+		percentage, err := domain.ParsePercentage(req.DiscountPercent)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewDiscount(percentage, startDate, endDate)
+	}
+
+	currency := req.AmountCurrency
+	if currency == "" {
+		currency = product.BasePrice().Currency().Code()
+	}
+
+	amount, err := domain.NewMoney(req.AmountNumerator, req.AmountDenominator, currency)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPrice) {
+			return nil, domain.ErrInvalidDiscountAmount
+		}
+		return nil, err
+	}
+
+	return domain.NewFixedAmountDiscount(amount, startDate, endDate)
+}
@@ -0,0 +1,125 @@
+package archive_category
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	FindChildren(ctx context.Context, categoryID string) ([]*domain.Category, error)
+}
+
+// CategoryWriter defines the interface for writing categories
+type CategoryWriter interface {
+	UpdateMut(category *domain.Category) *spanner.Mutation
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the archive category request
+type Request struct {
+	CategoryID string
+}
+
+// Response represents the archive category response
+type Response struct{}
+
+// Interactor handles category archival
+type Interactor struct {
+	reader     CategoryReader
+	writer     CategoryWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new archive category interactor
+func NewInteractor(
+	reader CategoryReader,
+	writer CategoryWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute archives a category without active subcategories
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load category and its children
+	category, err := it.reader.FindByID(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := it.reader.FindChildren(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Archive via domain
+	if err := category.Archive(children, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(category); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(category))
+	}
+
+	// Add outbox events
+	for _, event := range category.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
@@ -0,0 +1,272 @@
+package create_bundle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	InsertMut(product *domain.Product) *spanner.Mutation
+	BundleMuts(product *domain.Product) []*spanner.Mutation
+	PriceHistoryMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Component represents a component product of the bundle
+type Component struct {
+	ProductID string
+	Quantity  int64
+}
+
+// Request represents the create bundle request. At most one of the discount
+// percent, discount amount and price override may be set.
+type Request struct {
+	Name        string
+	Description string
+	Category    string // Category ID
+	Components  []Component
+
+	DiscountPercent           string // Optional, exact decimal or fraction, e.g. "12.5"
+	DiscountAmountNumerator   int64  // Optional fixed amount off the components' total
+	DiscountAmountDenominator int64
+	PriceOverrideNumerator    int64 // Optional fixed bundle price
+	PriceOverrideDenominator  int64
+	Currency                  string // Optional currency of the amounts, must match the components'
+}
+
+// Response represents the create bundle response
+type Response struct {
+	ProductID string
+}
+
+// Interactor handles bundle creation
+type Interactor struct {
+	products   ProductReader
+	writer     ProductWriter
+	categories CategoryReader
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+	calculator *services.PricingCalculator
+}
+
+// NewInteractor creates a new create bundle interactor
+func NewInteractor(
+	products ProductReader,
+	writer ProductWriter,
+	categories CategoryReader,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+	calculator *services.PricingCalculator,
+) *Interactor {
+	return &Interactor{
+		products:   products,
+		writer:     writer,
+		categories: categories,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+		calculator: calculator,
+	}
+}
+
+// Execute creates a new bundle product priced from its components
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	if req.Name == "" {
+		return nil, domain.ErrInvalidName
+	}
+	if req.Category == "" {
+		return nil, domain.ErrInvalidCategory
+	}
+
+	// The category must exist and accept products
+	category, err := it.categories.FindByID(ctx, req.Category)
+	if err != nil {
+		return nil, err
+	}
+	if err := category.CanAssignProducts(); err != nil {
+		return nil, err
+	}
+
+	if len(req.Components) == 0 {
+		return nil, domain.ErrInvalidBundle
+	}
+
+	// Load the components; the bundle takes the currency of its components
+	components, prices, err := loadComponents(ctx, it.products, req.Components, it.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	currency := prices[req.Components[0].ProductID].Currency().Code()
+
+	bundle, err := newBundle(components, req, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := it.calculator.CalculateBundlePrice(bundle, prices)
+	if err != nil {
+		return nil, err
+	}
+
+	productID := uuid.New().String()
+	product, err := domain.NewBundleProduct(productID, req.Name, req.Description, req.Category, bundle, price, it.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	if mut := it.writer.InsertMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	for _, mut := range it.writer.BundleMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Record the initial pricing state in the price history
+	for _, mut := range it.writer.PriceHistoryMuts(product) {
+		plan.Add(mut)
+	}
+
+	// The category must not be archived before the bundle is created
+	plan.Expect(it.categories.VersionPrecondition(category))
+
+	// Add outbox events
+	for _, event := range product.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{
+		ProductID: productID,
+	}, nil
+}
+
+// loadComponents loads the component products of a bundle, checks they can be
+// bundled and returns them with their effective prices at now, keyed by product ID
+func loadComponents(ctx context.Context, reader ProductReader, requested []Component, now time.Time) ([]domain.BundleComponent, map[string]*domain.Money, error) {
+	components := make([]domain.BundleComponent, 0, len(requested))
+	products := make(map[string]*domain.Product, len(requested))
+	prices := make(map[string]*domain.Money, len(requested))
+
+	for _, c := range requested {
+		component, err := domain.NewBundleComponent(c.ProductID, c.Quantity)
+		if err != nil {
+			return nil, nil, err
+		}
+		components = append(components, component)
+
+		if _, ok := products[c.ProductID]; ok {
+			continue // Duplicates are rejected by the bundle
+		}
+
+		product, err := reader.FindByID(ctx, c.ProductID)
+		if err != nil {
+			return nil, nil, err
+		}
+		products[c.ProductID] = product
+
+		price, err := product.EffectivePrice(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		prices[c.ProductID] = price
+	}
+
+	bundle, err := domain.NewBundle(components, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := bundle.CheckComponents(products); err != nil {
+		return nil, nil, err
+	}
+
+	return components, prices, nil
+}
+
+// newBundle creates the bundle definition with the pricing rule of req, whose
+// amounts default to currency
+func newBundle(components []domain.BundleComponent, req Request, currency string) (*domain.Bundle, error) {
+	if req.Currency != "" {
+		currency = req.Currency
+	}
+
+	var (
+		discountPercent *big.Rat
+		discountAmount  *domain.Money
+		priceOverride   *domain.Money
+		err             error
+	)
+
+	if req.DiscountPercent != "" {
+		if discountPercent, err = domain.ParsePercentage(req.DiscountPercent); err != nil {
+			return nil, err
+		}
+	}
+	if req.DiscountAmountNumerator != 0 {
+		if discountAmount, err = domain.NewMoney(req.DiscountAmountNumerator, req.DiscountAmountDenominator, currency); err != nil {
+			return nil, err
+		}
+	}
+	if req.PriceOverrideNumerator != 0 {
+		if priceOverride, err = domain.NewMoney(req.PriceOverrideNumerator, req.PriceOverrideDenominator, currency); err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.NewBundle(components, discountPercent, discountAmount, priceOverride)
+}
@@ -0,0 +1,136 @@
+package create_category
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+}
+
+// CategoryWriter defines the interface for writing categories
+type CategoryWriter interface {
+	InsertMut(category *domain.Category) *spanner.Mutation
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the create category request
+type Request struct {
+	Name     string
+	ParentID string // Optional, creates a root category if empty
+}
+
+// Response represents the create category response
+type Response struct {
+	CategoryID string
+}
+
+// Interactor handles category creation
+type Interactor struct {
+	reader     CategoryReader
+	writer     CategoryWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new create category interactor
+func NewInteractor(
+	reader CategoryReader,
+	writer CategoryWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute creates a new category
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load the parent, if any
+	var parent *domain.Category
+	if req.ParentID != "" {
+		var err error
+		parent, err = it.reader.FindByID(ctx, req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create category aggregate
+	categoryID := uuid.New().String()
+	category, err := domain.NewCategory(categoryID, req.Name, parent, it.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.writer.InsertMut(category))
+
+	// The parent must not be moved or archived while the child is created
+	if parent != nil {
+		plan.Expect(it.writer.VersionPrecondition(parent))
+	}
+
+	// Add outbox events
+	for _, event := range category.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		// The unique sibling name index rejects names already used under the parent
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return nil, domain.ErrDuplicateCategoryName
+		}
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{
+		CategoryID: categoryID,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package create_price_list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// PriceListWriter defines the interface for writing price lists
+type PriceListWriter interface {
+	InsertMut(list *domain.PriceList) *spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the create price list request
+type Request struct {
+	Name         string
+	Currency     string
+	Channel      string // Optional if Segment is set
+	Segment      string // Optional if Channel is set
+	ValidFromSec int64  // Optional, defaults to now
+	ValidToSec   int64  // Optional, open-ended if zero
+}
+
+// Response represents the create price list response
+type Response struct {
+	PriceListID string
+}
+
+// Interactor handles price list creation
+type Interactor struct {
+	writer     PriceListWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new create price list interactor
+func NewInteractor(
+	writer PriceListWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute creates a new empty price list
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	var (
+		validFrom time.Time
+		validTo   *time.Time
+	)
+	if req.ValidFromSec > 0 {
+		validFrom = time.Unix(req.ValidFromSec, 0)
+	}
+	if req.ValidToSec > 0 {
+		validTo = &[]time.Time{time.Unix(req.ValidToSec, 0)}[0]
+	}
+
+	// Create price list aggregate
+	priceListID := uuid.New().String()
+	list, err := domain.NewPriceList(priceListID, req.Name, req.Currency, req.Channel, req.Segment, validFrom, validTo, it.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.writer.InsertMut(list))
+
+	// Add outbox events
+	for _, event := range list.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{
+		PriceListID: priceListID,
+	}, nil
+}
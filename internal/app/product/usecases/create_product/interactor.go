@@ -25,6 +25,13 @@ type Committer interface {
 // ProductRepository defines the repository interface for products
 type ProductRepository interface {
 	InsertMut(product *domain.Product) *spanner.Mutation
+	PriceHistoryMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
 }
 
 // OutboxRepository defines the repository interface for outbox events
@@ -36,9 +43,10 @@ type OutboxRepository interface {
 type Request struct {
 	Name                 string
 	Description          string
-	Category             string
+	Category             string // Category ID
 	BasePriceNumerator   int64
 	BasePriceDenominator int64
+	Currency             string // ISO-4217 code, defaults to USD
 }
 
 // Response represents the create product response
@@ -48,21 +56,24 @@ type Response struct {
 
 // Interactor handles product creation
 type Interactor struct {
-	repo      ProductRepository
+	repo       ProductRepository
+	categories CategoryReader
 	outboxRepo OutboxRepository
-	committer Committer
-	clock     Clock
+	committer  Committer
+	clock      Clock
 }
 
 // NewInteractor creates a new create product interactor
 func NewInteractor(
 	repo ProductRepository,
+	categories CategoryReader,
 	outboxRepo OutboxRepository,
 	committer Committer,
 	clock Clock,
 ) *Interactor {
 	return &Interactor{
 		repo:       repo,
+		categories: categories,
 		outboxRepo: outboxRepo,
 		committer:  committer,
 		clock:      clock,
@@ -79,8 +90,22 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 		return nil, domain.ErrInvalidCategory
 	}
 
+	// The category must exist and accept products
+	category, err := it.categories.FindByID(ctx, req.Category)
+	if err != nil {
+		return nil, err
+	}
+	if err := category.CanAssignProducts(); err != nil {
+		return nil, err
+	}
+
 	// Create domain value objects
-	basePrice, err := domain.NewMoney(req.BasePriceNumerator, req.BasePriceDenominator)
+	currency := req.Currency
+	if currency == "" {
+		currency = domain.DefaultCurrencyCode
+	}
+
+	basePrice, err := domain.NewMoney(req.BasePriceNumerator, req.BasePriceDenominator, currency)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +132,14 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 		plan.Add(mut)
 	}
 
+	// Record the initial pricing state in the price history
+	for _, mut := range it.repo.PriceHistoryMuts(product) {
+		plan.Add(mut)
+	}
+
+	// The category must not be archived before the product is created
+	plan.Expect(it.categories.VersionPrecondition(category))
+
 	// Add outbox events for all domain events
 	for _, event := range product.DomainEvents() {
 		outboxEvent := it.enrichEvent(event)
@@ -142,6 +175,7 @@ func (it *Interactor) enrichEvent(event domain.DomainEvent) contracts.OutboxEven
 		payload["category"] = e.Category
 		payload["base_price_numerator"] = e.BasePriceNumerator
 		payload["base_price_denominator"] = e.BasePriceDenominator
+		payload["currency"] = e.Currency
 	case domain.ProductUpdatedEvent:
 		// No additional fields
 	case domain.ProductActivatedEvent:
@@ -151,7 +185,14 @@ func (it *Interactor) enrichEvent(event domain.DomainEvent) contracts.OutboxEven
 	case domain.ProductArchivedEvent:
 		// No additional fields
 	case domain.DiscountAppliedEvent:
-		payload["discount_percent"] = e.DiscountPercent
+		payload["discount_kind"] = e.DiscountKind
+		if e.DiscountKind == string(domain.DiscountKindFixedAmount) {
+			payload["amount_numerator"] = e.AmountNumerator
+			payload["amount_denominator"] = e.AmountDenominator
+			payload["currency"] = e.Currency
+		} else {
+			payload["discount_percent"] = domain.FormatPercentage(e.DiscountPercent)
+		}
 		payload["start_date"] = e.StartDate
 		payload["end_date"] = e.EndDate
 	case domain.DiscountRemovedEvent:
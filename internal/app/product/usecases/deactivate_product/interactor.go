@@ -19,6 +19,7 @@ type ProductReader interface {
 // ProductWriter defines the interface for writing products
 type ProductWriter interface {
 	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
 }
 
 // OutboxRepository defines the repository interface for outbox events
@@ -51,12 +52,12 @@ type Response struct{}
 
 // Interactor handles product deactivation
 type Interactor struct {
-	reader    ProductReader
-	writer    ProductWriter
+	reader     ProductReader
+	writer     ProductWriter
 	outboxRepo OutboxRepository
-	committer Committer
-	clock     Clock
-	enricher  EventEnricher
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
 }
 
 // NewInteractor creates a new deactivate product interactor
@@ -69,8 +70,8 @@ func NewInteractor(
 	enricher EventEnricher,
 ) *Interactor {
 	return &Interactor{
-		reader:    reader,
-		writer:    writer,
+		reader:     reader,
+		writer:     writer,
 		outboxRepo: outboxRepo,
 		committer:  committer,
 		clock:      clock,
@@ -94,9 +95,10 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 	// Build commit plan
 	plan := commitplan.NewPlan()
 
-	// Add update mutation
+	// Add update mutation guarded by the loaded version
 	if mut := it.writer.UpdateMut(product); mut != nil {
 		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
 	}
 
 	// Add outbox events
@@ -0,0 +1,79 @@
+package define_attribute
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// AttributeSchemaWriter defines the interface for writing attribute definitions
+type AttributeSchemaWriter interface {
+	UpsertMut(category string, definition *domain.AttributeDefinition) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Request represents the define attribute request
+type Request struct {
+	Category      string
+	Name          string
+	Type          string   // "string", "number" or "boolean"
+	Unit          string   // Optional, e.g. "W" or "in"
+	Required      bool     // Products of the category must carry the attribute
+	AllowedValues []string // Optional, string attributes only
+}
+
+// Response represents the define attribute response
+type Response struct{}
+
+// Interactor handles defining category attributes
+type Interactor struct {
+	writer    AttributeSchemaWriter
+	committer Committer
+}
+
+// NewInteractor creates a new define attribute interactor
+func NewInteractor(
+	writer AttributeSchemaWriter,
+	committer Committer,
+) *Interactor {
+	return &Interactor{
+		writer:    writer,
+		committer: committer,
+	}
+}
+
+// Execute creates or replaces an attribute definition of a category.
+// Existing attribute values are validated against it on their product's next update.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	if req.Category == "" {
+		return nil, domain.ErrInvalidCategory
+	}
+
+	typ, err := domain.ParseAttributeType(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := domain.NewAttributeDefinition(req.Name, typ, req.Unit, req.Required, req.AllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.writer.UpsertMut(req.Category, definition))
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
@@ -0,0 +1,194 @@
+package migrate_product_category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+}
+
+// CategoryRepository defines the interface for reading and writing categories
+type CategoryRepository interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	FindRootByName(ctx context.Context, name string) (*domain.Category, error)
+	InsertMut(category *domain.Category) *spanner.Mutation
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
+}
+
+// AttributeSchemaRepository defines the interface for moving attribute definitions
+type AttributeSchemaRepository interface {
+	FindByCategory(ctx context.Context, category string) (*domain.AttributeSchema, error)
+	UpsertMut(category string, definition *domain.AttributeDefinition) *spanner.Mutation
+	DeleteMut(category, name string) *spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the migrate product category request
+type Request struct {
+	ProductID string
+}
+
+// Response represents the migrate product category response
+type Response struct {
+	CategoryID      string
+	CreatedCategory bool // Whether the category was created for the product's category name
+}
+
+// Interactor moves a product created before categories existed, whose
+// category holds a free-text name, into the root category of that name. The
+// category is created if no root category has the name yet, and attribute
+// definitions keyed by the name move along with it.
+type Interactor struct {
+	reader     ProductReader
+	writer     ProductWriter
+	categories CategoryRepository
+	schemas    AttributeSchemaRepository
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new migrate product category interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	categories CategoryRepository,
+	schemas AttributeSchemaRepository,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		categories: categories,
+		schemas:    schemas,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute migrates the product's category
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := product.Category()
+
+	// Products that already reference a category are left alone
+	if _, err := it.categories.FindByID(ctx, name); err == nil {
+		return &Response{CategoryID: name}, nil
+	} else if !errors.Is(err, domain.ErrCategoryNotFound) {
+		return nil, err
+	}
+
+	now := it.clock.Now()
+
+	// Reuse the root category of the same name, or create it
+	created := false
+	category, err := it.categories.FindRootByName(ctx, name)
+	if errors.Is(err, domain.ErrCategoryNotFound) {
+		category, err = domain.NewCategory(uuid.New().String(), name, nil, now)
+		created = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.MigrateCategory(category.ID(), now); err != nil {
+		return nil, err
+	}
+
+	schema, err := it.schemas.FindByCategory(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	if created {
+		plan.Add(it.categories.InsertMut(category))
+	} else {
+		plan.Expect(it.categories.VersionPrecondition(category))
+	}
+
+	plan.Add(it.writer.UpdateMut(product))
+	plan.Expect(it.writer.VersionPrecondition(product))
+
+	// Attribute definitions are keyed by category, so they move to the category ID
+	for _, definition := range schema.Definitions() {
+		plan.Add(it.schemas.UpsertMut(category.ID(), definition))
+		plan.Add(it.schemas.DeleteMut(name, definition.Name()))
+	}
+
+	// Add outbox events
+	var events []domain.DomainEvent
+	events = append(events, category.DomainEvents()...)
+	events = append(events, product.DomainEvents()...)
+	for _, event := range events {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		// Another migration created the category first; retrying reuses it
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return nil, domain.ErrDuplicateCategoryName
+		}
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{
+		CategoryID:      category.ID(),
+		CreatedCategory: created,
+	}, nil
+}
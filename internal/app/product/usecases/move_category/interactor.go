@@ -0,0 +1,148 @@
+package move_category
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	FindDescendants(ctx context.Context, category *domain.Category) ([]*domain.Category, error)
+}
+
+// CategoryWriter defines the interface for writing categories
+type CategoryWriter interface {
+	UpdateMut(category *domain.Category) *spanner.Mutation
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the move category request
+type Request struct {
+	CategoryID string
+	ParentID   string // Optional, moves the category to the root if empty
+}
+
+// Response represents the move category response
+type Response struct{}
+
+// Interactor handles moving categories within the taxonomy
+type Interactor struct {
+	reader     CategoryReader
+	writer     CategoryWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new move category interactor
+func NewInteractor(
+	reader CategoryReader,
+	writer CategoryWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute moves a category and its subtree under a new parent
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load category and its subtree
+	category, err := it.reader.FindByID(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := it.reader.FindDescendants(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the new parent, if any
+	var parent *domain.Category
+	if req.ParentID != "" {
+		parent, err = it.reader.FindByID(ctx, req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Move via domain
+	if err := category.MoveTo(parent, descendants, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutations for the category and every rewritten descendant,
+	// each guarded by its loaded version
+	for _, c := range append([]*domain.Category{category}, descendants...) {
+		if mut := it.writer.UpdateMut(c); mut != nil {
+			plan.Add(mut)
+			plan.Expect(it.writer.VersionPrecondition(c))
+		}
+	}
+
+	// The parent must not be moved or archived concurrently
+	if parent != nil && category.Changes().HasChanges() {
+		plan.Expect(it.writer.VersionPrecondition(parent))
+	}
+
+	// Add outbox events
+	for _, event := range category.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		// The unique sibling name index rejects names already used under the parent
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return nil, domain.ErrDuplicateCategoryName
+		}
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
@@ -0,0 +1,88 @@
+package refresh_listing_price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the refresh listing price request
+type Request struct {
+	ProductID string
+}
+
+// Response represents the refresh listing price response
+type Response struct{}
+
+// Interactor rewrites the effective price stored for listings from the
+// product's base price and the discount active now, e.g. for products last
+// written before the price was stored
+type Interactor struct {
+	reader    ProductReader
+	writer    ProductWriter
+	committer Committer
+	clock     Clock
+}
+
+// NewInteractor creates a new refresh listing price interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	committer Committer,
+	clock Clock,
+) *Interactor {
+	return &Interactor{
+		reader:    reader,
+		writer:    writer,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute refreshes the product's listing price
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	product.RefreshListingPrice(it.clock.Now())
+
+	// Build commit plan, guarded by the loaded version
+	plan := commitplan.NewPlan()
+	plan.Add(it.writer.UpdateMut(product))
+	plan.Expect(it.writer.VersionPrecondition(product))
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
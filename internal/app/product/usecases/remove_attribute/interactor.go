@@ -0,0 +1,78 @@
+package remove_attribute
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// AttributeSchemaReader defines the interface for reading category attribute schemas
+type AttributeSchemaReader interface {
+	FindByCategory(ctx context.Context, category string) (*domain.AttributeSchema, error)
+}
+
+// AttributeSchemaWriter defines the interface for writing attribute definitions
+type AttributeSchemaWriter interface {
+	DeleteMut(category, name string) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Request represents the remove attribute request
+type Request struct {
+	Category string
+	Name     string
+}
+
+// Response represents the remove attribute response
+type Response struct{}
+
+// Interactor handles removing category attributes
+type Interactor struct {
+	reader    AttributeSchemaReader
+	writer    AttributeSchemaWriter
+	committer Committer
+}
+
+// NewInteractor creates a new remove attribute interactor
+func NewInteractor(
+	reader AttributeSchemaReader,
+	writer AttributeSchemaWriter,
+	committer Committer,
+) *Interactor {
+	return &Interactor{
+		reader:    reader,
+		writer:    writer,
+		committer: committer,
+	}
+}
+
+// Execute removes an attribute definition from a category. Products keeping a
+// value of the attribute must clear it on their next attribute update.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	schema, err := it.reader.FindByCategory(ctx, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := schema.Definition(req.Name); !ok {
+		return nil, domain.ErrAttributeDefinitionNotFound
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.writer.DeleteMut(req.Category, req.Name))
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
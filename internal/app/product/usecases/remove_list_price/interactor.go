@@ -0,0 +1,125 @@
+package remove_list_price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// PriceListReader defines the interface for reading price lists
+type PriceListReader interface {
+	FindByID(ctx context.Context, priceListID string, productIDs ...string) (*domain.PriceList, error)
+}
+
+// PriceListWriter defines the interface for writing price lists
+type PriceListWriter interface {
+	UpdateMut(list *domain.PriceList) *spanner.Mutation
+	VersionPrecondition(list *domain.PriceList) commitplan.Precondition
+	EntryMuts(list *domain.PriceList) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the remove list price request
+type Request struct {
+	PriceListID string
+	ProductID   string
+}
+
+// Response represents the remove list price response
+type Response struct{}
+
+// Interactor handles removing the prices of products from price lists
+type Interactor struct {
+	reader     PriceListReader
+	writer     PriceListWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new remove list price interactor
+func NewInteractor(
+	reader PriceListReader,
+	writer PriceListWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute removes the price of a product from a price list
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load price list with the product's entry
+	list, err := it.reader.FindByID(ctx, req.PriceListID, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove price via domain
+	if err := list.RemovePrice(req.ProductID, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(list); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(list))
+	}
+
+	for _, mut := range it.writer.EntryMuts(list) {
+		plan.Add(mut)
+	}
+
+	// Add outbox events
+	for _, event := range list.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
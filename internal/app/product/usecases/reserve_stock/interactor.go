@@ -0,0 +1,127 @@
+package reserve_stock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// StockReader defines the interface for reading stock records
+type StockReader interface {
+	FindByProductID(ctx context.Context, productID string) (*domain.Stock, error)
+}
+
+// StockWriter defines the interface for writing stock records
+type StockWriter interface {
+	UpdateMut(stock *domain.Stock) *spanner.Mutation
+	VersionPrecondition(stock *domain.Stock) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the reserve stock request
+type Request struct {
+	ProductID string
+	Quantity  int64
+}
+
+// Response represents the reserve stock response
+type Response struct {
+	OnHand   int64
+	Reserved int64
+}
+
+// Interactor handles stock reservations
+type Interactor struct {
+	reader     StockReader
+	writer     StockWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new reserve stock interactor
+func NewInteractor(
+	reader StockReader,
+	writer StockWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute holds units of a product's available stock
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load stock
+	stock, err := it.reader.FindByProductID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update domain
+	if err := stock.Reserve(req.Quantity, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version, so concurrent
+	// reservations cannot both take the last units
+	if mut := it.writer.UpdateMut(stock); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(stock))
+	}
+
+	// Add outbox events
+	for _, event := range stock.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{
+		OnHand:   stock.OnHand(),
+		Reserved: stock.Reserved(),
+	}, nil
+}
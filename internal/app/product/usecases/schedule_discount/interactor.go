@@ -0,0 +1,187 @@
+package schedule_discount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	DiscountScheduleMuts(product *domain.Product) []*spanner.Mutation
+	PriceHistoryMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the schedule discount request
+type Request struct {
+	ProductID        string
+	DiscountKind     string // Optional, defaults to percentage
+	DiscountPercent  string // Exact decimal or fraction, e.g. "12.5" or "25/2", at most 9 decimal places
+	DiscountStartSec int64
+	DiscountEndSec   int64
+
+	// Fixed amount taken off the base price, for fixed-amount discounts
+	AmountNumerator   int64
+	AmountDenominator int64
+	AmountCurrency    string // Optional, must match the product's currency
+}
+
+// Response represents the schedule discount response
+type Response struct {
+	DiscountID string
+}
+
+// Interactor handles queueing discounts on products
+type Interactor struct {
+	reader     ProductReader
+	writer     ProductWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// NewInteractor creates a new schedule discount interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute queues a discount on a product for its validity window
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create discount value object
+	discount, err := newDiscount(req, product)
+	if err != nil {
+		return nil, err
+	}
+
+	// Schedule discount via domain
+	discountID := uuid.New().String()
+	if err := product.ScheduleDiscount(discountID, discount, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	// Replace the stored schedule
+	for _, mut := range it.writer.DiscountScheduleMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Record the new schedule in the price history
+	for _, mut := range it.writer.PriceHistoryMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Add outbox events
+	for _, event := range product.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{
+		DiscountID: discountID,
+	}, nil
+}
+
+// newDiscount creates the requested percentage or fixed-amount discount
+func newDiscount(req Request, product *domain.Product) (*domain.Discount, error) {
+	kind, err := domain.ParseDiscountKind(req.DiscountKind)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate := time.Unix(req.DiscountStartSec, 0)
+	endDate := time.Unix(req.DiscountEndSec, 0)
+
+	if kind == domain.DiscountKindPercentage {
+		percentage, err := domain.ParsePercentage(req.DiscountPercent)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewDiscount(percentage, startDate, endDate)
+	}
+
+	currency := req.AmountCurrency
+	if currency == "" {
+		currency = product.BasePrice().Currency().Code()
+	}
+
+	amount, err := domain.NewMoney(req.AmountNumerator, req.AmountDenominator, currency)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPrice) {
+			return nil, domain.ErrInvalidDiscountAmount
+		}
+		return nil, err
+	}
+
+	return domain.NewFixedAmountDiscount(amount, startDate, endDate)
+}
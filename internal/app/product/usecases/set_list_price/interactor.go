@@ -0,0 +1,164 @@
+package set_list_price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for guarding products read by the use case
+type ProductWriter interface {
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+}
+
+// PriceListReader defines the interface for reading price lists
+type PriceListReader interface {
+	FindByID(ctx context.Context, priceListID string, productIDs ...string) (*domain.PriceList, error)
+}
+
+// PriceListWriter defines the interface for writing price lists
+type PriceListWriter interface {
+	UpdateMut(list *domain.PriceList) *spanner.Mutation
+	VersionPrecondition(list *domain.PriceList) commitplan.Precondition
+	EntryMuts(list *domain.PriceList) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the set list price request
+type Request struct {
+	PriceListID      string
+	ProductID        string
+	PriceNumerator   int64
+	PriceDenominator int64
+	Currency         string // Optional, must match the list's currency
+}
+
+// Response represents the set list price response
+type Response struct{}
+
+// Interactor handles setting the prices of products in price lists
+type Interactor struct {
+	productReader ProductReader
+	productWriter ProductWriter
+	listReader    PriceListReader
+	listWriter    PriceListWriter
+	outboxRepo    OutboxRepository
+	committer     Committer
+	clock         Clock
+	enricher      EventEnricher
+}
+
+// NewInteractor creates a new set list price interactor
+func NewInteractor(
+	productReader ProductReader,
+	productWriter ProductWriter,
+	listReader PriceListReader,
+	listWriter PriceListWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		productReader: productReader,
+		productWriter: productWriter,
+		listReader:    listReader,
+		listWriter:    listWriter,
+		outboxRepo:    outboxRepo,
+		committer:     committer,
+		clock:         clock,
+		enricher:      enricher,
+	}
+}
+
+// Execute sets the price of a product in a price list
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load price list with the product's entry
+	list, err := it.listReader.FindByID(ctx, req.PriceListID, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load product
+	product, err := it.productReader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The currency defaults to the list's
+	currency := req.Currency
+	if currency == "" {
+		currency = list.Currency().Code()
+	}
+
+	price, err := domain.NewMoney(req.PriceNumerator, req.PriceDenominator, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set price via domain
+	if err := list.SetPrice(product, price, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.listWriter.UpdateMut(list); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.listWriter.VersionPrecondition(list))
+
+		// The product must not be archived or repriced in another currency meanwhile
+		plan.Expect(it.productWriter.VersionPrecondition(product))
+	}
+
+	for _, mut := range it.listWriter.EntryMuts(list) {
+		plan.Add(mut)
+	}
+
+	// Add outbox events
+	for _, event := range list.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
@@ -0,0 +1,163 @@
+package set_price_tiers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	PriceTierMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Tier is a unit price from a minimum quantity
+type Tier struct {
+	MinQuantity      int64
+	PriceNumerator   int64
+	PriceDenominator int64
+}
+
+// Request represents the set price tiers request
+type Request struct {
+	ProductID string
+	Tiers     []Tier // Replaces all tiers; empty clears them
+	Currency  string // Optional, must match the product's currency
+}
+
+// Response represents the set price tiers response
+type Response struct{}
+
+// Interactor handles replacing the price tiers of products
+type Interactor struct {
+	reader     ProductReader
+	writer     ProductWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// NewInteractor creates a new set price tiers interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute replaces the price tiers of a product
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := newPriceTiers(req, product)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set tiers via domain
+	if err := product.SetPriceTiers(tiers, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	// Replace the stored tiers
+	for _, mut := range it.writer.PriceTierMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Add outbox events
+	for _, event := range product.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
+
+// newPriceTiers builds the requested tiers. The currency defaults to the product's.
+func newPriceTiers(req Request, product *domain.Product) ([]domain.PriceTier, error) {
+	currency := req.Currency
+	if currency == "" {
+		currency = product.BasePrice().Currency().Code()
+	}
+
+	tiers := make([]domain.PriceTier, 0, len(req.Tiers))
+	for _, t := range req.Tiers {
+		price, err := domain.NewMoney(t.PriceNumerator, t.PriceDenominator, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		tier, err := domain.NewPriceTier(t.MinQuantity, price)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, nil
+}
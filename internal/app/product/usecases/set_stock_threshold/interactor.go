@@ -0,0 +1,146 @@
+package set_stock_threshold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// StockReader defines the interface for reading stock records
+type StockReader interface {
+	FindByProductID(ctx context.Context, productID string) (*domain.Stock, error)
+}
+
+// StockWriter defines the interface for writing stock records
+type StockWriter interface {
+	InsertMut(stock *domain.Stock) *spanner.Mutation
+	UpdateMut(stock *domain.Stock) *spanner.Mutation
+	VersionPrecondition(stock *domain.Stock) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the set stock threshold request
+type Request struct {
+	ProductID         string
+	LowStockThreshold int64
+}
+
+// Response represents the set stock threshold response
+type Response struct{}
+
+// Interactor handles low stock threshold changes
+type Interactor struct {
+	products   ProductReader
+	reader     StockReader
+	writer     StockWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new set stock threshold interactor
+func NewInteractor(
+	products ProductReader,
+	reader StockReader,
+	writer StockWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		products:   products,
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute sets the available quantity at or below which a product is low stock
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	now := it.clock.Now()
+
+	// Load stock, starting an empty record for a product without one
+	stock, err := it.reader.FindByProductID(ctx, req.ProductID)
+	isNew := errors.Is(err, domain.ErrStockNotFound)
+	switch {
+	case isNew:
+		if _, err := it.products.FindByID(ctx, req.ProductID); err != nil {
+			return nil, err
+		}
+		stock = domain.NewStock(req.ProductID, now)
+	case err != nil:
+		return nil, err
+	}
+
+	// Update domain
+	if err := stock.SetLowStockThreshold(req.LowStockThreshold, now); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	if isNew {
+		plan.Add(it.writer.InsertMut(stock))
+	} else if mut := it.writer.UpdateMut(stock); mut != nil {
+		// Guard the update by the loaded version
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(stock))
+	}
+
+	// Add outbox events
+	for _, event := range stock.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		// Another writer created the stock record first
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return nil, domain.ErrConcurrentModification
+		}
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
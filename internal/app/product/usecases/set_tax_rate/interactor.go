@@ -0,0 +1,76 @@
+package set_tax_rate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// TaxRateWriter defines the interface for writing tax rates
+type TaxRateWriter interface {
+	UpsertMut(rate domain.TaxRate, now time.Time) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the set tax rate request
+type Request struct {
+	Region      string // ISO 3166 country code with an optional subdivision, e.g. "DE" or "US-CA"
+	TaxClass    string // Empty sets the rate of the standard class
+	RatePercent string // Exact decimal or fraction, e.g. "19" or "5.5"
+}
+
+// Response represents the set tax rate response
+type Response struct{}
+
+// Interactor handles setting the tax rates of tax classes per region
+type Interactor struct {
+	writer    TaxRateWriter
+	committer Committer
+	clock     Clock
+}
+
+// NewInteractor creates a new set tax rate interactor
+func NewInteractor(writer TaxRateWriter, committer Committer, clock Clock) *Interactor {
+	return &Interactor{
+		writer:    writer,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute sets the tax rate of a tax class in a region, replacing any previous rate
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	percent, err := domain.ParsePercentage(req.RatePercent)
+	if err != nil {
+		return nil, domain.ErrInvalidTaxRate
+	}
+
+	rate, err := domain.NewTaxRate(req.Region, req.TaxClass, percent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.writer.UpsertMut(rate, it.clock.Now()))
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
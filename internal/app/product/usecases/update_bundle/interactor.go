@@ -0,0 +1,246 @@
+package update_bundle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	BundleMuts(product *domain.Product) []*spanner.Mutation
+	PriceHistoryMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Component represents a component product of the bundle
+type Component struct {
+	ProductID string
+	Quantity  int64
+}
+
+// Request represents the update bundle request. The components and pricing
+// rule replace the bundle's current definition; at most one of the discount
+// percent, discount amount and price override may be set.
+type Request struct {
+	ProductID  string
+	Components []Component
+
+	DiscountPercent           string // Optional, exact decimal or fraction, e.g. "12.5"
+	DiscountAmountNumerator   int64  // Optional fixed amount off the components' total
+	DiscountAmountDenominator int64
+	PriceOverrideNumerator    int64 // Optional fixed bundle price
+	PriceOverrideDenominator  int64
+	Currency                  string // Optional currency of the amounts, must match the components'
+}
+
+// Response represents the update bundle response
+type Response struct{}
+
+// Interactor handles changes to bundle definitions
+type Interactor struct {
+	reader     ProductReader
+	writer     ProductWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+	calculator *services.PricingCalculator
+}
+
+// NewInteractor creates a new update bundle interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+	calculator *services.PricingCalculator,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+		calculator: calculator,
+	}
+}
+
+// Execute replaces the components and pricing rule of a bundle and reprices it
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if !product.IsBundle() {
+		return nil, domain.ErrNotABundle
+	}
+
+	components, prices, err := loadComponents(ctx, it.reader, req.Components, it.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := newBundle(components, req, product.BasePrice().Currency().Code())
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := it.calculator.CalculateBundlePrice(bundle, prices)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update bundle via domain
+	if err := product.UpdateBundle(bundle, price, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	for _, mut := range it.writer.BundleMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Record the new bundle price in the price history
+	if product.Changes().Dirty(domain.FieldBasePrice) {
+		for _, mut := range it.writer.PriceHistoryMuts(product) {
+			plan.Add(mut)
+		}
+	}
+
+	// Add outbox events
+	for _, event := range product.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
+
+// loadComponents loads the component products of a bundle, checks they can be
+// bundled and returns them with their effective prices at now, keyed by product ID
+func loadComponents(ctx context.Context, reader ProductReader, requested []Component, now time.Time) ([]domain.BundleComponent, map[string]*domain.Money, error) {
+	components := make([]domain.BundleComponent, 0, len(requested))
+	products := make(map[string]*domain.Product, len(requested))
+	prices := make(map[string]*domain.Money, len(requested))
+
+	for _, c := range requested {
+		component, err := domain.NewBundleComponent(c.ProductID, c.Quantity)
+		if err != nil {
+			return nil, nil, err
+		}
+		components = append(components, component)
+
+		if _, ok := products[c.ProductID]; ok {
+			continue // Duplicates are rejected by the bundle
+		}
+
+		product, err := reader.FindByID(ctx, c.ProductID)
+		if err != nil {
+			return nil, nil, err
+		}
+		products[c.ProductID] = product
+
+		price, err := product.EffectivePrice(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		prices[c.ProductID] = price
+	}
+
+	bundle, err := domain.NewBundle(components, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := bundle.CheckComponents(products); err != nil {
+		return nil, nil, err
+	}
+
+	return components, prices, nil
+}
+
+// newBundle creates the bundle definition with the pricing rule of req, whose
+// amounts default to currency
+func newBundle(components []domain.BundleComponent, req Request, currency string) (*domain.Bundle, error) {
+	if req.Currency != "" {
+		currency = req.Currency
+	}
+
+	var (
+		discountPercent *big.Rat
+		discountAmount  *domain.Money
+		priceOverride   *domain.Money
+		err             error
+	)
+
+	if req.DiscountPercent != "" {
+		if discountPercent, err = domain.ParsePercentage(req.DiscountPercent); err != nil {
+			return nil, err
+		}
+	}
+	if req.DiscountAmountNumerator != 0 {
+		if discountAmount, err = domain.NewMoney(req.DiscountAmountNumerator, req.DiscountAmountDenominator, currency); err != nil {
+			return nil, err
+		}
+	}
+	if req.PriceOverrideNumerator != 0 {
+		if priceOverride, err = domain.NewMoney(req.PriceOverrideNumerator, req.PriceOverrideDenominator, currency); err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.NewBundle(components, discountPercent, discountAmount, priceOverride)
+}
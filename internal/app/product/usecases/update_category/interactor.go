@@ -0,0 +1,125 @@
+package update_category
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+}
+
+// CategoryWriter defines the interface for writing categories
+type CategoryWriter interface {
+	UpdateMut(category *domain.Category) *spanner.Mutation
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// Request represents the update category request
+type Request struct {
+	CategoryID string
+	Name       string
+}
+
+// Response represents the update category response
+type Response struct{}
+
+// Interactor handles category updates
+type Interactor struct {
+	reader     CategoryReader
+	writer     CategoryWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// NewInteractor creates a new update category interactor
+func NewInteractor(
+	reader CategoryReader,
+	writer CategoryWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute renames a category
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load category
+	category, err := it.reader.FindByID(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update domain
+	if err := category.Rename(req.Name, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation if there are changes, guarded by the loaded version
+	if mut := it.writer.UpdateMut(category); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(category))
+	}
+
+	// Add outbox events
+	for _, event := range category.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		// The unique sibling name index rejects names already used under the parent
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return nil, domain.ErrDuplicateCategoryName
+		}
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
@@ -19,6 +19,19 @@ type ProductReader interface {
 // ProductWriter defines the interface for writing products
 type ProductWriter interface {
 	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	AttributeMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// AttributeSchemaReader defines the interface for reading category attribute schemas
+type AttributeSchemaReader interface {
+	FindByCategory(ctx context.Context, category string) (*domain.AttributeSchema, error)
+}
+
+// CategoryReader defines the interface for reading categories
+type CategoryReader interface {
+	FindByID(ctx context.Context, categoryID string) (*domain.Category, error)
+	VersionPrecondition(category *domain.Category) commitplan.Precondition
 }
 
 // OutboxRepository defines the repository interface for outbox events
@@ -41,7 +54,11 @@ type Request struct {
 	ProductID   string
 	Name        string
 	Description string
-	Category    string
+	Category    string // Category ID
+
+	// Attribute changes, validated against the schema of the product's category
+	SetAttributes   map[string]string // Attribute name to raw value, e.g. "screen_size": "15.6"
+	ClearAttributes []string
 }
 
 // Response represents the update product response
@@ -49,12 +66,14 @@ type Response struct{}
 
 // Interactor handles product updates
 type Interactor struct {
-	reader    ProductReader
-	writer    ProductWriter
+	reader     ProductReader
+	writer     ProductWriter
+	schemas    AttributeSchemaReader
+	categories CategoryReader
 	outboxRepo OutboxRepository
-	committer Committer
-	clock     Clock
-	enricher  EventEnricher
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
 }
 
 // EventEnricher enriches domain events for the outbox
@@ -66,14 +85,18 @@ type EventEnricher interface {
 func NewInteractor(
 	reader ProductReader,
 	writer ProductWriter,
+	schemas AttributeSchemaReader,
+	categories CategoryReader,
 	outboxRepo OutboxRepository,
 	committer Committer,
 	clock Clock,
 	enricher EventEnricher,
 ) *Interactor {
 	return &Interactor{
-		reader:    reader,
-		writer:    writer,
+		reader:     reader,
+		writer:     writer,
+		schemas:    schemas,
+		categories: categories,
 		outboxRepo: outboxRepo,
 		committer:  committer,
 		clock:      clock,
@@ -89,17 +112,55 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, erro
 		return nil, err
 	}
 
+	previousCategory := product.Category()
+	now := it.clock.Now()
+
 	// Update domain
-	if err := product.UpdateDetails(req.Name, req.Description, req.Category, it.clock.Now()); err != nil {
+	if err := product.UpdateDetails(req.Name, req.Description, req.Category, now); err != nil {
 		return nil, err
 	}
 
+	// A product may only move into an existing category that accepts products
+	var category *domain.Category
+	if product.Category() != previousCategory {
+		category, err = it.categories.FindByID(ctx, product.Category())
+		if err != nil {
+			return nil, err
+		}
+		if err := category.CanAssignProducts(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Revalidate attributes when they change or the product moves to another category
+	if len(req.SetAttributes) > 0 || len(req.ClearAttributes) > 0 || product.Category() != previousCategory {
+		schema, err := it.schemas.FindByCategory(ctx, product.Category())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := product.UpdateAttributes(schema, req.SetAttributes, req.ClearAttributes, now); err != nil {
+			return nil, err
+		}
+	}
+
 	// Build commit plan
 	plan := commitplan.NewPlan()
 
-	// Add update mutation if there are changes
+	// Add update mutation if there are changes, guarded by the loaded version
 	if mut := it.writer.UpdateMut(product); mut != nil {
 		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	// The new category must not be archived before the move is committed
+	if category != nil {
+		plan.Expect(it.categories.VersionPrecondition(category))
+	}
+
+	// Replace the attribute values if they changed
+	for _, mut := range it.writer.AttributeMuts(product) {
+		plan.Add(mut)
 	}
 
 	// Add outbox events
@@ -0,0 +1,158 @@
+package update_variant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	"product-catalog-service/internal/pkg/commitplan"
+)
+
+// ProductReader defines the interface for reading products
+type ProductReader interface {
+	FindByID(ctx context.Context, productID string) (*domain.Product, error)
+}
+
+// ProductWriter defines the interface for writing products
+type ProductWriter interface {
+	UpdateMut(product *domain.Product) *spanner.Mutation
+	VersionPrecondition(product *domain.Product) commitplan.Precondition
+	VariantMuts(product *domain.Product) []*spanner.Mutation
+}
+
+// OutboxRepository defines the repository interface for outbox events
+type OutboxRepository interface {
+	InsertMut(event contracts.OutboxEvent) *spanner.Mutation
+}
+
+// Committer applies commit plans
+type Committer interface {
+	Apply(ctx context.Context, plan *commitplan.Plan) error
+}
+
+// Clock provides time abstraction
+type Clock interface {
+	Now() time.Time
+}
+
+// Request represents the update variant request
+type Request struct {
+	ProductID string
+	VariantID string
+	SKU       string
+	Options   map[string]string // Replaces all options
+
+	// Optional price override; zero inherits the product's base price
+	PriceOverrideNumerator   int64
+	PriceOverrideDenominator int64
+	PriceOverrideCurrency    string // Optional, must match the product's currency
+}
+
+// Response represents the update variant response
+type Response struct{}
+
+// Interactor handles updating product variants
+type Interactor struct {
+	reader     ProductReader
+	writer     ProductWriter
+	outboxRepo OutboxRepository
+	committer  Committer
+	clock      Clock
+	enricher   EventEnricher
+}
+
+// EventEnricher enriches domain events for the outbox
+type EventEnricher interface {
+	EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent
+}
+
+// NewInteractor creates a new update variant interactor
+func NewInteractor(
+	reader ProductReader,
+	writer ProductWriter,
+	outboxRepo OutboxRepository,
+	committer Committer,
+	clock Clock,
+	enricher EventEnricher,
+) *Interactor {
+	return &Interactor{
+		reader:     reader,
+		writer:     writer,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+		enricher:   enricher,
+	}
+}
+
+// Execute replaces the SKU, options and price override of a variant
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Response, error) {
+	// Load product
+	product, err := it.reader.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	priceOverride, err := newPriceOverride(req, product)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update variant via domain
+	if err := product.UpdateVariant(req.VariantID, req.SKU, req.Options, priceOverride, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	// Build commit plan
+	plan := commitplan.NewPlan()
+
+	// Add update mutation guarded by the loaded version
+	if mut := it.writer.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+		plan.Expect(it.writer.VersionPrecondition(product))
+	}
+
+	// Write the changed variants
+	for _, mut := range it.writer.VariantMuts(product) {
+		plan.Add(mut)
+	}
+
+	// Add outbox events
+	for _, event := range product.DomainEvents() {
+		outboxEvent := it.enricher.EnrichEvent(event)
+		outboxMut := it.outboxRepo.InsertMut(outboxEvent)
+		if outboxMut != nil {
+			plan.Add(outboxMut)
+		}
+	}
+
+	// Apply the plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		// The unique SKU index rejects SKUs used by variants of other products
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return nil, domain.ErrDuplicateSKU
+		}
+		return nil, fmt.Errorf("failed to apply commit plan: %w", err)
+	}
+
+	return &Response{}, nil
+}
+
+// newPriceOverride returns the variant's price override, or nil if the request
+// leaves the price unset. The currency defaults to the product's.
+func newPriceOverride(req Request, product *domain.Product) (*domain.Money, error) {
+	if req.PriceOverrideNumerator == 0 && req.PriceOverrideDenominator == 0 {
+		return nil, nil
+	}
+
+	currency := req.PriceOverrideCurrency
+	if currency == "" {
+		currency = product.BasePrice().Currency().Code()
+	}
+
+	return domain.NewMoney(req.PriceOverrideNumerator, req.PriceOverrideDenominator, currency)
+}
@@ -0,0 +1,23 @@
+package m_attribute_definition
+
+// AttributeDefinition represents a database row in the attribute_definitions table
+type AttributeDefinition struct {
+	Category      string
+	Name          string
+	AttributeType string
+	Unit          *string
+	Required      bool
+	AllowedValues []string
+}
+
+// ToMap converts the attribute definition to a map for Spanner mutation
+func (d *AttributeDefinition) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		Category:      d.Category,
+		Name:          d.Name,
+		AttributeType: d.AttributeType,
+		Unit:          d.Unit,
+		Required:      d.Required,
+		AllowedValues: d.AllowedValues,
+	}
+}
@@ -0,0 +1,12 @@
+package m_attribute_definition
+
+const (
+	Table = "attribute_definitions"
+
+	Category      = "category"
+	Name          = "name"
+	AttributeType = "attribute_type"
+	Unit          = "unit"
+	Required      = "required"
+	AllowedValues = "allowed_values"
+)
@@ -0,0 +1,17 @@
+package m_bundle_component
+
+// BundleComponent represents a database row in the bundle_components table
+type BundleComponent struct {
+	ProductID          string
+	ComponentProductID string
+	Quantity           int64
+}
+
+// ToMap converts the component to a map for Spanner mutation
+func (c *BundleComponent) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:          c.ProductID,
+		ComponentProductID: c.ComponentProductID,
+		Quantity:           c.Quantity,
+	}
+}
@@ -0,0 +1,9 @@
+package m_bundle_component
+
+const (
+	Table = "bundle_components"
+
+	ProductID          = "product_id"
+	ComponentProductID = "component_product_id"
+	Quantity           = "quantity"
+)
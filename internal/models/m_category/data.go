@@ -0,0 +1,33 @@
+package m_category
+
+import "time"
+
+// Category represents a database row in the categories table
+type Category struct {
+	CategoryID string
+	Name       string
+	NameKey    string
+	ParentID   *string // Nil for root categories
+	Path       string
+	Status     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time
+	Version    int64
+}
+
+// ToMap converts the category to a map for Spanner mutation
+func (c *Category) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		CategoryID: c.CategoryID,
+		Name:       c.Name,
+		NameKey:    c.NameKey,
+		ParentID:   c.ParentID,
+		Path:       c.Path,
+		Status:     c.Status,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		ArchivedAt: c.ArchivedAt,
+		Version:    c.Version,
+	}
+}
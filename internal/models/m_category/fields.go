@@ -0,0 +1,16 @@
+package m_category
+
+const (
+	Table = "categories"
+
+	CategoryID = "category_id"
+	Name       = "name"
+	NameKey    = "name_key"
+	ParentID   = "parent_id"
+	Path       = "path"
+	Status     = "status"
+	CreatedAt  = "created_at"
+	UpdatedAt  = "updated_at"
+	ArchivedAt = "archived_at"
+	Version    = "version"
+)
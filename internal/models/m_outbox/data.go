@@ -11,24 +11,28 @@ const (
 
 // OutboxEvent represents a database row in the outbox_events table
 type OutboxEvent struct {
-	EventID     string
-	EventType   string
-	AggregateID string
-	Payload     string // JSON payload
-	Status      string
-	CreatedAt   time.Time
-	ProcessedAt *time.Time
+	EventID        string
+	EventType      string
+	AggregateID    string
+	Payload        string // JSON payload
+	Status         string
+	CreatedAt      time.Time
+	ProcessedAt    *time.Time
+	LeaseOwner     *string
+	LeaseExpiresAt *time.Time
 }
 
 // ToMap converts the outbox event to a map for Spanner mutation
 func (e *OutboxEvent) ToMap() map[string]interface{} {
 	return map[string]interface{}{
-		EventID:     e.EventID,
-		EventType:   e.EventType,
-		AggregateID: e.AggregateID,
-		Payload:     e.Payload,
-		Status:      e.Status,
-		CreatedAt:   e.CreatedAt,
-		ProcessedAt: e.ProcessedAt,
+		EventID:        e.EventID,
+		EventType:      e.EventType,
+		AggregateID:    e.AggregateID,
+		Payload:        e.Payload,
+		Status:         e.Status,
+		CreatedAt:      e.CreatedAt,
+		ProcessedAt:    e.ProcessedAt,
+		LeaseOwner:     e.LeaseOwner,
+		LeaseExpiresAt: e.LeaseExpiresAt,
 	}
 }
@@ -3,11 +3,16 @@ package m_outbox
 const (
 	Table = "outbox_events"
 
-	EventID     = "event_id"
-	EventType   = "event_type"
-	AggregateID = "aggregate_id"
-	Payload     = "payload"
-	Status      = "status"
-	CreatedAt   = "created_at"
-	ProcessedAt = "processed_at"
+	EventID        = "event_id"
+	EventType      = "event_type"
+	AggregateID    = "aggregate_id"
+	Payload        = "payload"
+	Status         = "status"
+	CreatedAt      = "created_at"
+	ProcessedAt    = "processed_at"
+	LeaseOwner     = "lease_owner"
+	LeaseExpiresAt = "lease_expires_at"
+
+	// StatusIndex orders pending events by creation time
+	StatusIndex = "idx_outbox_status"
 )
@@ -0,0 +1,40 @@
+package m_price_history
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// PriceHistory represents a database row in the product_price_history table
+type PriceHistory struct {
+	ProductID                 string
+	EffectiveFrom             time.Time
+	BasePriceNumerator        int64
+	BasePriceDenominator      int64
+	Currency                  string
+	DiscountKind              *string
+	DiscountPercent           spanner.NullNumeric
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *time.Time
+	DiscountEndDate           *time.Time
+}
+
+// ToMap converts the price history entry to a map for Spanner mutation
+func (h *PriceHistory) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:                 h.ProductID,
+		EffectiveFrom:             h.EffectiveFrom,
+		BasePriceNumerator:        h.BasePriceNumerator,
+		BasePriceDenominator:      h.BasePriceDenominator,
+		Currency:                  h.Currency,
+		DiscountKind:              h.DiscountKind,
+		DiscountPercent:           h.DiscountPercent,
+		DiscountAmountNumerator:   h.DiscountAmountNumerator,
+		DiscountAmountDenominator: h.DiscountAmountDenominator,
+		DiscountStartDate:         h.DiscountStartDate,
+		DiscountEndDate:           h.DiscountEndDate,
+		RecordedAt:                spanner.CommitTimestamp,
+	}
+}
@@ -0,0 +1,18 @@
+package m_price_history
+
+const (
+	Table = "product_price_history"
+
+	ProductID                 = "product_id"
+	EffectiveFrom             = "effective_from"
+	BasePriceNumerator        = "base_price_numerator"
+	BasePriceDenominator      = "base_price_denominator"
+	Currency                  = "currency"
+	DiscountKind              = "discount_kind"
+	DiscountPercent           = "discount_percent"
+	DiscountAmountNumerator   = "discount_amount_numerator"
+	DiscountAmountDenominator = "discount_amount_denominator"
+	DiscountStartDate         = "discount_start_date"
+	DiscountEndDate           = "discount_end_date"
+	RecordedAt                = "recorded_at"
+)
@@ -0,0 +1,33 @@
+package m_price_history_discount
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// PriceHistoryDiscount represents a database row in the product_price_history_discounts table
+type PriceHistoryDiscount struct {
+	ProductID                 string
+	EffectiveFrom             time.Time
+	StartDate                 time.Time
+	EndDate                   time.Time
+	DiscountKind              string
+	DiscountPercent           spanner.NullNumeric
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+}
+
+// ToMap converts the scheduled window to a map for Spanner mutation
+func (d *PriceHistoryDiscount) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:                 d.ProductID,
+		EffectiveFrom:             d.EffectiveFrom,
+		StartDate:                 d.StartDate,
+		EndDate:                   d.EndDate,
+		DiscountKind:              d.DiscountKind,
+		DiscountPercent:           d.DiscountPercent,
+		DiscountAmountNumerator:   d.DiscountAmountNumerator,
+		DiscountAmountDenominator: d.DiscountAmountDenominator,
+	}
+}
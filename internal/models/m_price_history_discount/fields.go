@@ -0,0 +1,14 @@
+package m_price_history_discount
+
+const (
+	Table = "product_price_history_discounts"
+
+	ProductID                 = "product_id"
+	EffectiveFrom             = "effective_from"
+	StartDate                 = "start_date"
+	EndDate                   = "end_date"
+	DiscountKind              = "discount_kind"
+	DiscountPercent           = "discount_percent"
+	DiscountAmountNumerator   = "discount_amount_numerator"
+	DiscountAmountDenominator = "discount_amount_denominator"
+)
@@ -0,0 +1,33 @@
+package m_price_list
+
+import "time"
+
+// PriceList represents a database row in the price_lists table
+type PriceList struct {
+	PriceListID string
+	Name        string
+	Currency    string
+	Channel     *string // Nil for lists targeting a segment only
+	Segment     *string // Nil for lists targeting a channel only
+	ValidFrom   time.Time
+	ValidTo     *time.Time // Nil for open-ended lists
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int64
+}
+
+// ToMap converts the price list to a map for Spanner mutation
+func (l *PriceList) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		PriceListID: l.PriceListID,
+		Name:        l.Name,
+		Currency:    l.Currency,
+		Channel:     l.Channel,
+		Segment:     l.Segment,
+		ValidFrom:   l.ValidFrom,
+		ValidTo:     l.ValidTo,
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+		Version:     l.Version,
+	}
+}
@@ -0,0 +1,16 @@
+package m_price_list
+
+const (
+	Table = "price_lists"
+
+	PriceListID = "price_list_id"
+	Name        = "name"
+	Currency    = "currency"
+	Channel     = "channel"
+	Segment     = "segment"
+	ValidFrom   = "valid_from"
+	ValidTo     = "valid_to"
+	CreatedAt   = "created_at"
+	UpdatedAt   = "updated_at"
+	Version     = "version"
+)
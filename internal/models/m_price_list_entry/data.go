@@ -0,0 +1,23 @@
+package m_price_list_entry
+
+import "time"
+
+// PriceListEntry represents a database row in the price_list_entries table
+type PriceListEntry struct {
+	PriceListID      string
+	ProductID        string
+	PriceNumerator   int64
+	PriceDenominator int64
+	UpdatedAt        time.Time
+}
+
+// ToMap converts the price list entry to a map for Spanner mutation
+func (e *PriceListEntry) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		PriceListID:      e.PriceListID,
+		ProductID:        e.ProductID,
+		PriceNumerator:   e.PriceNumerator,
+		PriceDenominator: e.PriceDenominator,
+		UpdatedAt:        e.UpdatedAt,
+	}
+}
@@ -0,0 +1,11 @@
+package m_price_list_entry
+
+const (
+	Table = "price_list_entries"
+
+	PriceListID      = "price_list_id"
+	ProductID        = "product_id"
+	PriceNumerator   = "price_numerator"
+	PriceDenominator = "price_denominator"
+	UpdatedAt        = "updated_at"
+)
@@ -1,41 +1,60 @@
 package m_product
 
 import (
+	"math/big"
 	"time"
+
+	"cloud.google.com/go/spanner"
 )
 
 // Product represents a database row in the products table
 type Product struct {
-	ProductID            string
-	Name                 string
-	Description          string
-	Category             string
-	BasePriceNumerator   int64
-	BasePriceDenominator int64
-	DiscountPercent      *int64
-	DiscountStartDate    *time.Time
-	DiscountEndDate      *time.Time
-	Status               string
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
-	ArchivedAt           *time.Time
+	ProductID                 string
+	Name                      string
+	Description               string
+	Category                  string
+	BasePriceNumerator        int64
+	BasePriceDenominator      int64
+	Currency                  string
+	TaxClass                  string
+	DiscountKind              *string
+	DiscountPercent           spanner.NullNumeric
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	DiscountStartDate         *time.Time
+	DiscountEndDate           *time.Time
+	DiscountPhase             *string
+	EffectivePrice            big.Rat
+	Status                    string
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+	ArchivedAt                *time.Time
+	Version                   int64
 }
 
 // ToMap converts the product to a map for Spanner mutation
 func (p *Product) ToMap() map[string]interface{} {
 	return map[string]interface{}{
-		ProductID:            p.ProductID,
-		Name:                 p.Name,
-		Description:          p.Description,
-		Category:             p.Category,
-		BasePriceNumerator:   p.BasePriceNumerator,
-		BasePriceDenominator: p.BasePriceDenominator,
-		DiscountPercent:      p.DiscountPercent,
-		DiscountStartDate:    p.DiscountStartDate,
-		DiscountEndDate:      p.DiscountEndDate,
-		Status:               p.Status,
-		CreatedAt:            p.CreatedAt,
-		UpdatedAt:            p.UpdatedAt,
-		ArchivedAt:           p.ArchivedAt,
+		ProductID:                 p.ProductID,
+		Name:                      p.Name,
+		Description:               p.Description,
+		Category:                  p.Category,
+		BasePriceNumerator:        p.BasePriceNumerator,
+		BasePriceDenominator:      p.BasePriceDenominator,
+		Currency:                  p.Currency,
+		TaxClass:                  p.TaxClass,
+		DiscountKind:              p.DiscountKind,
+		DiscountPercent:           p.DiscountPercent,
+		DiscountAmountNumerator:   p.DiscountAmountNumerator,
+		DiscountAmountDenominator: p.DiscountAmountDenominator,
+		DiscountStartDate:         p.DiscountStartDate,
+		DiscountEndDate:           p.DiscountEndDate,
+		DiscountPhase:             p.DiscountPhase,
+		EffectivePrice:            p.EffectivePrice,
+		Status:                    p.Status,
+		CreatedAt:                 p.CreatedAt,
+		UpdatedAt:                 p.UpdatedAt,
+		ArchivedAt:                p.ArchivedAt,
+		Version:                   p.Version,
 	}
 }
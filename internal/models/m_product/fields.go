@@ -3,17 +3,28 @@ package m_product
 const (
 	Table = "products"
 
-	ProductID               = "product_id"
-	Name                    = "name"
-	Description             = "description"
-	Category                = "category"
-	BasePriceNumerator      = "base_price_numerator"
-	BasePriceDenominator    = "base_price_denominator"
-	DiscountPercent         = "discount_percent"
-	DiscountStartDate       = "discount_start_date"
-	DiscountEndDate         = "discount_end_date"
-	Status                  = "status"
-	CreatedAt               = "created_at"
-	UpdatedAt               = "updated_at"
-	ArchivedAt              = "archived_at"
+	ProductID                 = "product_id"
+	Name                      = "name"
+	Description               = "description"
+	Category                  = "category"
+	BasePriceNumerator        = "base_price_numerator"
+	BasePriceDenominator      = "base_price_denominator"
+	Currency                  = "currency"
+	TaxClass                  = "tax_class"
+	DiscountKind              = "discount_kind"
+	DiscountPercent           = "discount_percent"
+	DiscountAmountNumerator   = "discount_amount_numerator"
+	DiscountAmountDenominator = "discount_amount_denominator"
+	DiscountStartDate         = "discount_start_date"
+	DiscountEndDate           = "discount_end_date"
+	DiscountPhase             = "discount_phase"
+	EffectivePrice            = "effective_price"
+	Status                    = "status"
+	CreatedAt                 = "created_at"
+	UpdatedAt                 = "updated_at"
+	ArchivedAt                = "archived_at"
+	Version                   = "version"
+
+	// DiscountPhaseIndex orders applied discounts by phase and start date
+	DiscountPhaseIndex = "idx_products_discount_phase"
 )
@@ -0,0 +1,23 @@
+package m_product_attribute
+
+import "cloud.google.com/go/spanner"
+
+// ProductAttribute represents a database row in the product_attributes table
+type ProductAttribute struct {
+	ProductID     string
+	Name          string
+	AttributeType string
+	Value         string              // Canonical text of the value
+	NumberValue   spanner.NullNumeric // Set only for number attributes
+}
+
+// ToMap converts the attribute to a map for Spanner mutation
+func (a *ProductAttribute) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:     a.ProductID,
+		Name:          a.Name,
+		AttributeType: a.AttributeType,
+		Value:         a.Value,
+		NumberValue:   a.NumberValue,
+	}
+}
@@ -0,0 +1,11 @@
+package m_product_attribute
+
+const (
+	Table = "product_attributes"
+
+	ProductID     = "product_id"
+	Name          = "name"
+	AttributeType = "attribute_type"
+	Value         = "value"
+	NumberValue   = "number_value"
+)
@@ -0,0 +1,25 @@
+package m_product_bundle
+
+import "cloud.google.com/go/spanner"
+
+// ProductBundle represents a database row in the product_bundles table
+type ProductBundle struct {
+	ProductID                 string
+	DiscountPercent           spanner.NullNumeric
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	PriceOverrideNumerator    *int64
+	PriceOverrideDenominator  *int64
+}
+
+// ToMap converts the bundle to a map for Spanner mutation
+func (b *ProductBundle) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:                 b.ProductID,
+		DiscountPercent:           b.DiscountPercent,
+		DiscountAmountNumerator:   b.DiscountAmountNumerator,
+		DiscountAmountDenominator: b.DiscountAmountDenominator,
+		PriceOverrideNumerator:    b.PriceOverrideNumerator,
+		PriceOverrideDenominator:  b.PriceOverrideDenominator,
+	}
+}
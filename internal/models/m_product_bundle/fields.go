@@ -0,0 +1,12 @@
+package m_product_bundle
+
+const (
+	Table = "product_bundles"
+
+	ProductID                 = "product_id"
+	DiscountPercent           = "discount_percent"
+	DiscountAmountNumerator   = "discount_amount_numerator"
+	DiscountAmountDenominator = "discount_amount_denominator"
+	PriceOverrideNumerator    = "price_override_numerator"
+	PriceOverrideDenominator  = "price_override_denominator"
+)
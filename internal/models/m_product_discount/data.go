@@ -0,0 +1,35 @@
+package m_product_discount
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductDiscount represents a database row in the product_discounts table
+type ProductDiscount struct {
+	ProductID                 string
+	DiscountID                string
+	DiscountKind              string
+	DiscountPercent           spanner.NullNumeric
+	DiscountAmountNumerator   *int64
+	DiscountAmountDenominator *int64
+	StartDate                 time.Time
+	EndDate                   time.Time
+	Phase                     string
+}
+
+// ToMap converts the scheduled discount to a map for Spanner mutation
+func (d *ProductDiscount) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:                 d.ProductID,
+		DiscountID:                d.DiscountID,
+		DiscountKind:              d.DiscountKind,
+		DiscountPercent:           d.DiscountPercent,
+		DiscountAmountNumerator:   d.DiscountAmountNumerator,
+		DiscountAmountDenominator: d.DiscountAmountDenominator,
+		StartDate:                 d.StartDate,
+		EndDate:                   d.EndDate,
+		Phase:                     d.Phase,
+	}
+}
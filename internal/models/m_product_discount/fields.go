@@ -0,0 +1,18 @@
+package m_product_discount
+
+const (
+	Table = "product_discounts"
+
+	ProductID                 = "product_id"
+	DiscountID                = "discount_id"
+	DiscountKind              = "discount_kind"
+	DiscountPercent           = "discount_percent"
+	DiscountAmountNumerator   = "discount_amount_numerator"
+	DiscountAmountDenominator = "discount_amount_denominator"
+	StartDate                 = "start_date"
+	EndDate                   = "end_date"
+	Phase                     = "phase"
+
+	// PhaseIndex orders scheduled discounts by phase and start date
+	PhaseIndex = "idx_product_discounts_phase"
+)
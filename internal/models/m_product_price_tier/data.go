@@ -0,0 +1,19 @@
+package m_product_price_tier
+
+// ProductPriceTier represents a database row in the product_price_tiers table
+type ProductPriceTier struct {
+	ProductID        string
+	MinQuantity      int64
+	PriceNumerator   int64
+	PriceDenominator int64
+}
+
+// ToMap converts the price tier to a map for Spanner mutation
+func (t *ProductPriceTier) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:        t.ProductID,
+		MinQuantity:      t.MinQuantity,
+		PriceNumerator:   t.PriceNumerator,
+		PriceDenominator: t.PriceDenominator,
+	}
+}
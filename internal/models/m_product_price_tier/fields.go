@@ -0,0 +1,10 @@
+package m_product_price_tier
+
+const (
+	Table = "product_price_tiers"
+
+	ProductID        = "product_id"
+	MinQuantity      = "min_quantity"
+	PriceNumerator   = "price_numerator"
+	PriceDenominator = "price_denominator"
+)
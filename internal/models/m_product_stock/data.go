@@ -0,0 +1,25 @@
+package m_product_stock
+
+import "time"
+
+// ProductStock represents a database row in the product_stock table
+type ProductStock struct {
+	ProductID         string
+	OnHand            int64
+	Reserved          int64
+	LowStockThreshold int64
+	UpdatedAt         time.Time
+	Version           int64
+}
+
+// ToMap converts the stock record to a map for Spanner mutation
+func (s *ProductStock) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:         s.ProductID,
+		OnHand:            s.OnHand,
+		Reserved:          s.Reserved,
+		LowStockThreshold: s.LowStockThreshold,
+		UpdatedAt:         s.UpdatedAt,
+		Version:           s.Version,
+	}
+}
@@ -0,0 +1,12 @@
+package m_product_stock
+
+const (
+	Table = "product_stock"
+
+	ProductID         = "product_id"
+	OnHand            = "on_hand"
+	Reserved          = "reserved"
+	LowStockThreshold = "low_stock_threshold"
+	UpdatedAt         = "updated_at"
+	Version           = "version"
+)
@@ -0,0 +1,35 @@
+package m_product_variant
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductVariant represents a database row in the product_variants table
+type ProductVariant struct {
+	ProductID                string
+	VariantID                string
+	SKU                      string
+	Options                  spanner.NullJSON // Object of option name to value
+	PriceOverrideNumerator   *int64
+	PriceOverrideDenominator *int64
+	Status                   string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+// ToMap converts the variant to a map for Spanner mutation
+func (v *ProductVariant) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		ProductID:                v.ProductID,
+		VariantID:                v.VariantID,
+		SKU:                      v.SKU,
+		Options:                  v.Options,
+		PriceOverrideNumerator:   v.PriceOverrideNumerator,
+		PriceOverrideDenominator: v.PriceOverrideDenominator,
+		Status:                   v.Status,
+		CreatedAt:                v.CreatedAt,
+		UpdatedAt:                v.UpdatedAt,
+	}
+}
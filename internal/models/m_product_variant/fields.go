@@ -0,0 +1,15 @@
+package m_product_variant
+
+const (
+	Table = "product_variants"
+
+	ProductID                = "product_id"
+	VariantID                = "variant_id"
+	SKU                      = "sku"
+	Options                  = "options"
+	PriceOverrideNumerator   = "price_override_numerator"
+	PriceOverrideDenominator = "price_override_denominator"
+	Status                   = "status"
+	CreatedAt                = "created_at"
+	UpdatedAt                = "updated_at"
+)
@@ -0,0 +1,24 @@
+package m_tax_rate
+
+import (
+	"math/big"
+	"time"
+)
+
+// TaxRate represents a database row in the tax_rates table
+type TaxRate struct {
+	Region      string
+	TaxClass    string
+	RatePercent big.Rat
+	UpdatedAt   time.Time
+}
+
+// ToMap converts the tax rate to a map for Spanner mutation
+func (r *TaxRate) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		Region:      r.Region,
+		TaxClass:    r.TaxClass,
+		RatePercent: r.RatePercent,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
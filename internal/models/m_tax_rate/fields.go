@@ -0,0 +1,10 @@
+package m_tax_rate
+
+const (
+	Table = "tax_rates"
+
+	Region      = "region"
+	TaxClass    = "tax_class"
+	RatePercent = "rate_percent"
+	UpdatedAt   = "updated_at"
+)
@@ -4,9 +4,21 @@ import (
 	"cloud.google.com/go/spanner"
 )
 
+// Precondition requires a row's column to hold an expected value at commit time
+type Precondition struct {
+	Table    string
+	Key      spanner.Key
+	Column   string
+	Expected int64
+
+	// Err is returned when the row is missing or the value differs
+	Err error
+}
+
 // Plan represents a commit plan with mutations to be applied atomically
 type Plan struct {
-	mutations []*spanner.Mutation
+	mutations     []*spanner.Mutation
+	preconditions []Precondition
 }
 
 // NewPlan creates a new commit plan
@@ -23,11 +35,21 @@ func (p *Plan) Add(mut *spanner.Mutation) {
 	}
 }
 
+// Expect adds a precondition that must hold when the plan is applied
+func (p *Plan) Expect(pre Precondition) {
+	p.preconditions = append(p.preconditions, pre)
+}
+
 // Mutations returns all mutations in the plan
 func (p *Plan) Mutations() []*spanner.Mutation {
 	return p.mutations
 }
 
+// Preconditions returns all preconditions in the plan
+func (p *Plan) Preconditions() []Precondition {
+	return p.preconditions
+}
+
 // Size returns the number of mutations in the plan
 func (p *Plan) Size() int {
 	return len(p.mutations)
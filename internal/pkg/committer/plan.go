@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
 	"product-catalog-service/internal/pkg/commitplan"
 )
 
@@ -28,11 +29,48 @@ func (c *Committer) Apply(ctx context.Context, plan *commitplan.Plan) error {
 		return nil
 	}
 
-	// Apply all mutations atomically in a single transaction
-	_, err := c.client.Apply(ctx, mutations)
+	// Plans without preconditions can be applied blindly in a single transaction
+	if len(plan.Preconditions()) == 0 {
+		_, err := c.client.Apply(ctx, mutations)
+		if err != nil {
+			return fmt.Errorf("failed to apply commit plan: %w", err)
+		}
+		return nil
+	}
+
+	// Check preconditions and buffer mutations in the same read-write transaction
+	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		for _, pre := range plan.Preconditions() {
+			if err := checkPrecondition(ctx, txn, pre); err != nil {
+				return err
+			}
+		}
+		return txn.BufferWrite(mutations)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to apply commit plan: %w", err)
 	}
 
 	return nil
 }
+
+func checkPrecondition(ctx context.Context, txn *spanner.ReadWriteTransaction, pre commitplan.Precondition) error {
+	row, err := txn.ReadRow(ctx, pre.Table, pre.Key, []string{pre.Column})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return pre.Err
+		}
+		return err
+	}
+
+	var actual int64
+	if err := row.Columns(&actual); err != nil {
+		return err
+	}
+
+	if actual != pre.Expected {
+		return pre.Err
+	}
+
+	return nil
+}
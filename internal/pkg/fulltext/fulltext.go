@@ -0,0 +1,278 @@
+// Package fulltext tokenizes, indexes and highlights text for product search.
+// Tokens are runs of letters and digits, lower-cased, like Spanner's
+// TOKENIZE_FULLTEXT, so the in-process index matches what the Spanner search
+// index would.
+package fulltext
+
+import (
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token is a term of a text and its byte span in the text
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// Tokenize splits text into lower-cased runs of letters and digits
+func Tokenize(text string) []Token {
+	var tokens []Token
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, Token{Term: strings.ToLower(text[start:i]), Start: start, End: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, Token{Term: strings.ToLower(text[start:]), Start: start, End: len(text)})
+	}
+	return tokens
+}
+
+// Terms returns the distinct terms of text in order of first occurrence
+func Terms(text string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, t := range Tokenize(text) {
+		if !seen[t.Term] {
+			seen[t.Term] = true
+			terms = append(terms, t.Term)
+		}
+	}
+	return terms
+}
+
+// ContainsAny reports whether text contains a token matching any of terms
+func ContainsAny(text string, terms []string) bool {
+	for _, t := range Tokenize(text) {
+		for _, term := range terms {
+			if t.Term == term {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Field is a text of a document and the weight of its matches
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// Hit is a document matching a search and its relevance score
+type Hit struct {
+	ID    string
+	Score float64
+}
+
+// Index is an in-memory inverted index of documents. It is safe for
+// concurrent use.
+type Index struct {
+	mu       sync.RWMutex
+	postings map[string]map[string]float64 // Term to document to weighted term frequency
+	docs     map[string][]string           // Document to its distinct terms
+}
+
+// NewIndex creates an empty index
+func NewIndex() *Index {
+	return &Index{
+		postings: make(map[string]map[string]float64),
+		docs:     make(map[string][]string),
+	}
+}
+
+// Put indexes a document, replacing any previous version of it
+func (ix *Index) Put(id string, fields ...Field) {
+	freqs := make(map[string]float64)
+	for _, f := range fields {
+		for _, t := range Tokenize(f.Text) {
+			freqs[t.Term] += f.Weight
+		}
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(id)
+
+	terms := make([]string, 0, len(freqs))
+	for term, freq := range freqs {
+		if ix.postings[term] == nil {
+			ix.postings[term] = make(map[string]float64)
+		}
+		ix.postings[term][id] = freq
+		terms = append(terms, term)
+	}
+	ix.docs[id] = terms
+}
+
+// Remove removes a document from the index
+func (ix *Index) Remove(id string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(id)
+}
+
+func (ix *Index) remove(id string) {
+	for _, term := range ix.docs[id] {
+		delete(ix.postings[term], id)
+		if len(ix.postings[term]) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+	delete(ix.docs, id)
+}
+
+// IDs returns the IDs of the indexed documents
+func (ix *Index) IDs() []string {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	ids := make([]string, 0, len(ix.docs))
+	for id := range ix.docs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Search returns the documents containing every term, best first and by ID
+// among equal scores. A document scores the sum over the terms of its
+// saturated weighted term frequency times the term's inverse document
+// frequency, so rare terms and matches in heavier fields rank higher.
+func (ix *Index) Search(terms []string) []Hit {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	n := float64(len(ix.docs))
+	scores := make(map[string]float64)
+	for i, term := range terms {
+		postings := ix.postings[term]
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+		next := make(map[string]float64, len(postings))
+		for id, freq := range postings {
+			score, ok := scores[id]
+			if i > 0 && !ok {
+				continue // Missing an earlier term
+			}
+			next[id] = score + freq/(freq+1)*idf
+		}
+		scores = next
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	return hits
+}
+
+// Highlight returns text HTML-escaped with the tokens matching terms wrapped
+// in <em> tags. If maxRunes is positive and text is longer, only an excerpt of
+// about maxRunes runes starting shortly before the first match is returned,
+// marked with ellipses where text was cut.
+func Highlight(text string, terms []string, maxRunes int) string {
+	match := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		match[term] = true
+	}
+
+	var spans []Token
+	for _, t := range Tokenize(text) {
+		if match[t.Term] {
+			spans = append(spans, t)
+		}
+	}
+
+	start, end := 0, len(text)
+	if maxRunes > 0 && utf8.RuneCountInString(text) > maxRunes {
+		if len(spans) > 0 {
+			start = excerptStart(text, spans[0].Start, maxRunes/4)
+		}
+		end = excerptEnd(text, start, maxRunes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, s := range spans {
+		if s.Start < start || s.End > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:s.Start]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[s.Start:s.End]))
+		b.WriteString("</em>")
+		pos = s.End
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// excerptStart returns the byte offset of the word start at most lead runes
+// before offset
+func excerptStart(text string, offset, lead int) int {
+	start := offset
+	for i := 0; i < lead && start > 0; i++ {
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+	}
+	for start > 0 && start < offset {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if unicode.IsSpace(r) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(text[start:])
+		start += size
+	}
+	return start
+}
+
+// excerptEnd returns the byte offset maxRunes runes after start, moved back
+// to a word end if one is near
+func excerptEnd(text string, start, maxRunes int) int {
+	end := start
+	for i := 0; i < maxRunes && end < len(text); i++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+	if end >= len(text) {
+		return len(text)
+	}
+	if space := strings.LastIndexFunc(text[start:end], unicode.IsSpace); space > 0 {
+		return start + space
+	}
+	return end
+}
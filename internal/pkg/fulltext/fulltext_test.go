@@ -0,0 +1,61 @@
+package fulltext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenizeSplitsOnNonAlphanumerics(t *testing.T) {
+	tokens := Tokenize("Oak chair, 2-pack (Café)")
+
+	var terms []string
+	for _, tok := range tokens {
+		terms = append(terms, tok.Term)
+	}
+	assert.Equal(t, []string{"oak", "chair", "2", "pack", "café"}, terms)
+	assert.Equal(t, "Café", "Oak chair, 2-pack (Café)"[tokens[4].Start:tokens[4].End])
+
+	assert.Equal(t, []string{"red", "chair"}, Terms("Red chair RED"))
+}
+
+func TestIndexSearchMatchesEveryTermAndRanks(t *testing.T) {
+	ix := NewIndex()
+	ix.Put("p-1", Field{Text: "Oak Chair", Weight: 2}, Field{Text: "A sturdy chair", Weight: 1})
+	ix.Put("p-2", Field{Text: "Oak Table", Weight: 2}, Field{Text: "Seats four, pairs with any oak chair", Weight: 1})
+	ix.Put("p-3", Field{Text: "Pine Shelf", Weight: 2}, Field{Text: "", Weight: 1})
+
+	hits := ix.Search([]string{"oak", "chair"})
+	assert.Len(t, hits, 2)
+	assert.Equal(t, "p-1", hits[0].ID, "name matches weigh more")
+	assert.Equal(t, "p-2", hits[1].ID)
+
+	assert.Empty(t, ix.Search([]string{"oak", "shelf"}), "every term must match")
+
+	// Replacing a document drops its old terms
+	ix.Put("p-1", Field{Text: "Birch Stool", Weight: 2})
+	assert.Equal(t, []Hit{{ID: "p-2", Score: ix.Search([]string{"chair"})[0].Score}}, ix.Search([]string{"chair"}))
+
+	ix.Remove("p-2")
+	assert.Empty(t, ix.Search([]string{"chair"}))
+	assert.ElementsMatch(t, []string{"p-1", "p-3"}, ix.IDs())
+}
+
+func TestHighlightEscapesAndMarksMatches(t *testing.T) {
+	assert.Equal(t,
+		"<em>Oak</em> &amp; pine <em>chair</em>",
+		Highlight("Oak & pine chair", []string{"oak", "chair"}, 0),
+	)
+	assert.Equal(t, "Pine shelf", Highlight("Pine shelf", []string{"oak"}, 0))
+}
+
+func TestHighlightExcerptsAroundFirstMatch(t *testing.T) {
+	text := strings.Repeat("filler ", 40) + "solid oak frame " + strings.Repeat("more ", 40)
+
+	excerpt := Highlight(text, []string{"oak"}, 40)
+	assert.True(t, strings.HasPrefix(excerpt, "…"))
+	assert.True(t, strings.HasSuffix(excerpt, "…"))
+	assert.Contains(t, excerpt, "solid <em>oak</em> frame")
+	assert.LessOrEqual(t, len([]rune(excerpt)), 40+len("<em></em>")+2)
+}
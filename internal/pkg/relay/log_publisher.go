@@ -0,0 +1,29 @@
+package relay
+
+import (
+	"context"
+	"log"
+)
+
+// LogPublisher implements Publisher by writing events to the standard logger.
+// It is the default publisher until a message broker is configured.
+type LogPublisher struct {
+	logger *log.Logger
+}
+
+// NewLogPublisher creates a new log publisher
+func NewLogPublisher(logger *log.Logger) *LogPublisher {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogPublisher{
+		logger: logger,
+	}
+}
+
+// Publish logs the event
+func (p *LogPublisher) Publish(ctx context.Context, event Event) error {
+	p.logger.Printf("outbox event %s type=%s aggregate=%s payload=%s",
+		event.EventID, event.EventType, event.AggregateID, event.Payload)
+	return nil
+}
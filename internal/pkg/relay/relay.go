@@ -0,0 +1,159 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"product-catalog-service/internal/pkg/clock"
+)
+
+// Event represents a pending outbox event claimed by the relay
+type Event struct {
+	EventID     string
+	EventType   string
+	AggregateID string
+	Payload     []byte // JSON payload
+	CreatedAt   time.Time
+}
+
+// Publisher delivers outbox events to downstream consumers
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Store claims pending outbox events and records their delivery
+type Store interface {
+	// ClaimPending leases up to limit pending events to owner until leaseUntil,
+	// oldest first. Events leased by another owner are skipped until the lease expires.
+	ClaimPending(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]Event, error)
+
+	// MarkProcessed marks events leased by owner as processed
+	MarkProcessed(ctx context.Context, owner string, eventIDs []string) error
+
+	// Release drops the lease owner holds on events so they can be claimed again
+	Release(ctx context.Context, owner string, eventIDs []string) error
+}
+
+// Config controls the relay polling behaviour
+type Config struct {
+	// Owner identifies this relay instance in leases
+	Owner string
+
+	// BatchSize is the maximum number of events claimed per poll
+	BatchSize int
+
+	// PollInterval is how long to wait between polls when the outbox is drained
+	PollInterval time.Duration
+
+	// LeaseDuration is how long claimed events stay reserved for this instance
+	LeaseDuration time.Duration
+}
+
+// DefaultConfig returns the default relay configuration for the given owner
+func DefaultConfig(owner string) Config {
+	return Config{
+		Owner:         owner,
+		BatchSize:     100,
+		PollInterval:  time.Second,
+		LeaseDuration: 30 * time.Second,
+	}
+}
+
+// Relay moves pending outbox events to a Publisher
+type Relay struct {
+	store     Store
+	publisher Publisher
+	clock     clock.Clock
+	config    Config
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(store Store, publisher Publisher, clk clock.Clock, config Config) *Relay {
+	defaults := DefaultConfig(config.Owner)
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+	if config.LeaseDuration <= 0 {
+		config.LeaseDuration = defaults.LeaseDuration
+	}
+
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		clock:     clk,
+		config:    config,
+	}
+}
+
+// Run polls the outbox until the context is cancelled
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		n, err := r.RunOnce(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("outbox relay: %v", err)
+		}
+
+		// Keep draining while full batches are being claimed
+		if err == nil && n == r.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+}
+
+// RunOnce claims a single batch of pending events and publishes them in order.
+// It returns the number of events claimed.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	now := r.clock.Now()
+
+	events, err := r.store.ClaimPending(ctx, r.config.Owner, now, now.Add(r.config.LeaseDuration), r.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	published := make([]string, 0, len(events))
+	var publishErr error
+	for _, event := range events {
+		if err := r.publisher.Publish(ctx, event); err != nil {
+			// Stop at the first failure so later events are not delivered ahead of it
+			publishErr = fmt.Errorf("failed to publish event %s: %w", event.EventID, err)
+			break
+		}
+		published = append(published, event.EventID)
+	}
+
+	if len(published) > 0 {
+		if err := r.store.MarkProcessed(ctx, r.config.Owner, published); err != nil {
+			return len(events), fmt.Errorf("failed to mark outbox events processed: %w", err)
+		}
+	}
+
+	if publishErr != nil {
+		remaining := make([]string, 0, len(events)-len(published))
+		for _, event := range events[len(published):] {
+			remaining = append(remaining, event.EventID)
+		}
+		if err := r.store.Release(ctx, r.config.Owner, remaining); err != nil {
+			log.Printf("outbox relay: failed to release leases: %v", err)
+		}
+		return len(events), publishErr
+	}
+
+	return len(events), nil
+}
@@ -0,0 +1,84 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type fakeStore struct {
+	pending   []Event
+	processed []string
+	released  []string
+}
+
+func (s *fakeStore) ClaimPending(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]Event, error) {
+	if len(s.pending) < limit {
+		limit = len(s.pending)
+	}
+	claimed := s.pending[:limit]
+	s.pending = s.pending[limit:]
+	return claimed, nil
+}
+
+func (s *fakeStore) MarkProcessed(ctx context.Context, owner string, eventIDs []string) error {
+	s.processed = append(s.processed, eventIDs...)
+	return nil
+}
+
+func (s *fakeStore) Release(ctx context.Context, owner string, eventIDs []string) error {
+	s.released = append(s.released, eventIDs...)
+	return nil
+}
+
+type fakePublisher struct {
+	failOn    string
+	published []string
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, event Event) error {
+	if event.EventID == p.failOn {
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, event.EventID)
+	return nil
+}
+
+func newTestRelay(store Store, publisher Publisher) *Relay {
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	return NewRelay(store, publisher, clk, Config{Owner: "test", BatchSize: 2})
+}
+
+func TestRunOncePublishesAndMarksProcessed(t *testing.T) {
+	store := &fakeStore{pending: []Event{{EventID: "e1"}, {EventID: "e2"}, {EventID: "e3"}}}
+	publisher := &fakePublisher{}
+	r := newTestRelay(store, publisher)
+
+	n, err := r.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"e1", "e2"}, publisher.published)
+	assert.Equal(t, []string{"e1", "e2"}, store.processed)
+
+	n, err = r.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"e1", "e2", "e3"}, store.processed)
+}
+
+func TestRunOnceReleasesEventsAfterPublishFailure(t *testing.T) {
+	store := &fakeStore{pending: []Event{{EventID: "e1"}, {EventID: "e2"}}}
+	publisher := &fakePublisher{failOn: "e1"}
+	r := newTestRelay(store, publisher)
+
+	_, err := r.RunOnce(context.Background())
+	require.Error(t, err)
+	assert.Empty(t, publisher.published, "events after a failure must not be published out of order")
+	assert.Empty(t, store.processed)
+	assert.Equal(t, []string{"e1", "e2"}, store.released)
+}
@@ -0,0 +1,132 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"product-catalog-service/internal/pkg/clock"
+)
+
+// Store finds products whose discount windows crossed a boundary
+type Store interface {
+	// FindDueDiscounts returns up to limit products with a discount window that
+	// started or ended by now and whose lifecycle event has not been emitted yet
+	FindDueDiscounts(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+// Advancer emits the lifecycle events a product's discounts are due for and
+// returns the number of events emitted
+type Advancer interface {
+	Advance(ctx context.Context, productID string) (int, error)
+}
+
+// AdvancerFunc adapts a function to the Advancer interface
+type AdvancerFunc func(ctx context.Context, productID string) (int, error)
+
+// Advance calls f
+func (f AdvancerFunc) Advance(ctx context.Context, productID string) (int, error) {
+	return f(ctx, productID)
+}
+
+// Config controls the scheduler polling behaviour
+type Config struct {
+	// BatchSize is the maximum number of products advanced per poll
+	BatchSize int
+
+	// PollInterval is how long to wait between polls when no boundary is due
+	PollInterval time.Duration
+}
+
+// DefaultConfig returns the default scheduler configuration
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: 10 * time.Second,
+	}
+}
+
+// DiscountScheduler emits discount start and end events as their windows are
+// crossed. Progress is recorded with the events themselves, so a restarted
+// scheduler resumes without emitting an event twice.
+type DiscountScheduler struct {
+	store    Store
+	advancer Advancer
+	clock    clock.Clock
+	config   Config
+}
+
+// NewDiscountScheduler creates a new discount scheduler
+func NewDiscountScheduler(store Store, advancer Advancer, clk clock.Clock, config Config) *DiscountScheduler {
+	defaults := DefaultConfig()
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+
+	return &DiscountScheduler{
+		store:    store,
+		advancer: advancer,
+		clock:    clk,
+		config:   config,
+	}
+}
+
+// Run polls for due discount boundaries until the context is cancelled
+func (s *DiscountScheduler) Run(ctx context.Context) error {
+	for {
+		n, err := s.RunOnce(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("discount scheduler: %v", err)
+		}
+
+		// Keep draining while full batches are advanced without failures
+		if err == nil && n == s.config.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.config.PollInterval):
+		}
+	}
+}
+
+// RunOnce advances a single batch of products with due discount boundaries.
+// A product that fails is skipped and retried on the next poll.
+// It returns the number of products found.
+func (s *DiscountScheduler) RunOnce(ctx context.Context) (int, error) {
+	productIDs, err := s.store.FindDueDiscounts(ctx, s.clock.Now(), s.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due discounts: %w", err)
+	}
+
+	var (
+		failed   int
+		firstErr error
+	)
+	for _, productID := range productIDs {
+		if _, err := s.advancer.Advance(ctx, productID); err != nil {
+			if ctx.Err() != nil {
+				return len(productIDs), ctx.Err()
+			}
+			failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to advance product %s: %w", productID, err)
+			}
+		}
+	}
+
+	if firstErr != nil {
+		return len(productIDs), fmt.Errorf("%d of %d products failed: %w", failed, len(productIDs), firstErr)
+	}
+
+	return len(productIDs), nil
+}
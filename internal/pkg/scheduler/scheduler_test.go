@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"product-catalog-service/internal/pkg/clock"
+)
+
+type fakeStore struct {
+	due []string
+	now time.Time
+}
+
+func (s *fakeStore) FindDueDiscounts(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	s.now = now
+	if len(s.due) < limit {
+		limit = len(s.due)
+	}
+	return s.due[:limit], nil
+}
+
+func TestRunOnceAdvancesDueProducts(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &fakeStore{due: []string{"p-1", "p-2", "p-3"}}
+
+	var advanced []string
+	advancer := AdvancerFunc(func(ctx context.Context, productID string) (int, error) {
+		advanced = append(advanced, productID)
+		return 1, nil
+	})
+
+	s := NewDiscountScheduler(store, advancer, clock.NewMockClock(now), Config{BatchSize: 2})
+
+	n, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"p-1", "p-2"}, advanced)
+	assert.Equal(t, now, store.now, "boundaries are evaluated at the clock's time")
+}
+
+func TestRunOnceSkipsFailedProducts(t *testing.T) {
+	store := &fakeStore{due: []string{"p-1", "p-2"}}
+
+	var advanced []string
+	advancer := AdvancerFunc(func(ctx context.Context, productID string) (int, error) {
+		if productID == "p-1" {
+			return 0, errors.New("product was modified by another transaction")
+		}
+		advanced = append(advanced, productID)
+		return 1, nil
+	})
+
+	s := NewDiscountScheduler(store, advancer, clock.NewRealClock(), DefaultConfig())
+
+	n, err := s.RunOnce(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"p-2"}, advanced, "a failing product must not block the rest of the batch")
+}
@@ -1,24 +1,66 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
 	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
+	pricing "product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/queries/get_catalog_facets"
+	"product-catalog-service/internal/app/product/queries/get_category"
+	"product-catalog-service/internal/app/product/queries/get_price"
+	"product-catalog-service/internal/app/product/queries/get_price_history"
 	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/list_attribute_definitions"
+	"product-catalog-service/internal/app/product/queries/list_categories"
+	"product-catalog-service/internal/app/product/queries/list_discounts"
 	"product-catalog-service/internal/app/product/queries/list_products"
+	"product-catalog-service/internal/app/product/queries/quote_prices"
+	"product-catalog-service/internal/app/product/queries/search_products"
 	"product-catalog-service/internal/app/product/repo"
 	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
+	"product-catalog-service/internal/app/product/usecases/adjust_stock"
+	"product-catalog-service/internal/app/product/usecases/advance_discount_lifecycle"
 	"product-catalog-service/internal/app/product/usecases/apply_discount"
+	"product-catalog-service/internal/app/product/usecases/archive_category"
 	"product-catalog-service/internal/app/product/usecases/archive_product"
+	"product-catalog-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"product-catalog-service/internal/app/product/usecases/change_price"
+	"product-catalog-service/internal/app/product/usecases/create_bundle"
+	"product-catalog-service/internal/app/product/usecases/create_category"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
 	"product-catalog-service/internal/app/product/usecases/create_product"
 	"product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"product-catalog-service/internal/app/product/usecases/define_attribute"
+	"product-catalog-service/internal/app/product/usecases/migrate_product_category"
+	"product-catalog-service/internal/app/product/usecases/move_category"
+	"product-catalog-service/internal/app/product/usecases/refresh_listing_price"
+	"product-catalog-service/internal/app/product/usecases/release_stock"
+	"product-catalog-service/internal/app/product/usecases/remove_attribute"
 	"product-catalog-service/internal/app/product/usecases/remove_discount"
+	"product-catalog-service/internal/app/product/usecases/remove_list_price"
+	"product-catalog-service/internal/app/product/usecases/reserve_stock"
+	"product-catalog-service/internal/app/product/usecases/retire_variant"
+	"product-catalog-service/internal/app/product/usecases/schedule_discount"
+	"product-catalog-service/internal/app/product/usecases/set_list_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/set_stock_threshold"
+	"product-catalog-service/internal/app/product/usecases/set_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"product-catalog-service/internal/app/product/usecases/update_bundle"
+	"product-catalog-service/internal/app/product/usecases/update_category"
 	"product-catalog-service/internal/app/product/usecases/update_product"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
 	"product-catalog-service/internal/pkg/clock"
 	"product-catalog-service/internal/pkg/committer"
+	"product-catalog-service/internal/pkg/relay"
+	"product-catalog-service/internal/pkg/scheduler"
 	"product-catalog-service/internal/transport/grpc/product"
 )
 
@@ -29,28 +71,74 @@ type Container struct {
 	Committer *committer.Committer
 
 	// Repositories
-	ProductRepo     *repo.ProductRepo
-	OutboxRepo      *repo.OutboxRepo
+	ProductRepo      *repo.ProductRepo
+	OutboxRepo       *repo.OutboxRepo
 	ProductReadModel *repo.ProductReadModel
+	ProductSearch    *repo.ProductSearch
+	AttributeSchemas *repo.AttributeSchemaRepo
+	CategoryRepo     *repo.CategoryRepo
+	StockRepo        *repo.StockRepo
+	PriceListRepo    *repo.PriceListRepo
+	TaxRateRepo      *repo.TaxRateRepo
 
 	// Event Enricher
 	EventEnricher *EventEnricher
 
 	// Usecases
-	CreateProductInteractor      *create_product.Interactor
-	UpdateProductInteractor      *update_product.Interactor
-	ActivateProductInteractor    *activate_product.Interactor
-	DeactivateProductInteractor  *deactivate_product.Interactor
-	ApplyDiscountInteractor      *apply_discount.Interactor
-	RemoveDiscountInteractor     *remove_discount.Interactor
-	ArchiveProductInteractor     *archive_product.Interactor
+	CreateProductInteractor            *create_product.Interactor
+	UpdateProductInteractor            *update_product.Interactor
+	ActivateProductInteractor          *activate_product.Interactor
+	DeactivateProductInteractor        *deactivate_product.Interactor
+	ApplyDiscountInteractor            *apply_discount.Interactor
+	RemoveDiscountInteractor           *remove_discount.Interactor
+	ArchiveProductInteractor           *archive_product.Interactor
+	ChangePriceInteractor              *change_price.Interactor
+	ScheduleDiscountInteractor         *schedule_discount.Interactor
+	CancelScheduledDiscountInteractor  *cancel_scheduled_discount.Interactor
+	AddVariantInteractor               *add_variant.Interactor
+	UpdateVariantInteractor            *update_variant.Interactor
+	RetireVariantInteractor            *retire_variant.Interactor
+	AdvanceDiscountLifecycleInteractor *advance_discount_lifecycle.Interactor
+	DefineAttributeInteractor          *define_attribute.Interactor
+	RemoveAttributeInteractor          *remove_attribute.Interactor
+	CreateCategoryInteractor           *create_category.Interactor
+	UpdateCategoryInteractor           *update_category.Interactor
+	MoveCategoryInteractor             *move_category.Interactor
+	ArchiveCategoryInteractor          *archive_category.Interactor
+	ReserveStockInteractor             *reserve_stock.Interactor
+	ReleaseStockInteractor             *release_stock.Interactor
+	AdjustStockInteractor              *adjust_stock.Interactor
+	SetStockThresholdInteractor        *set_stock_threshold.Interactor
+	CreateBundleInteractor             *create_bundle.Interactor
+	UpdateBundleInteractor             *update_bundle.Interactor
+	SetPriceTiersInteractor            *set_price_tiers.Interactor
+	CreatePriceListInteractor          *create_price_list.Interactor
+	SetListPriceInteractor             *set_list_price.Interactor
+	RemoveListPriceInteractor          *remove_list_price.Interactor
+	SetTaxClassInteractor              *set_tax_class.Interactor
+	SetTaxRateInteractor               *set_tax_rate.Interactor
+	MigrateProductCategoryInteractor   *migrate_product_category.Interactor
+	RefreshListingPriceInteractor      *refresh_listing_price.Interactor
 
 	// Queries
-	GetProductQuery   *get_product.Query
-	ListProductsQuery *list_products.Query
+	GetProductQuery               *get_product.Query
+	ListProductsQuery             *list_products.Query
+	GetPriceHistoryQuery          *get_price_history.Query
+	ListDiscountsQuery            *list_discounts.Query
+	ListAttributeDefinitionsQuery *list_attribute_definitions.Query
+	GetCategoryQuery              *get_category.Query
+	ListCategoriesQuery           *list_categories.Query
+	GetPriceQuery                 *get_price.Query
+	QuotePricesQuery              *quote_prices.Query
+	GetCatalogFacetsQuery         *get_catalog_facets.Query
+	SearchProductsQuery           *search_products.Query
 
 	// Handlers
 	ProductHandlers *product.Handlers
+
+	// Background workers
+	OutboxRelay       *relay.Relay
+	DiscountScheduler *scheduler.DiscountScheduler
 }
 
 // NewContainer creates a new dependency injection container
@@ -62,14 +150,24 @@ func NewContainer(spannerClient *spanner.Client) *Container {
 	// Repositories
 	productRepo := repo.NewProductRepo(spannerClient)
 	outboxRepo := repo.NewOutboxRepo(spannerClient)
-	productReadModel := repo.NewProductReadModel(spannerClient)
+	productReadModel := repo.NewProductReadModel(spannerClient, priceRoundingMode(), pageTokenKey())
+	productSearch := repo.NewProductSearch(productReadModel, searchIndex())
+	attributeSchemas := repo.NewAttributeSchemaRepo(spannerClient)
+	categoryRepo := repo.NewCategoryRepo(spannerClient)
+	stockRepo := repo.NewStockRepo(spannerClient)
+	priceListRepo := repo.NewPriceListRepo(spannerClient)
+	taxRateRepo := repo.NewTaxRateRepo(spannerClient)
 
 	// Event Enricher
 	eventEnricher := NewEventEnricher()
 
+	// Domain services
+	pricingCalculator := pricing.NewPricingCalculator()
+
 	// Usecases
 	createProductInteractor := create_product.NewInteractor(
 		productRepo,
+		categoryRepo,
 		outboxRepo,
 		committer,
 		clk,
@@ -78,6 +176,8 @@ func NewContainer(spannerClient *spanner.Client) *Container {
 	updateProductInteractor := update_product.NewInteractor(
 		productRepo,
 		productRepo,
+		attributeSchemas,
+		categoryRepo,
 		outboxRepo,
 		committer,
 		clk,
@@ -129,9 +229,257 @@ func NewContainer(spannerClient *spanner.Client) *Container {
 		eventEnricher,
 	)
 
+	changePriceInteractor := change_price.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	scheduleDiscountInteractor := schedule_discount.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	cancelScheduledDiscountInteractor := cancel_scheduled_discount.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	addVariantInteractor := add_variant.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	updateVariantInteractor := update_variant.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	retireVariantInteractor := retire_variant.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	advanceDiscountLifecycleInteractor := advance_discount_lifecycle.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	defineAttributeInteractor := define_attribute.NewInteractor(
+		attributeSchemas,
+		committer,
+	)
+
+	removeAttributeInteractor := remove_attribute.NewInteractor(
+		attributeSchemas,
+		attributeSchemas,
+		committer,
+	)
+
+	createCategoryInteractor := create_category.NewInteractor(
+		categoryRepo,
+		categoryRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	updateCategoryInteractor := update_category.NewInteractor(
+		categoryRepo,
+		categoryRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	moveCategoryInteractor := move_category.NewInteractor(
+		categoryRepo,
+		categoryRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	archiveCategoryInteractor := archive_category.NewInteractor(
+		categoryRepo,
+		categoryRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	reserveStockInteractor := reserve_stock.NewInteractor(
+		stockRepo,
+		stockRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	releaseStockInteractor := release_stock.NewInteractor(
+		stockRepo,
+		stockRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	adjustStockInteractor := adjust_stock.NewInteractor(
+		productRepo,
+		stockRepo,
+		stockRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	setStockThresholdInteractor := set_stock_threshold.NewInteractor(
+		productRepo,
+		stockRepo,
+		stockRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	createBundleInteractor := create_bundle.NewInteractor(
+		productRepo,
+		productRepo,
+		categoryRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+		pricingCalculator,
+	)
+
+	updateBundleInteractor := update_bundle.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+		pricingCalculator,
+	)
+
+	setPriceTiersInteractor := set_price_tiers.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	createPriceListInteractor := create_price_list.NewInteractor(
+		priceListRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	setListPriceInteractor := set_list_price.NewInteractor(
+		productRepo,
+		productRepo,
+		priceListRepo,
+		priceListRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	removeListPriceInteractor := remove_list_price.NewInteractor(
+		priceListRepo,
+		priceListRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	setTaxClassInteractor := set_tax_class.NewInteractor(
+		productRepo,
+		productRepo,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	setTaxRateInteractor := set_tax_rate.NewInteractor(
+		taxRateRepo,
+		committer,
+		clk,
+	)
+
+	migrateProductCategoryInteractor := migrate_product_category.NewInteractor(
+		productRepo,
+		productRepo,
+		categoryRepo,
+		attributeSchemas,
+		outboxRepo,
+		committer,
+		clk,
+		eventEnricher,
+	)
+
+	refreshListingPriceInteractor := refresh_listing_price.NewInteractor(
+		productRepo,
+		productRepo,
+		committer,
+		clk,
+	)
+
 	// Queries
-	getProductQuery := get_product.NewQuery(productReadModel)
-	listProductsQuery := list_products.NewQuery(productReadModel)
+	getProductQuery := get_product.NewQuery(productReadModel, clk)
+	listProductsQuery := list_products.NewQuery(productReadModel, clk)
+	getPriceHistoryQuery := get_price_history.NewQuery(productReadModel, pricingCalculator, clk)
+	listDiscountsQuery := list_discounts.NewQuery(productReadModel)
+	listAttributeDefinitionsQuery := list_attribute_definitions.NewQuery(productReadModel)
+	getCategoryQuery := get_category.NewQuery(productReadModel)
+	listCategoriesQuery := list_categories.NewQuery(productReadModel)
+	getPriceQuery := get_price.NewQuery(productReadModel, pricingCalculator, priceRoundingMode(), clk)
+	quotePricesQuery := quote_prices.NewQuery(productRepo, priceListRepo, pricingCalculator, priceRoundingMode(), clk)
+	getCatalogFacetsQuery := get_catalog_facets.NewQuery(productReadModel, clk)
+	searchProductsQuery := search_products.NewQuery(productSearch, clk)
 
 	// Handlers
 	productHandlers := product.NewHandlers(
@@ -142,30 +490,176 @@ func NewContainer(spannerClient *spanner.Client) *Container {
 		applyDiscountInteractor,
 		removeDiscountInteractor,
 		archiveProductInteractor,
+		changePriceInteractor,
+		scheduleDiscountInteractor,
+		cancelScheduledDiscountInteractor,
+		addVariantInteractor,
+		updateVariantInteractor,
+		retireVariantInteractor,
+		defineAttributeInteractor,
+		removeAttributeInteractor,
+		createCategoryInteractor,
+		updateCategoryInteractor,
+		moveCategoryInteractor,
+		archiveCategoryInteractor,
+		reserveStockInteractor,
+		releaseStockInteractor,
+		adjustStockInteractor,
+		setStockThresholdInteractor,
+		createBundleInteractor,
+		updateBundleInteractor,
+		setPriceTiersInteractor,
+		createPriceListInteractor,
+		setListPriceInteractor,
+		removeListPriceInteractor,
+		setTaxClassInteractor,
+		setTaxRateInteractor,
 		getProductQuery,
 		listProductsQuery,
+		getPriceHistoryQuery,
+		listDiscountsQuery,
+		listAttributeDefinitionsQuery,
+		getCategoryQuery,
+		listCategoriesQuery,
+		getPriceQuery,
+		quotePricesQuery,
+		getCatalogFacetsQuery,
+		searchProductsQuery,
+	)
+
+	// Background workers
+	outboxRelay := relay.NewRelay(
+		outboxRepo,
+		relay.NewLogPublisher(nil),
+		clk,
+		relay.DefaultConfig(relayOwner()),
+	)
+
+	discountScheduler := scheduler.NewDiscountScheduler(
+		productRepo,
+		scheduler.AdvancerFunc(func(ctx context.Context, productID string) (int, error) {
+			resp, err := advanceDiscountLifecycleInteractor.Execute(ctx, advance_discount_lifecycle.Request{ProductID: productID})
+			if err != nil {
+				return 0, err
+			}
+			return resp.EventCount, nil
+		}),
+		clk,
+		scheduler.DefaultConfig(),
 	)
 
 	return &Container{
-		Clock:                    clk,
-		Committer:                committer,
-		ProductRepo:              productRepo,
-		OutboxRepo:               outboxRepo,
-		ProductReadModel:          productReadModel,
-		EventEnricher:            eventEnricher,
-		CreateProductInteractor:    createProductInteractor,
-		UpdateProductInteractor:    updateProductInteractor,
-		ActivateProductInteractor:  activateProductInteractor,
-		DeactivateProductInteractor: deactivateProductInteractor,
-		ApplyDiscountInteractor:   applyDiscountInteractor,
-		RemoveDiscountInteractor:  removeDiscountInteractor,
-		ArchiveProductInteractor:   archiveProductInteractor,
-		GetProductQuery:           getProductQuery,
-		ListProductsQuery:         listProductsQuery,
-		ProductHandlers:          productHandlers,
+		Clock:                              clk,
+		Committer:                          committer,
+		ProductRepo:                        productRepo,
+		OutboxRepo:                         outboxRepo,
+		ProductReadModel:                   productReadModel,
+		AttributeSchemas:                   attributeSchemas,
+		CategoryRepo:                       categoryRepo,
+		StockRepo:                          stockRepo,
+		PriceListRepo:                      priceListRepo,
+		TaxRateRepo:                        taxRateRepo,
+		EventEnricher:                      eventEnricher,
+		CreateProductInteractor:            createProductInteractor,
+		UpdateProductInteractor:            updateProductInteractor,
+		ActivateProductInteractor:          activateProductInteractor,
+		DeactivateProductInteractor:        deactivateProductInteractor,
+		ApplyDiscountInteractor:            applyDiscountInteractor,
+		RemoveDiscountInteractor:           removeDiscountInteractor,
+		ArchiveProductInteractor:           archiveProductInteractor,
+		ChangePriceInteractor:              changePriceInteractor,
+		ScheduleDiscountInteractor:         scheduleDiscountInteractor,
+		CancelScheduledDiscountInteractor:  cancelScheduledDiscountInteractor,
+		AddVariantInteractor:               addVariantInteractor,
+		UpdateVariantInteractor:            updateVariantInteractor,
+		RetireVariantInteractor:            retireVariantInteractor,
+		AdvanceDiscountLifecycleInteractor: advanceDiscountLifecycleInteractor,
+		DefineAttributeInteractor:          defineAttributeInteractor,
+		RemoveAttributeInteractor:          removeAttributeInteractor,
+		CreateCategoryInteractor:           createCategoryInteractor,
+		UpdateCategoryInteractor:           updateCategoryInteractor,
+		MoveCategoryInteractor:             moveCategoryInteractor,
+		ArchiveCategoryInteractor:          archiveCategoryInteractor,
+		ReserveStockInteractor:             reserveStockInteractor,
+		ReleaseStockInteractor:             releaseStockInteractor,
+		AdjustStockInteractor:              adjustStockInteractor,
+		SetStockThresholdInteractor:        setStockThresholdInteractor,
+		CreateBundleInteractor:             createBundleInteractor,
+		UpdateBundleInteractor:             updateBundleInteractor,
+		SetPriceTiersInteractor:            setPriceTiersInteractor,
+		CreatePriceListInteractor:          createPriceListInteractor,
+		SetListPriceInteractor:             setListPriceInteractor,
+		RemoveListPriceInteractor:          removeListPriceInteractor,
+		SetTaxClassInteractor:              setTaxClassInteractor,
+		SetTaxRateInteractor:               setTaxRateInteractor,
+		MigrateProductCategoryInteractor:   migrateProductCategoryInteractor,
+		RefreshListingPriceInteractor:      refreshListingPriceInteractor,
+		GetProductQuery:                    getProductQuery,
+		ListProductsQuery:                  listProductsQuery,
+		GetPriceHistoryQuery:               getPriceHistoryQuery,
+		ListDiscountsQuery:                 listDiscountsQuery,
+		ListAttributeDefinitionsQuery:      listAttributeDefinitionsQuery,
+		GetCategoryQuery:                   getCategoryQuery,
+		ListCategoriesQuery:                listCategoriesQuery,
+		GetPriceQuery:                      getPriceQuery,
+		QuotePricesQuery:                   quotePricesQuery,
+		GetCatalogFacetsQuery:              getCatalogFacetsQuery,
+		SearchProductsQuery:                searchProductsQuery,
+		ProductHandlers:                    productHandlers,
+		OutboxRelay:                        outboxRelay,
+		DiscountScheduler:                  discountScheduler,
 	}
 }
 
+// priceRoundingMode returns the rounding mode for rendered prices from
+// PRICE_ROUNDING_MODE, falling back to the default for unset or unknown values
+func priceRoundingMode() domain.RoundingMode {
+	mode, err := domain.ParseRoundingMode(os.Getenv("PRICE_ROUNDING_MODE"))
+	if err != nil {
+		return domain.DefaultRoundingMode
+	}
+	return mode
+}
+
+// pageTokenKey returns the key signing listing page tokens. Replicas must
+// share it so that any of them accepts the others' tokens; without one each
+// process signs with its own random key, which cmd/server only allows on the
+// emulator.
+func pageTokenKey() []byte {
+	if secret := os.Getenv("PAGE_TOKEN_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	return nil
+}
+
+// searchIndex returns the index backing product search from SEARCH_INDEX:
+// "memory" searches in process, "spanner" uses the Spanner search index. It
+// defaults to the in-process index on the emulator, which lacks search
+// indexes, and to the Spanner index otherwise. The in-process index scans the
+// whole products table on every search, so it is meant for the emulator and
+// tests only; cmd/server rejects it elsewhere.
+func searchIndex() repo.SearchIndex {
+	switch os.Getenv("SEARCH_INDEX") {
+	case "memory":
+		return repo.NewMemorySearchIndex()
+	case "spanner":
+		return repo.NewSpannerSearchIndex()
+	}
+	if os.Getenv("SPANNER_EMULATOR_HOST") != "" {
+		return repo.NewMemorySearchIndex()
+	}
+	return repo.NewSpannerSearchIndex()
+}
+
+// relayOwner returns a lease owner ID unique to this process
+func relayOwner() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "relay"
+	}
+	return fmt.Sprintf("%s-%s", host, uuid.New().String()[:8])
+}
+
 // EventEnricher enriches domain events for the outbox
 type EventEnricher struct {
 	clock clock.Clock
@@ -208,14 +702,98 @@ func (e *EventEnricher) EnrichEvent(domainEvent domain.DomainEvent) contracts.Ou
 		aggregateID = ev.AggregateID()
 		eventType = ev.EventType()
 		occurredAt = ev.OccurredAt()
+	case domain.ProductPriceChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
 	case domain.DiscountAppliedEvent:
 		aggregateID = ev.AggregateID()
 		eventType = ev.EventType()
 		occurredAt = ev.OccurredAt()
+	case domain.DiscountScheduledEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.DiscountScheduleCancelledEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.DiscountStartedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.DiscountEndedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.VariantAddedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.VariantUpdatedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.VariantRetiredEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.ProductAttributesChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.ProductBundleChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.ProductPriceTiersChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.ProductTaxClassChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
 	case domain.DiscountRemovedEvent:
 		aggregateID = ev.AggregateID()
 		eventType = ev.EventType()
 		occurredAt = ev.OccurredAt()
+	case domain.CategoryCreatedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.CategoryRenamedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.CategoryMovedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.CategoryArchivedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.StockChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.StockThresholdChangedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.PriceListCreatedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.PriceListPriceSetEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
+	case domain.PriceListPriceRemovedEvent:
+		aggregateID = ev.AggregateID()
+		eventType = ev.EventType()
+		occurredAt = ev.OccurredAt()
 	default:
 		return contracts.OutboxEvent{}
 	}
@@ -226,6 +804,110 @@ func (e *EventEnricher) EnrichEvent(domainEvent domain.DomainEvent) contracts.Ou
 		"occurred_at":  occurredAt.Unix(),
 	}
 
+	// Add event-specific fields
+	switch ev := domainEvent.(type) {
+	case domain.ProductPriceChangedEvent:
+		payload["old_price_numerator"] = ev.OldPriceNumerator
+		payload["old_price_denominator"] = ev.OldPriceDenominator
+		payload["new_price_numerator"] = ev.NewPriceNumerator
+		payload["new_price_denominator"] = ev.NewPriceDenominator
+		payload["currency"] = ev.Currency
+		if ev.ReasonCode != "" {
+			payload["reason_code"] = ev.ReasonCode
+		}
+	case domain.DiscountAppliedEvent:
+		addDiscountPayload(payload, ev)
+	case domain.DiscountScheduledEvent:
+		payload["discount_id"] = ev.DiscountID
+		addDiscountPayload(payload, ev.DiscountAppliedEvent)
+	case domain.DiscountScheduleCancelledEvent:
+		payload["discount_id"] = ev.DiscountID
+	case domain.DiscountStartedEvent:
+		if ev.DiscountID != "" {
+			payload["discount_id"] = ev.DiscountID
+		}
+		addDiscountPayload(payload, ev.DiscountAppliedEvent)
+	case domain.DiscountEndedEvent:
+		if ev.DiscountID != "" {
+			payload["discount_id"] = ev.DiscountID
+		}
+		addDiscountPayload(payload, ev.DiscountAppliedEvent)
+	case domain.VariantAddedEvent:
+		addVariantPayload(payload, ev)
+	case domain.VariantUpdatedEvent:
+		addVariantPayload(payload, ev.VariantAddedEvent)
+	case domain.VariantRetiredEvent:
+		payload["variant_id"] = ev.VariantID
+	case domain.ProductAttributesChangedEvent:
+		payload["attributes"] = ev.Attributes
+	case domain.ProductBundleChangedEvent:
+		payload["components"] = ev.Components
+		if ev.DiscountPercent != "" {
+			payload["discount_percent"] = ev.DiscountPercent
+		}
+		if ev.DiscountAmountNumerator != 0 {
+			payload["discount_amount_numerator"] = ev.DiscountAmountNumerator
+			payload["discount_amount_denominator"] = ev.DiscountAmountDenominator
+		}
+		if ev.PriceOverrideNumerator != 0 {
+			payload["price_override_numerator"] = ev.PriceOverrideNumerator
+			payload["price_override_denominator"] = ev.PriceOverrideDenominator
+		}
+	case domain.ProductPriceTiersChangedEvent:
+		tiers := make([]map[string]interface{}, 0, len(ev.Tiers))
+		for _, t := range ev.Tiers {
+			tiers = append(tiers, map[string]interface{}{
+				"min_quantity":      t.MinQuantity,
+				"price_numerator":   t.PriceNumerator,
+				"price_denominator": t.PriceDenominator,
+			})
+		}
+		payload["tiers"] = tiers
+		payload["currency"] = ev.Currency
+	case domain.ProductTaxClassChangedEvent:
+		payload["tax_class"] = ev.TaxClass
+	case domain.CategoryCreatedEvent:
+		payload["name"] = ev.Name
+		if ev.ParentID != "" {
+			payload["parent_id"] = ev.ParentID
+		}
+	case domain.CategoryRenamedEvent:
+		payload["name"] = ev.Name
+	case domain.CategoryMovedEvent:
+		payload["old_parent_id"] = ev.OldParentID
+		payload["parent_id"] = ev.ParentID
+	case domain.StockChangedEvent:
+		payload["change"] = ev.Change
+		payload["quantity"] = ev.Quantity
+		payload["on_hand"] = ev.OnHand
+		payload["reserved"] = ev.Reserved
+		if ev.ReasonCode != "" {
+			payload["reason_code"] = ev.ReasonCode
+		}
+	case domain.StockThresholdChangedEvent:
+		payload["low_stock_threshold"] = ev.LowStockThreshold
+	case domain.PriceListCreatedEvent:
+		payload["name"] = ev.Name
+		payload["currency"] = ev.Currency
+		if ev.Channel != "" {
+			payload["channel"] = ev.Channel
+		}
+		if ev.Segment != "" {
+			payload["segment"] = ev.Segment
+		}
+		payload["valid_from"] = ev.ValidFrom
+		if ev.ValidTo != 0 {
+			payload["valid_to"] = ev.ValidTo
+		}
+	case domain.PriceListPriceSetEvent:
+		payload["product_id"] = ev.ProductID
+		payload["price_numerator"] = ev.PriceNumerator
+		payload["price_denominator"] = ev.PriceDenominator
+		payload["currency"] = ev.Currency
+	case domain.PriceListPriceRemovedEvent:
+		payload["product_id"] = ev.ProductID
+	}
+
 	return contracts.OutboxEvent{
 		EventID:     uuid.New().String(),
 		EventType:   eventType,
@@ -233,3 +915,29 @@ func (e *EventEnricher) EnrichEvent(domainEvent domain.DomainEvent) contracts.Ou
 		Payload:     payload,
 	}
 }
+
+// addDiscountPayload adds the fields of an applied, scheduled, started or ended discount to payload
+func addDiscountPayload(payload map[string]interface{}, ev domain.DiscountAppliedEvent) {
+	payload["discount_kind"] = ev.DiscountKind
+	if ev.DiscountKind == string(domain.DiscountKindFixedAmount) {
+		payload["amount_numerator"] = ev.AmountNumerator
+		payload["amount_denominator"] = ev.AmountDenominator
+		payload["currency"] = ev.Currency
+	} else {
+		payload["discount_percent"] = domain.FormatPercentage(ev.DiscountPercent)
+	}
+	payload["start_date"] = ev.StartDate
+	payload["end_date"] = ev.EndDate
+}
+
+// addVariantPayload adds the fields of an added or updated variant to payload
+func addVariantPayload(payload map[string]interface{}, ev domain.VariantAddedEvent) {
+	payload["variant_id"] = ev.VariantID
+	payload["sku"] = ev.SKU
+	payload["options"] = ev.Options
+	if ev.PriceOverrideDenominator != 0 {
+		payload["price_override_numerator"] = ev.PriceOverrideNumerator
+		payload["price_override_denominator"] = ev.PriceOverrideDenominator
+		payload["currency"] = ev.Currency
+	}
+}
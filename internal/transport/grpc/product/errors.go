@@ -23,12 +23,114 @@ func mapDomainErrorToGRPC(err error) error {
 		return status.Error(codes.FailedPrecondition, "product is already active")
 	case errors.Is(err, domain.ErrProductIsArchived):
 		return status.Error(codes.FailedPrecondition, "product is archived")
+	case errors.Is(err, domain.ErrInvalidProductStatus):
+		return status.Error(codes.InvalidArgument, "status must be active, inactive or archived")
+	case errors.Is(err, domain.ErrStoredStateExpired):
+		return status.Error(codes.InvalidArgument, "read_stored_state can only read as_of_seconds within the last hour")
 	case errors.Is(err, domain.ErrInvalidDiscountPeriod):
 		return status.Error(codes.InvalidArgument, "invalid discount period")
 	case errors.Is(err, domain.ErrDiscountOutOfRange):
 		return status.Error(codes.OutOfRange, "discount must be between 0 and 100")
 	case errors.Is(err, domain.ErrNoActiveDiscount):
 		return status.Error(codes.FailedPrecondition, "no active discount to remove")
+	case errors.Is(err, domain.ErrInvalidDiscountPercent):
+		return status.Error(codes.InvalidArgument, "discount percentage is not a valid number")
+	case errors.Is(err, domain.ErrPercentageTooPrecise):
+		return status.Error(codes.InvalidArgument, "percentage must have at most 9 decimal places")
+	case errors.Is(err, domain.ErrUnsupportedDiscountKind):
+		return status.Error(codes.InvalidArgument, "discount kind is not supported")
+	case errors.Is(err, domain.ErrInvalidDiscountAmount):
+		return status.Error(codes.InvalidArgument, "discount amount must be positive")
+	case errors.Is(err, domain.ErrDiscountExceedsPrice):
+		return status.Error(codes.InvalidArgument, "discount amount exceeds the base price")
+	case errors.Is(err, domain.ErrDiscountOverlap):
+		return status.Error(codes.FailedPrecondition, "discount overlaps another discount")
+	case errors.Is(err, domain.ErrScheduledDiscountNotFound):
+		return status.Error(codes.NotFound, "scheduled discount not found")
+	case errors.Is(err, domain.ErrVariantNotFound):
+		return status.Error(codes.NotFound, "variant not found")
+	case errors.Is(err, domain.ErrVariantRetired):
+		return status.Error(codes.FailedPrecondition, "variant is retired")
+	case errors.Is(err, domain.ErrInvalidSKU):
+		return status.Error(codes.InvalidArgument, "sku cannot be empty")
+	case errors.Is(err, domain.ErrDuplicateSKU):
+		return status.Error(codes.AlreadyExists, "sku is already used by another variant")
+	case errors.Is(err, domain.ErrInvalidBundle):
+		return status.Error(codes.InvalidArgument, "bundle must list distinct components and at most one pricing rule")
+	case errors.Is(err, domain.ErrNestedBundle):
+		return status.Error(codes.InvalidArgument, "bundle components must be standard products")
+	case errors.Is(err, domain.ErrNotABundle):
+		return status.Error(codes.FailedPrecondition, "product is not a bundle")
+	case errors.Is(err, domain.ErrBundlePriceDerived):
+		return status.Error(codes.FailedPrecondition, "bundle price is derived from its components")
+	case errors.Is(err, domain.ErrBundleVariants):
+		return status.Error(codes.FailedPrecondition, "bundles cannot have variants")
+	case errors.Is(err, domain.ErrInvalidPriceTier):
+		return status.Error(codes.InvalidArgument, "price tier minimum quantity must be at least 2")
+	case errors.Is(err, domain.ErrPriceTiersNotMonotonic):
+		return status.Error(codes.InvalidArgument, "price tiers must ascend by quantity without raising the unit price")
+	case errors.Is(err, domain.ErrPriceListNotFound):
+		return status.Error(codes.NotFound, "price list not found")
+	case errors.Is(err, domain.ErrInvalidPriceList):
+		return status.Error(codes.InvalidArgument, "price list needs a name and a channel or segment")
+	case errors.Is(err, domain.ErrPriceListEntryNotFound):
+		return status.Error(codes.NotFound, "product has no price in the price list")
+	case errors.Is(err, domain.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "page token is invalid or was issued for other filters")
+	case errors.Is(err, domain.ErrUnsupportedSortOrder):
+		return status.Error(codes.InvalidArgument, "order_by must be name, created_at, updated_at or effective_price, optionally followed by asc or desc")
+	case errors.Is(err, domain.ErrInvalidPriceRange):
+		return status.Error(codes.InvalidArgument, "price range bounds must share a currency and min_price must not exceed max_price")
+	case errors.Is(err, domain.ErrInvalidSearchQuery):
+		return status.Error(codes.InvalidArgument, "query must contain between 1 and 16 words")
+	case errors.Is(err, domain.ErrInvalidPriceBands):
+		return status.Error(codes.InvalidArgument, "price_band_bounds must share a currency, strictly ascend and number at most 20")
+	case errors.Is(err, domain.ErrInvalidQuote):
+		return status.Error(codes.InvalidArgument, "quote must have between 1 and 100 items")
+	case errors.Is(err, domain.ErrInvalidTaxClass):
+		return status.Error(codes.InvalidArgument, "tax class must be a lower-case slug")
+	case errors.Is(err, domain.ErrInvalidRegion):
+		return status.Error(codes.InvalidArgument, "region must be an ISO 3166 country code with an optional subdivision")
+	case errors.Is(err, domain.ErrInvalidTaxRate):
+		return status.Error(codes.InvalidArgument, "tax rate must be between 0 and 100 percent")
+	case errors.Is(err, domain.ErrTaxRateNotFound):
+		return status.Error(codes.FailedPrecondition, "no tax rate is set for the product's tax class in the region")
+	case errors.Is(err, domain.ErrUnsupportedAttributeType):
+		return status.Error(codes.InvalidArgument, "attribute type is not supported")
+	case errors.Is(err, domain.ErrInvalidAttributeDefinition):
+		return status.Error(codes.InvalidArgument, "attribute definition is invalid")
+	case errors.Is(err, domain.ErrAttributeDefinitionNotFound):
+		return status.Error(codes.NotFound, "attribute definition not found")
+	case errors.Is(err, domain.ErrAttributeSchemaMismatch):
+		return status.Error(codes.FailedPrecondition, "attribute schema does not match the product's category")
+	case errors.Is(err, domain.ErrUnknownAttribute):
+		return status.Error(codes.InvalidArgument, "attribute is not defined for the category")
+	case errors.Is(err, domain.ErrInvalidAttributeValue):
+		return status.Error(codes.InvalidArgument, "attribute value does not match its definition")
+	case errors.Is(err, domain.ErrMissingRequiredAttribute):
+		return status.Error(codes.FailedPrecondition, "required attribute is missing")
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, domain.ErrCategoryArchived):
+		return status.Error(codes.FailedPrecondition, "category is archived")
+	case errors.Is(err, domain.ErrInvalidCategoryName):
+		return status.Error(codes.InvalidArgument, "category name must be between 1 and 100 characters")
+	case errors.Is(err, domain.ErrDuplicateCategoryName):
+		return status.Error(codes.AlreadyExists, "category name is already used by a sibling")
+	case errors.Is(err, domain.ErrCategoryCycle):
+		return status.Error(codes.InvalidArgument, "category cannot be moved below itself")
+	case errors.Is(err, domain.ErrCategoryHasChildren):
+		return status.Error(codes.FailedPrecondition, "category has active subcategories")
+	case errors.Is(err, domain.ErrStockNotFound):
+		return status.Error(codes.NotFound, "stock not found")
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, "quantity must be positive and within the maximum")
+	case errors.Is(err, domain.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, "insufficient stock available")
+	case errors.Is(err, domain.ErrReleaseExceedsReserved):
+		return status.Error(codes.FailedPrecondition, "release exceeds reserved quantity")
+	case errors.Is(err, domain.ErrInvalidThreshold):
+		return status.Error(codes.InvalidArgument, "low stock threshold cannot be negative")
 	case errors.Is(err, domain.ErrInvalidName):
 		return status.Error(codes.InvalidArgument, "name cannot be empty")
 	case errors.Is(err, domain.ErrInvalidCategory):
@@ -37,6 +139,14 @@ func mapDomainErrorToGRPC(err error) error {
 		return status.Error(codes.InvalidArgument, "price must be positive")
 	case errors.Is(err, domain.ErrInvalidDateRange):
 		return status.Error(codes.InvalidArgument, "end date must be after start date")
+	case errors.Is(err, domain.ErrUnsupportedCurrency):
+		return status.Error(codes.InvalidArgument, "currency is not supported")
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		return status.Error(codes.InvalidArgument, "money amounts have different currencies")
+	case errors.Is(err, domain.ErrAmountOverflow):
+		return status.Error(codes.OutOfRange, "amount is too large to return as a fraction")
+	case errors.Is(err, domain.ErrConcurrentModification):
+		return status.Error(codes.Aborted, "product was modified by another transaction")
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
@@ -2,32 +2,99 @@ package product
 
 import (
 	"context"
+	"strconv"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"product-catalog-service/internal/app/product/queries/get_catalog_facets"
+	"product-catalog-service/internal/app/product/queries/get_category"
+	"product-catalog-service/internal/app/product/queries/get_price"
+	"product-catalog-service/internal/app/product/queries/get_price_history"
 	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/list_attribute_definitions"
+	"product-catalog-service/internal/app/product/queries/list_categories"
+	"product-catalog-service/internal/app/product/queries/list_discounts"
 	"product-catalog-service/internal/app/product/queries/list_products"
+	"product-catalog-service/internal/app/product/queries/quote_prices"
+	"product-catalog-service/internal/app/product/queries/search_products"
 	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
+	"product-catalog-service/internal/app/product/usecases/adjust_stock"
 	"product-catalog-service/internal/app/product/usecases/apply_discount"
+	"product-catalog-service/internal/app/product/usecases/archive_category"
 	"product-catalog-service/internal/app/product/usecases/archive_product"
+	"product-catalog-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"product-catalog-service/internal/app/product/usecases/change_price"
+	"product-catalog-service/internal/app/product/usecases/create_bundle"
+	"product-catalog-service/internal/app/product/usecases/create_category"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
 	"product-catalog-service/internal/app/product/usecases/create_product"
 	"product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"product-catalog-service/internal/app/product/usecases/define_attribute"
+	"product-catalog-service/internal/app/product/usecases/move_category"
+	"product-catalog-service/internal/app/product/usecases/release_stock"
+	"product-catalog-service/internal/app/product/usecases/remove_attribute"
 	"product-catalog-service/internal/app/product/usecases/remove_discount"
+	"product-catalog-service/internal/app/product/usecases/remove_list_price"
+	"product-catalog-service/internal/app/product/usecases/reserve_stock"
+	"product-catalog-service/internal/app/product/usecases/retire_variant"
+	"product-catalog-service/internal/app/product/usecases/schedule_discount"
+	"product-catalog-service/internal/app/product/usecases/set_list_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/set_stock_threshold"
+	"product-catalog-service/internal/app/product/usecases/set_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"product-catalog-service/internal/app/product/usecases/update_bundle"
+	"product-catalog-service/internal/app/product/usecases/update_category"
 	"product-catalog-service/internal/app/product/usecases/update_product"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
 	productv1 "product-catalog-service/proto/product/v1"
 )
 
 // Handlers contains all the product usecase handlers
 type Handlers struct {
-	createProduct     *create_product.Interactor
-	updateProduct     *update_product.Interactor
-	activateProduct   *activate_product.Interactor
-	deactivateProduct *deactivate_product.Interactor
-	applyDiscount     *apply_discount.Interactor
-	removeDiscount    *remove_discount.Interactor
-	archiveProduct    *archive_product.Interactor
-	getProduct        *get_product.Query
-	listProducts      *list_products.Query
+	createProduct            *create_product.Interactor
+	updateProduct            *update_product.Interactor
+	activateProduct          *activate_product.Interactor
+	deactivateProduct        *deactivate_product.Interactor
+	applyDiscount            *apply_discount.Interactor
+	removeDiscount           *remove_discount.Interactor
+	archiveProduct           *archive_product.Interactor
+	changePrice              *change_price.Interactor
+	scheduleDiscount         *schedule_discount.Interactor
+	cancelScheduledDiscount  *cancel_scheduled_discount.Interactor
+	addVariant               *add_variant.Interactor
+	updateVariant            *update_variant.Interactor
+	retireVariant            *retire_variant.Interactor
+	defineAttribute          *define_attribute.Interactor
+	removeAttribute          *remove_attribute.Interactor
+	createCategory           *create_category.Interactor
+	updateCategory           *update_category.Interactor
+	moveCategory             *move_category.Interactor
+	archiveCategory          *archive_category.Interactor
+	reserveStock             *reserve_stock.Interactor
+	releaseStock             *release_stock.Interactor
+	adjustStock              *adjust_stock.Interactor
+	setStockThreshold        *set_stock_threshold.Interactor
+	createBundle             *create_bundle.Interactor
+	updateBundle             *update_bundle.Interactor
+	setPriceTiers            *set_price_tiers.Interactor
+	createPriceList          *create_price_list.Interactor
+	setListPrice             *set_list_price.Interactor
+	removeListPrice          *remove_list_price.Interactor
+	setTaxClass              *set_tax_class.Interactor
+	setTaxRate               *set_tax_rate.Interactor
+	getProduct               *get_product.Query
+	listProducts             *list_products.Query
+	getPriceHistory          *get_price_history.Query
+	listDiscounts            *list_discounts.Query
+	listAttributeDefinitions *list_attribute_definitions.Query
+	getCategory              *get_category.Query
+	listCategories           *list_categories.Query
+	getPrice                 *get_price.Query
+	quotePrices              *quote_prices.Query
+	getCatalogFacets         *get_catalog_facets.Query
+	searchProducts           *search_products.Query
 }
 
 // NewHandlers creates a new product handlers instance
@@ -39,19 +106,85 @@ func NewHandlers(
 	applyDiscount *apply_discount.Interactor,
 	removeDiscount *remove_discount.Interactor,
 	archiveProduct *archive_product.Interactor,
+	changePrice *change_price.Interactor,
+	scheduleDiscount *schedule_discount.Interactor,
+	cancelScheduledDiscount *cancel_scheduled_discount.Interactor,
+	addVariant *add_variant.Interactor,
+	updateVariant *update_variant.Interactor,
+	retireVariant *retire_variant.Interactor,
+	defineAttribute *define_attribute.Interactor,
+	removeAttribute *remove_attribute.Interactor,
+	createCategory *create_category.Interactor,
+	updateCategory *update_category.Interactor,
+	moveCategory *move_category.Interactor,
+	archiveCategory *archive_category.Interactor,
+	reserveStock *reserve_stock.Interactor,
+	releaseStock *release_stock.Interactor,
+	adjustStock *adjust_stock.Interactor,
+	setStockThreshold *set_stock_threshold.Interactor,
+	createBundle *create_bundle.Interactor,
+	updateBundle *update_bundle.Interactor,
+	setPriceTiers *set_price_tiers.Interactor,
+	createPriceList *create_price_list.Interactor,
+	setListPrice *set_list_price.Interactor,
+	removeListPrice *remove_list_price.Interactor,
+	setTaxClass *set_tax_class.Interactor,
+	setTaxRate *set_tax_rate.Interactor,
 	getProduct *get_product.Query,
 	listProducts *list_products.Query,
+	getPriceHistory *get_price_history.Query,
+	listDiscounts *list_discounts.Query,
+	listAttributeDefinitions *list_attribute_definitions.Query,
+	getCategory *get_category.Query,
+	listCategories *list_categories.Query,
+	getPrice *get_price.Query,
+	quotePrices *quote_prices.Query,
+	getCatalogFacets *get_catalog_facets.Query,
+	searchProducts *search_products.Query,
 ) *Handlers {
 	return &Handlers{
-		createProduct:     createProduct,
-		updateProduct:     updateProduct,
-		activateProduct:   activateProduct,
-		deactivateProduct: deactivateProduct,
-		applyDiscount:     applyDiscount,
-		removeDiscount:    removeDiscount,
-		archiveProduct:    archiveProduct,
-		getProduct:        getProduct,
-		listProducts:      listProducts,
+		createProduct:            createProduct,
+		updateProduct:            updateProduct,
+		activateProduct:          activateProduct,
+		deactivateProduct:        deactivateProduct,
+		applyDiscount:            applyDiscount,
+		removeDiscount:           removeDiscount,
+		archiveProduct:           archiveProduct,
+		changePrice:              changePrice,
+		scheduleDiscount:         scheduleDiscount,
+		cancelScheduledDiscount:  cancelScheduledDiscount,
+		addVariant:               addVariant,
+		updateVariant:            updateVariant,
+		retireVariant:            retireVariant,
+		defineAttribute:          defineAttribute,
+		removeAttribute:          removeAttribute,
+		createCategory:           createCategory,
+		updateCategory:           updateCategory,
+		moveCategory:             moveCategory,
+		archiveCategory:          archiveCategory,
+		reserveStock:             reserveStock,
+		releaseStock:             releaseStock,
+		adjustStock:              adjustStock,
+		setStockThreshold:        setStockThreshold,
+		createBundle:             createBundle,
+		updateBundle:             updateBundle,
+		setPriceTiers:            setPriceTiers,
+		createPriceList:          createPriceList,
+		setListPrice:             setListPrice,
+		removeListPrice:          removeListPrice,
+		setTaxClass:              setTaxClass,
+		setTaxRate:               setTaxRate,
+		getProduct:               getProduct,
+		listProducts:             listProducts,
+		getPriceHistory:          getPriceHistory,
+		listDiscounts:            listDiscounts,
+		listAttributeDefinitions: listAttributeDefinitions,
+		getCategory:              getCategory,
+		listCategories:           listCategories,
+		getPrice:                 getPrice,
+		quotePrices:              quotePrices,
+		getCatalogFacets:         getCatalogFacets,
+		searchProducts:           searchProducts,
 	}
 }
 
@@ -82,6 +215,7 @@ func (h *Handler) CreateProduct(ctx context.Context, req *productv1.CreateProduc
 		Category:             req.Category,
 		BasePriceNumerator:   req.BasePriceNumerator,
 		BasePriceDenominator: req.BasePriceDenominator,
+		Currency:             req.CurrencyCode,
 	}
 
 	// Execute usecase
@@ -106,6 +240,9 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *productv1.UpdateProduc
 		Name:        req.Name,
 		Description: req.Description,
 		Category:    req.Category,
+
+		SetAttributes:   req.GetSetAttributes(),
+		ClearAttributes: req.GetClearAttributes(),
 	}
 
 	_, err := h.handlers.updateProduct.Execute(ctx, appReq)
@@ -160,11 +297,23 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *productv1.ApplyDiscoun
 
 	appReq := apply_discount.Request{
 		ProductID:        req.ProductId,
-		DiscountPercent:  req.DiscountPercent,
+		DiscountKind:     req.DiscountKind,
+		DiscountPercent:  req.DiscountPercentExact,
 		DiscountStartSec: req.StartDateSeconds,
 		DiscountEndSec:   req.EndDateSeconds,
 	}
 
+	// Whole-number percentages may still be sent in the integer field
+	if appReq.DiscountPercent == "" {
+		appReq.DiscountPercent = strconv.FormatInt(req.DiscountPercent, 10)
+	}
+
+	if amount := req.GetDiscountAmount(); amount != nil {
+		appReq.AmountNumerator = amount.Numerator
+		appReq.AmountDenominator = amount.Denominator
+		appReq.AmountCurrency = amount.CurrencyCode
+	}
+
 	_, err := h.handlers.applyDiscount.Execute(ctx, appReq)
 	if err != nil {
 		return nil, mapDomainErrorToGRPC(err)
@@ -209,6 +358,626 @@ func (h *Handler) ArchiveProduct(ctx context.Context, req *productv1.ArchiveProd
 	return &productv1.ArchiveProductReply{}, nil
 }
 
+// ChangePrice handles the ChangePrice RPC
+func (h *Handler) ChangePrice(ctx context.Context, req *productv1.ChangePriceRequest) (*productv1.ChangePriceReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := change_price.Request{
+		ProductID:            req.ProductId,
+		BasePriceNumerator:   req.BasePriceNumerator,
+		BasePriceDenominator: req.BasePriceDenominator,
+		Currency:             req.CurrencyCode,
+		ReasonCode:           req.ReasonCode,
+	}
+
+	_, err := h.handlers.changePrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.ChangePriceReply{}, nil
+}
+
+// ScheduleDiscount handles the ScheduleDiscount RPC
+func (h *Handler) ScheduleDiscount(ctx context.Context, req *productv1.ScheduleDiscountRequest) (*productv1.ScheduleDiscountReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := schedule_discount.Request{
+		ProductID:        req.ProductId,
+		DiscountKind:     req.DiscountKind,
+		DiscountPercent:  req.DiscountPercentExact,
+		DiscountStartSec: req.StartDateSeconds,
+		DiscountEndSec:   req.EndDateSeconds,
+	}
+
+	if amount := req.GetDiscountAmount(); amount != nil {
+		appReq.AmountNumerator = amount.Numerator
+		appReq.AmountDenominator = amount.Denominator
+		appReq.AmountCurrency = amount.CurrencyCode
+	}
+
+	resp, err := h.handlers.scheduleDiscount.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.ScheduleDiscountReply{
+		DiscountId: resp.DiscountID,
+	}, nil
+}
+
+// CancelScheduledDiscount handles the CancelScheduledDiscount RPC
+func (h *Handler) CancelScheduledDiscount(ctx context.Context, req *productv1.CancelScheduledDiscountRequest) (*productv1.CancelScheduledDiscountReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.DiscountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "discount_id is required")
+	}
+
+	appReq := cancel_scheduled_discount.Request{
+		ProductID:  req.ProductId,
+		DiscountID: req.DiscountId,
+	}
+
+	_, err := h.handlers.cancelScheduledDiscount.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.CancelScheduledDiscountReply{}, nil
+}
+
+// AddVariant handles the AddVariant RPC
+func (h *Handler) AddVariant(ctx context.Context, req *productv1.AddVariantRequest) (*productv1.AddVariantReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.Sku == "" {
+		return nil, status.Error(codes.InvalidArgument, "sku is required")
+	}
+
+	appReq := add_variant.Request{
+		ProductID: req.ProductId,
+		SKU:       req.Sku,
+		Options:   req.Options,
+	}
+
+	if price := req.GetPriceOverride(); price != nil {
+		appReq.PriceOverrideNumerator = price.Numerator
+		appReq.PriceOverrideDenominator = price.Denominator
+		appReq.PriceOverrideCurrency = price.CurrencyCode
+	}
+
+	resp, err := h.handlers.addVariant.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.AddVariantReply{
+		VariantId: resp.VariantID,
+	}, nil
+}
+
+// UpdateVariant handles the UpdateVariant RPC
+func (h *Handler) UpdateVariant(ctx context.Context, req *productv1.UpdateVariantRequest) (*productv1.UpdateVariantReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.VariantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "variant_id is required")
+	}
+	if req.Sku == "" {
+		return nil, status.Error(codes.InvalidArgument, "sku is required")
+	}
+
+	appReq := update_variant.Request{
+		ProductID: req.ProductId,
+		VariantID: req.VariantId,
+		SKU:       req.Sku,
+		Options:   req.Options,
+	}
+
+	if price := req.GetPriceOverride(); price != nil {
+		appReq.PriceOverrideNumerator = price.Numerator
+		appReq.PriceOverrideDenominator = price.Denominator
+		appReq.PriceOverrideCurrency = price.CurrencyCode
+	}
+
+	_, err := h.handlers.updateVariant.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.UpdateVariantReply{}, nil
+}
+
+// RetireVariant handles the RetireVariant RPC
+func (h *Handler) RetireVariant(ctx context.Context, req *productv1.RetireVariantRequest) (*productv1.RetireVariantReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.VariantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "variant_id is required")
+	}
+
+	appReq := retire_variant.Request{
+		ProductID: req.ProductId,
+		VariantID: req.VariantId,
+	}
+
+	_, err := h.handlers.retireVariant.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.RetireVariantReply{}, nil
+}
+
+// DefineAttribute handles the DefineAttribute RPC
+func (h *Handler) DefineAttribute(ctx context.Context, req *productv1.DefineAttributeRequest) (*productv1.DefineAttributeReply, error) {
+	if req.Category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	appReq := define_attribute.Request{
+		Category:      req.Category,
+		Name:          req.Name,
+		Type:          req.Type,
+		Unit:          req.Unit,
+		Required:      req.Required,
+		AllowedValues: req.AllowedValues,
+	}
+
+	_, err := h.handlers.defineAttribute.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.DefineAttributeReply{}, nil
+}
+
+// RemoveAttribute handles the RemoveAttribute RPC
+func (h *Handler) RemoveAttribute(ctx context.Context, req *productv1.RemoveAttributeRequest) (*productv1.RemoveAttributeReply, error) {
+	if req.Category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	appReq := remove_attribute.Request{
+		Category: req.Category,
+		Name:     req.Name,
+	}
+
+	_, err := h.handlers.removeAttribute.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.RemoveAttributeReply{}, nil
+}
+
+// CreateCategory handles the CreateCategory RPC
+func (h *Handler) CreateCategory(ctx context.Context, req *productv1.CreateCategoryRequest) (*productv1.CreateCategoryReply, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	appReq := create_category.Request{
+		Name:     req.Name,
+		ParentID: req.ParentId,
+	}
+
+	resp, err := h.handlers.createCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.CreateCategoryReply{
+		CategoryId: resp.CategoryID,
+	}, nil
+}
+
+// UpdateCategory handles the UpdateCategory RPC
+func (h *Handler) UpdateCategory(ctx context.Context, req *productv1.UpdateCategoryRequest) (*productv1.UpdateCategoryReply, error) {
+	if req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	appReq := update_category.Request{
+		CategoryID: req.CategoryId,
+		Name:       req.Name,
+	}
+
+	_, err := h.handlers.updateCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.UpdateCategoryReply{}, nil
+}
+
+// MoveCategory handles the MoveCategory RPC
+func (h *Handler) MoveCategory(ctx context.Context, req *productv1.MoveCategoryRequest) (*productv1.MoveCategoryReply, error) {
+	if req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	appReq := move_category.Request{
+		CategoryID: req.CategoryId,
+		ParentID:   req.ParentId,
+	}
+
+	_, err := h.handlers.moveCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.MoveCategoryReply{}, nil
+}
+
+// ArchiveCategory handles the ArchiveCategory RPC
+func (h *Handler) ArchiveCategory(ctx context.Context, req *productv1.ArchiveCategoryRequest) (*productv1.ArchiveCategoryReply, error) {
+	if req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	appReq := archive_category.Request{
+		CategoryID: req.CategoryId,
+	}
+
+	_, err := h.handlers.archiveCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.ArchiveCategoryReply{}, nil
+}
+
+// ReserveStock handles the ReserveStock RPC
+func (h *Handler) ReserveStock(ctx context.Context, req *productv1.ReserveStockRequest) (*productv1.ReserveStockReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := reserve_stock.Request{
+		ProductID: req.ProductId,
+		Quantity:  req.Quantity,
+	}
+
+	resp, err := h.handlers.reserveStock.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.ReserveStockReply{
+		OnHand:   resp.OnHand,
+		Reserved: resp.Reserved,
+	}, nil
+}
+
+// ReleaseStock handles the ReleaseStock RPC
+func (h *Handler) ReleaseStock(ctx context.Context, req *productv1.ReleaseStockRequest) (*productv1.ReleaseStockReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := release_stock.Request{
+		ProductID: req.ProductId,
+		Quantity:  req.Quantity,
+	}
+
+	resp, err := h.handlers.releaseStock.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.ReleaseStockReply{
+		OnHand:   resp.OnHand,
+		Reserved: resp.Reserved,
+	}, nil
+}
+
+// AdjustStock handles the AdjustStock RPC
+func (h *Handler) AdjustStock(ctx context.Context, req *productv1.AdjustStockRequest) (*productv1.AdjustStockReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := adjust_stock.Request{
+		ProductID:  req.ProductId,
+		Delta:      req.Delta,
+		ReasonCode: req.ReasonCode,
+	}
+
+	resp, err := h.handlers.adjustStock.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.AdjustStockReply{
+		OnHand:   resp.OnHand,
+		Reserved: resp.Reserved,
+	}, nil
+}
+
+// SetStockThreshold handles the SetStockThreshold RPC
+func (h *Handler) SetStockThreshold(ctx context.Context, req *productv1.SetStockThresholdRequest) (*productv1.SetStockThresholdReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := set_stock_threshold.Request{
+		ProductID:         req.ProductId,
+		LowStockThreshold: req.LowStockThreshold,
+	}
+
+	_, err := h.handlers.setStockThreshold.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.SetStockThresholdReply{}, nil
+}
+
+// CreateBundle handles the CreateBundle RPC
+func (h *Handler) CreateBundle(ctx context.Context, req *productv1.CreateBundleRequest) (*productv1.CreateBundleReply, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.Category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+	if len(req.Components) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "components are required")
+	}
+
+	appReq := create_bundle.Request{
+		Name:            req.Name,
+		Description:     req.Description,
+		Category:        req.Category,
+		DiscountPercent: req.DiscountPercentExact,
+	}
+
+	for _, c := range req.Components {
+		appReq.Components = append(appReq.Components, create_bundle.Component{
+			ProductID: c.ProductId,
+			Quantity:  c.Quantity,
+		})
+	}
+
+	if amount := req.GetDiscountAmount(); amount != nil {
+		appReq.DiscountAmountNumerator = amount.Numerator
+		appReq.DiscountAmountDenominator = amount.Denominator
+		appReq.Currency = amount.CurrencyCode
+	}
+	if price := req.GetPriceOverride(); price != nil {
+		appReq.PriceOverrideNumerator = price.Numerator
+		appReq.PriceOverrideDenominator = price.Denominator
+		appReq.Currency = price.CurrencyCode
+	}
+
+	resp, err := h.handlers.createBundle.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.CreateBundleReply{
+		ProductId: resp.ProductID,
+	}, nil
+}
+
+// UpdateBundle handles the UpdateBundle RPC
+func (h *Handler) UpdateBundle(ctx context.Context, req *productv1.UpdateBundleRequest) (*productv1.UpdateBundleReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if len(req.Components) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "components are required")
+	}
+
+	appReq := update_bundle.Request{
+		ProductID:       req.ProductId,
+		DiscountPercent: req.DiscountPercentExact,
+	}
+
+	for _, c := range req.Components {
+		appReq.Components = append(appReq.Components, update_bundle.Component{
+			ProductID: c.ProductId,
+			Quantity:  c.Quantity,
+		})
+	}
+
+	if amount := req.GetDiscountAmount(); amount != nil {
+		appReq.DiscountAmountNumerator = amount.Numerator
+		appReq.DiscountAmountDenominator = amount.Denominator
+		appReq.Currency = amount.CurrencyCode
+	}
+	if price := req.GetPriceOverride(); price != nil {
+		appReq.PriceOverrideNumerator = price.Numerator
+		appReq.PriceOverrideDenominator = price.Denominator
+		appReq.Currency = price.CurrencyCode
+	}
+
+	_, err := h.handlers.updateBundle.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.UpdateBundleReply{}, nil
+}
+
+// SetPriceTiers handles the SetPriceTiers RPC
+func (h *Handler) SetPriceTiers(ctx context.Context, req *productv1.SetPriceTiersRequest) (*productv1.SetPriceTiersReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := set_price_tiers.Request{
+		ProductID: req.ProductId,
+	}
+
+	for _, t := range req.Tiers {
+		price := t.GetPrice()
+		if price == nil {
+			return nil, status.Error(codes.InvalidArgument, "tier price is required")
+		}
+
+		appReq.Tiers = append(appReq.Tiers, set_price_tiers.Tier{
+			MinQuantity:      t.MinQuantity,
+			PriceNumerator:   price.Numerator,
+			PriceDenominator: price.Denominator,
+		})
+		if price.CurrencyCode != "" {
+			appReq.Currency = price.CurrencyCode
+		}
+	}
+
+	_, err := h.handlers.setPriceTiers.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.SetPriceTiersReply{}, nil
+}
+
+// CreatePriceList handles the CreatePriceList RPC
+func (h *Handler) CreatePriceList(ctx context.Context, req *productv1.CreatePriceListRequest) (*productv1.CreatePriceListReply, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.CurrencyCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "currency_code is required")
+	}
+	if req.Channel == "" && req.Segment == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel or segment is required")
+	}
+
+	appReq := create_price_list.Request{
+		Name:         req.Name,
+		Currency:     req.CurrencyCode,
+		Channel:      req.Channel,
+		Segment:      req.Segment,
+		ValidFromSec: req.ValidFromSeconds,
+		ValidToSec:   req.ValidToSeconds,
+	}
+
+	resp, err := h.handlers.createPriceList.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.CreatePriceListReply{
+		PriceListId: resp.PriceListID,
+	}, nil
+}
+
+// SetListPrice handles the SetListPrice RPC
+func (h *Handler) SetListPrice(ctx context.Context, req *productv1.SetListPriceRequest) (*productv1.SetListPriceReply, error) {
+	if req.PriceListId == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_list_id is required")
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	price := req.GetPrice()
+	if price == nil {
+		return nil, status.Error(codes.InvalidArgument, "price is required")
+	}
+
+	appReq := set_list_price.Request{
+		PriceListID:      req.PriceListId,
+		ProductID:        req.ProductId,
+		PriceNumerator:   price.Numerator,
+		PriceDenominator: price.Denominator,
+		Currency:         price.CurrencyCode,
+	}
+
+	_, err := h.handlers.setListPrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.SetListPriceReply{}, nil
+}
+
+// RemoveListPrice handles the RemoveListPrice RPC
+func (h *Handler) RemoveListPrice(ctx context.Context, req *productv1.RemoveListPriceRequest) (*productv1.RemoveListPriceReply, error) {
+	if req.PriceListId == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_list_id is required")
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := remove_list_price.Request{
+		PriceListID: req.PriceListId,
+		ProductID:   req.ProductId,
+	}
+
+	_, err := h.handlers.removeListPrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.RemoveListPriceReply{}, nil
+}
+
+// SetTaxClass handles the SetTaxClass RPC
+func (h *Handler) SetTaxClass(ctx context.Context, req *productv1.SetTaxClassRequest) (*productv1.SetTaxClassReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := set_tax_class.Request{
+		ProductID: req.ProductId,
+		TaxClass:  req.TaxClass,
+	}
+
+	_, err := h.handlers.setTaxClass.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.SetTaxClassReply{}, nil
+}
+
+// SetTaxRate handles the SetTaxRate RPC
+func (h *Handler) SetTaxRate(ctx context.Context, req *productv1.SetTaxRateRequest) (*productv1.SetTaxRateReply, error) {
+	if req.Region == "" {
+		return nil, status.Error(codes.InvalidArgument, "region is required")
+	}
+	if req.RatePercentExact == "" {
+		return nil, status.Error(codes.InvalidArgument, "rate_percent_exact is required")
+	}
+
+	appReq := set_tax_rate.Request{
+		Region:      req.Region,
+		TaxClass:    req.TaxClass,
+		RatePercent: req.RatePercentExact,
+	}
+
+	_, err := h.handlers.setTaxRate.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.SetTaxRateReply{}, nil
+}
+
 // GetProduct handles the GetProduct RPC
 func (h *Handler) GetProduct(ctx context.Context, req *productv1.GetProductRequest) (*productv1.GetProductReply, error) {
 	if req.ProductId == "" {
@@ -216,7 +985,11 @@ func (h *Handler) GetProduct(ctx context.Context, req *productv1.GetProductReque
 	}
 
 	appReq := get_product.Request{
-		ProductID: req.ProductId,
+		ProductID:       req.ProductId,
+		AsOfSec:         req.AsOfSeconds,
+		ReadStoredState: req.ReadStoredState,
+		PriceListID:     req.PriceListId,
+		Region:          req.Region,
 	}
 
 	resp, err := h.handlers.getProduct.Execute(ctx, appReq)
@@ -232,10 +1005,31 @@ func (h *Handler) GetProduct(ctx context.Context, req *productv1.GetProductReque
 // ListProducts handles the ListProducts RPC
 func (h *Handler) ListProducts(ctx context.Context, req *productv1.ListProductsRequest) (*productv1.ListProductsReply, error) {
 	appReq := list_products.Request{
-		Category:  req.Category,
-		PageSize:  int(req.PageSize),
-		PageToken: req.PageToken,
-		Status:    "", // Default to empty to return all statuses
+		Category:        req.Category,
+		PageSize:        int(req.PageSize),
+		PageToken:       req.PageToken,
+		Statuses:        req.Statuses,
+		IncludeArchived: req.IncludeArchived,
+		AsOfSec:         req.AsOfSeconds,
+		ReadStoredState: req.ReadStoredState,
+		PriceListID:     req.PriceListId,
+		Region:          req.Region,
+
+		IncludeSubcategories: req.IncludeSubcategories,
+		AttributeFilters:     protoToAttributeFilters(req.GetAttributeFilters()),
+
+		OrderBy: req.OrderBy,
+	}
+
+	if price := req.GetMinPrice(); price != nil {
+		appReq.MinPriceNumerator = price.Numerator
+		appReq.MinPriceDenominator = price.Denominator
+		appReq.MinPriceCurrency = price.CurrencyCode
+	}
+	if price := req.GetMaxPrice(); price != nil {
+		appReq.MaxPriceNumerator = price.Numerator
+		appReq.MaxPriceDenominator = price.Denominator
+		appReq.MaxPriceCurrency = price.CurrencyCode
 	}
 
 	resp, err := h.handlers.listProducts.Execute(ctx, appReq)
@@ -253,3 +1047,245 @@ func (h *Handler) ListProducts(ctx context.Context, req *productv1.ListProductsR
 		NextPageToken: resp.NextPageToken,
 	}, nil
 }
+
+// GetPriceHistory handles the GetPriceHistory RPC
+func (h *Handler) GetPriceHistory(ctx context.Context, req *productv1.GetPriceHistoryRequest) (*productv1.GetPriceHistoryReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := get_price_history.Request{
+		ProductID: req.ProductId,
+		FromSec:   req.FromSeconds,
+		ToSec:     req.ToSeconds,
+	}
+
+	resp, err := h.handlers.getPriceHistory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	intervals := make([]*productv1.PriceInterval, len(resp.Intervals))
+	for i, interval := range resp.Intervals {
+		intervals[i] = dtoToProtoPriceInterval(interval)
+	}
+
+	return &productv1.GetPriceHistoryReply{
+		Intervals: intervals,
+	}, nil
+}
+
+// ListDiscounts handles the ListDiscounts RPC
+func (h *Handler) ListDiscounts(ctx context.Context, req *productv1.ListDiscountsRequest) (*productv1.ListDiscountsReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := list_discounts.Request{
+		ProductID: req.ProductId,
+	}
+
+	resp, err := h.handlers.listDiscounts.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	discounts := make([]*productv1.ScheduledDiscount, len(resp.Discounts))
+	for i, discount := range resp.Discounts {
+		discounts[i] = dtoToProtoScheduledDiscount(discount)
+	}
+
+	return &productv1.ListDiscountsReply{
+		Discounts: discounts,
+	}, nil
+}
+
+// ListAttributeDefinitions handles the ListAttributeDefinitions RPC
+func (h *Handler) ListAttributeDefinitions(ctx context.Context, req *productv1.ListAttributeDefinitionsRequest) (*productv1.ListAttributeDefinitionsReply, error) {
+	if req.Category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	appReq := list_attribute_definitions.Request{
+		Category: req.Category,
+	}
+
+	resp, err := h.handlers.listAttributeDefinitions.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	definitions := make([]*productv1.AttributeDefinition, len(resp.Definitions))
+	for i, definition := range resp.Definitions {
+		definitions[i] = dtoToProtoAttributeDefinition(definition)
+	}
+
+	return &productv1.ListAttributeDefinitionsReply{
+		Definitions: definitions,
+	}, nil
+}
+
+// GetCategory handles the GetCategory RPC
+func (h *Handler) GetCategory(ctx context.Context, req *productv1.GetCategoryRequest) (*productv1.GetCategoryReply, error) {
+	if req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	appReq := get_category.Request{
+		CategoryID: req.CategoryId,
+	}
+
+	resp, err := h.handlers.getCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return &productv1.GetCategoryReply{
+		Category: dtoToProtoCategory(resp.Category),
+	}, nil
+}
+
+// ListCategories handles the ListCategories RPC
+func (h *Handler) ListCategories(ctx context.Context, req *productv1.ListCategoriesRequest) (*productv1.ListCategoriesReply, error) {
+	appReq := list_categories.Request{
+		ParentID:        req.ParentId,
+		IncludeArchived: req.IncludeArchived,
+	}
+
+	resp, err := h.handlers.listCategories.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	categories := make([]*productv1.Category, len(resp.Categories))
+	for i, category := range resp.Categories {
+		categories[i] = dtoToProtoCategory(category)
+	}
+
+	return &productv1.ListCategoriesReply{
+		Categories: categories,
+	}, nil
+}
+
+// GetPrice handles the GetPrice RPC
+func (h *Handler) GetPrice(ctx context.Context, req *productv1.GetPriceRequest) (*productv1.GetPriceReply, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := get_price.Request{
+		ProductID:   req.ProductId,
+		Quantity:    req.Quantity,
+		AsOfSec:     req.AsOfSeconds,
+		PriceListID: req.PriceListId,
+		Region:      req.Region,
+	}
+
+	resp, err := h.handlers.getPrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return dtoToProtoQuantityPrice(resp.Price), nil
+}
+
+// QuotePrices handles the QuotePrices RPC
+func (h *Handler) QuotePrices(ctx context.Context, req *productv1.QuotePricesRequest) (*productv1.QuotePricesReply, error) {
+	if len(req.GetItems()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "items are required")
+	}
+
+	appReq := quote_prices.Request{
+		Items:       make([]quote_prices.Item, 0, len(req.GetItems())),
+		AsOfSec:     req.AsOfSeconds,
+		PriceListID: req.PriceListId,
+	}
+	for _, item := range req.GetItems() {
+		appReq.Items = append(appReq.Items, quote_prices.Item{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	resp, err := h.handlers.quotePrices.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return dtoToProtoQuote(resp.Quote), nil
+}
+
+// GetCatalogFacets handles the GetCatalogFacets RPC
+func (h *Handler) GetCatalogFacets(ctx context.Context, req *productv1.GetCatalogFacetsRequest) (*productv1.GetCatalogFacetsReply, error) {
+	appReq := get_catalog_facets.Request{
+		Products: list_products.Request{
+			Category:        req.Category,
+			Statuses:        req.Statuses,
+			IncludeArchived: req.IncludeArchived,
+			AsOfSec:         req.AsOfSeconds,
+
+			IncludeSubcategories: req.IncludeSubcategories,
+			AttributeFilters:     protoToAttributeFilters(req.GetAttributeFilters()),
+		},
+	}
+
+	if price := req.GetMinPrice(); price != nil {
+		appReq.Products.MinPriceNumerator = price.Numerator
+		appReq.Products.MinPriceDenominator = price.Denominator
+		appReq.Products.MinPriceCurrency = price.CurrencyCode
+	}
+	if price := req.GetMaxPrice(); price != nil {
+		appReq.Products.MaxPriceNumerator = price.Numerator
+		appReq.Products.MaxPriceDenominator = price.Denominator
+		appReq.Products.MaxPriceCurrency = price.CurrencyCode
+	}
+
+	for _, bound := range req.GetPriceBandBounds() {
+		appReq.PriceBandBounds = append(appReq.PriceBandBounds, get_catalog_facets.PriceBound{
+			Numerator:   bound.Numerator,
+			Denominator: bound.Denominator,
+			Currency:    bound.CurrencyCode,
+		})
+	}
+
+	resp, err := h.handlers.getCatalogFacets.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	return dtoToProtoCatalogFacets(resp.Facets), nil
+}
+
+// SearchProducts handles the SearchProducts RPC
+func (h *Handler) SearchProducts(ctx context.Context, req *productv1.SearchProductsRequest) (*productv1.SearchProductsReply, error) {
+	appReq := search_products.Request{
+		Query: req.Query,
+		Products: list_products.Request{
+			Category:        req.Category,
+			PageSize:        int(req.PageSize),
+			PageToken:       req.PageToken,
+			Statuses:        req.Statuses,
+			IncludeArchived: req.IncludeArchived,
+			AsOfSec:         req.AsOfSeconds,
+			PriceListID:     req.PriceListId,
+			Region:          req.Region,
+
+			IncludeSubcategories: req.IncludeSubcategories,
+		},
+	}
+
+	resp, err := h.handlers.searchProducts.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	results := make([]*productv1.SearchResult, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = dtoToProtoSearchResult(r)
+	}
+
+	return &productv1.SearchProductsReply{
+		Results:       results,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
@@ -1,37 +1,458 @@
 package product
 
 import (
-	productv1 "product-catalog-service/proto/product/v1"
+	"math/big"
+
 	"product-catalog-service/internal/app/product/contracts"
+	"product-catalog-service/internal/app/product/domain"
+	productv1 "product-catalog-service/proto/product/v1"
 )
 
 // dtoToProtoProduct converts a ProductDTO to a proto Product
 func dtoToProtoProduct(dto *contracts.ProductDTO) *productv1.Product {
 	p := &productv1.Product{
-		ProductId: dto.ProductID,
-		Name:      dto.Name,
+		ProductId:   dto.ProductID,
+		Name:        dto.Name,
 		Description: dto.Description,
-		Category:  dto.Category,
+		Category:    dto.Category,
 		BasePrice: &productv1.Money{
-			Numerator:   dto.BasePriceNumerator,
-			Denominator: dto.BasePriceDenominator,
+			Numerator:    dto.BasePriceNumerator,
+			Denominator:  dto.BasePriceDenominator,
+			CurrencyCode: dto.Currency,
+			Decimal:      dto.BasePriceDecimal,
 		},
 		EffectivePrice: &productv1.Money{
-			Numerator:   dto.EffectivePriceNumerator,
-			Denominator: dto.EffectivePriceDenominator,
+			Numerator:    dto.EffectivePriceNumerator,
+			Denominator:  dto.EffectivePriceDenominator,
+			CurrencyCode: dto.Currency,
+			Decimal:      dto.EffectivePriceDecimal,
 		},
-		Status:          dto.Status,
+		Status:            dto.Status,
+		CreatedAtSeconds:  dto.CreatedAtSec,
+		UpdatedAtSeconds:  dto.UpdatedAtSec,
+		Availability:      dto.Availability,
+		AvailableQuantity: dto.AvailableQuantity,
+		ProductType:       dto.ProductType,
+		PriceListId:       dto.PriceListID,
+		TaxClass:          dto.TaxClass,
+	}
+
+	if dto.Tax != nil {
+		p.Tax = dtoToProtoTax(dto.Tax, dto.Currency)
+	}
+
+	if dto.Bundle != nil {
+		p.Bundle = dtoToProtoBundle(dto.Bundle, dto.Currency)
+	}
+
+	if dto.HasDiscount {
+		p.Discount = dtoToProtoDiscount(
+			dto.DiscountKind,
+			dto.DiscountPercent,
+			dto.DiscountAmountNumerator,
+			dto.DiscountAmountDenominator,
+			dto.Currency,
+			*dto.DiscountStartDate,
+			*dto.DiscountEndDate,
+		)
+	}
+
+	for _, v := range dto.Variants {
+		p.Variants = append(p.Variants, dtoToProtoVariant(v, dto.Currency))
+	}
+
+	for _, t := range dto.PriceTiers {
+		p.PriceTiers = append(p.PriceTiers, dtoToProtoPriceTier(t, dto.Currency))
+	}
+
+	for _, a := range dto.Attributes {
+		p.Attributes = append(p.Attributes, &productv1.Attribute{
+			Name:  a.Name,
+			Type:  a.Type,
+			Value: a.Value,
+		})
+	}
+
+	return p
+}
+
+// dtoToProtoVariant converts a VariantDTO priced in currency to a proto Variant
+func dtoToProtoVariant(dto *contracts.VariantDTO, currency string) *productv1.Variant {
+	return &productv1.Variant{
+		VariantId: dto.VariantID,
+		Sku:       dto.SKU,
+		Options:   dto.Options,
+		Price: &productv1.Money{
+			Numerator:    dto.PriceNumerator,
+			Denominator:  dto.PriceDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.PriceDecimal,
+		},
+		HasPriceOverride: dto.HasPriceOverride,
+		EffectivePrice: &productv1.Money{
+			Numerator:    dto.EffectivePriceNumerator,
+			Denominator:  dto.EffectivePriceDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.EffectivePriceDecimal,
+		},
+		Status:           dto.Status,
 		CreatedAtSeconds: dto.CreatedAtSec,
 		UpdatedAtSeconds: dto.UpdatedAtSec,
 	}
+}
+
+// dtoToProtoPriceTier converts a PriceTierDTO priced in currency to a proto PriceTier
+func dtoToProtoPriceTier(dto *contracts.PriceTierDTO, currency string) *productv1.PriceTier {
+	return &productv1.PriceTier{
+		MinQuantity: dto.MinQuantity,
+		Price: &productv1.Money{
+			Numerator:    dto.PriceNumerator,
+			Denominator:  dto.PriceDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.PriceDecimal,
+		},
+		EffectivePrice: &productv1.Money{
+			Numerator:    dto.EffectivePriceNumerator,
+			Denominator:  dto.EffectivePriceDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.EffectivePriceDecimal,
+		},
+	}
+}
+
+// dtoToProtoQuantityPrice converts a QuantityPriceDTO to a proto GetPriceReply
+func dtoToProtoQuantityPrice(dto *contracts.QuantityPriceDTO) *productv1.GetPriceReply {
+	p := &productv1.GetPriceReply{
+		ProductId:       dto.ProductID,
+		Quantity:        dto.Quantity,
+		TierMinQuantity: dto.TierMinQuantity,
+		UnitPrice: &productv1.Money{
+			Numerator:    dto.UnitPriceNumerator,
+			Denominator:  dto.UnitPriceDenominator,
+			CurrencyCode: dto.Currency,
+			Decimal:      dto.UnitPriceDecimal,
+		},
+		EffectiveUnitPrice: &productv1.Money{
+			Numerator:    dto.EffectiveUnitPriceNumerator,
+			Denominator:  dto.EffectiveUnitPriceDenominator,
+			CurrencyCode: dto.Currency,
+			Decimal:      dto.EffectiveUnitPriceDecimal,
+		},
+		LineTotal: &productv1.Money{
+			Numerator:    dto.LineTotalNumerator,
+			Denominator:  dto.LineTotalDenominator,
+			CurrencyCode: dto.Currency,
+			Decimal:      dto.LineTotalDecimal,
+		},
+	}
+
+	if dto.Tax != nil {
+		p.Tax = dtoToProtoTax(dto.Tax, dto.Currency)
+	}
 
 	if dto.HasDiscount {
-		p.Discount = &productv1.Discount{
-			Percent:         *dto.DiscountPercent,
-			StartDateSeconds: *dto.DiscountStartDate,
-			EndDateSeconds:   *dto.DiscountEndDate,
+		p.Discount = dtoToProtoDiscount(
+			dto.DiscountKind,
+			dto.DiscountPercent,
+			dto.DiscountAmountNumerator,
+			dto.DiscountAmountDenominator,
+			dto.Currency,
+			*dto.DiscountStartDate,
+			*dto.DiscountEndDate,
+		)
+	}
+
+	return p
+}
+
+// dtoToProtoQuote converts a QuoteDTO to a proto QuotePricesReply
+func dtoToProtoQuote(dto *contracts.QuoteDTO) *productv1.QuotePricesReply {
+	p := &productv1.QuotePricesReply{}
+
+	for _, l := range dto.Lines {
+		p.Lines = append(p.Lines, dtoToProtoQuoteLine(l, dto.Currency))
+	}
+
+	if dto.Currency != "" {
+		p.Total = &productv1.Money{
+			Numerator:    dto.TotalNumerator,
+			Denominator:  dto.TotalDenominator,
+			CurrencyCode: dto.Currency,
+			Decimal:      dto.TotalDecimal,
+		}
+	}
+
+	return p
+}
+
+// dtoToProtoCatalogFacets converts a CatalogFacetsDTO to a proto GetCatalogFacetsReply
+func dtoToProtoCatalogFacets(dto *contracts.CatalogFacetsDTO) *productv1.GetCatalogFacetsReply {
+	p := &productv1.GetCatalogFacetsReply{
+		Total:             dto.Total,
+		Categories:        dtoToProtoFacetCounts(dto.Categories),
+		Statuses:          dtoToProtoFacetCounts(dto.Statuses),
+		HasActiveDiscount: dtoToProtoFacetCounts(dto.HasActiveDiscount),
+	}
+
+	for _, b := range dto.PriceBands {
+		band := &productv1.PriceBand{Count: b.Count}
+		if b.MinNumerator != nil && b.MinDenominator != nil {
+			band.Min = &productv1.Money{
+				Numerator:    *b.MinNumerator,
+				Denominator:  *b.MinDenominator,
+				CurrencyCode: b.Currency,
+				Decimal:      b.MinDecimal,
+			}
+		}
+		if b.MaxNumerator != nil && b.MaxDenominator != nil {
+			band.Max = &productv1.Money{
+				Numerator:    *b.MaxNumerator,
+				Denominator:  *b.MaxDenominator,
+				CurrencyCode: b.Currency,
+				Decimal:      b.MaxDecimal,
+			}
 		}
+		p.PriceBands = append(p.PriceBands, band)
 	}
 
 	return p
 }
+
+// dtoToProtoFacetCounts converts FacetCountDTOs to proto FacetCounts
+func dtoToProtoFacetCounts(dtos []*contracts.FacetCountDTO) []*productv1.FacetCount {
+	counts := make([]*productv1.FacetCount, len(dtos))
+	for i, c := range dtos {
+		counts[i] = &productv1.FacetCount{Value: c.Value, Count: c.Count}
+	}
+	return counts
+}
+
+// dtoToProtoSearchResult converts a SearchResultDTO to a proto SearchResult
+func dtoToProtoSearchResult(dto *contracts.SearchResultDTO) *productv1.SearchResult {
+	return &productv1.SearchResult{
+		Product:              dtoToProtoProduct(dto.Product),
+		Score:                dto.Score,
+		NameHighlight:        dto.NameHighlight,
+		DescriptionHighlight: dto.DescriptionHighlight,
+	}
+}
+
+// dtoToProtoQuoteLine converts a QuoteLineDTO priced in currency to a proto QuoteLine
+func dtoToProtoQuoteLine(dto *contracts.QuoteLineDTO, currency string) *productv1.QuoteLine {
+	p := &productv1.QuoteLine{
+		ProductId:       dto.ProductID,
+		Quantity:        dto.Quantity,
+		RejectionReason: dto.RejectionReason,
+	}
+
+	if dto.RejectionReason != "" {
+		return p
+	}
+
+	p.TierMinQuantity = dto.TierMinQuantity
+
+	p.UnitPrice = &productv1.Money{
+		Numerator:    dto.UnitPriceNumerator,
+		Denominator:  dto.UnitPriceDenominator,
+		CurrencyCode: currency,
+		Decimal:      dto.UnitPriceDecimal,
+	}
+	p.EffectiveUnitPrice = &productv1.Money{
+		Numerator:    dto.EffectiveUnitPriceNumerator,
+		Denominator:  dto.EffectiveUnitPriceDenominator,
+		CurrencyCode: currency,
+		Decimal:      dto.EffectiveUnitPriceDecimal,
+	}
+	p.LineTotal = &productv1.Money{
+		Numerator:    dto.LineTotalNumerator,
+		Denominator:  dto.LineTotalDenominator,
+		CurrencyCode: currency,
+		Decimal:      dto.LineTotalDecimal,
+	}
+
+	if dto.HasDiscount {
+		p.Discount = dtoToProtoDiscount(
+			dto.DiscountKind,
+			dto.DiscountPercent,
+			dto.DiscountAmountNumerator,
+			dto.DiscountAmountDenominator,
+			currency,
+			*dto.DiscountStartDate,
+			*dto.DiscountEndDate,
+		)
+	}
+
+	return p
+}
+
+// dtoToProtoTax converts a TaxDTO priced in currency to a proto Tax
+func dtoToProtoTax(dto *contracts.TaxDTO, currency string) *productv1.Tax {
+	return &productv1.Tax{
+		Region:           dto.Region,
+		TaxClass:         dto.TaxClass,
+		RatePercentExact: domain.FormatPercentage(dto.RatePercent),
+		Net: &productv1.Money{
+			Numerator:    dto.NetNumerator,
+			Denominator:  dto.NetDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.NetDecimal,
+		},
+		Tax: &productv1.Money{
+			Numerator:    dto.TaxNumerator,
+			Denominator:  dto.TaxDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.TaxDecimal,
+		},
+		Gross: &productv1.Money{
+			Numerator:    dto.GrossNumerator,
+			Denominator:  dto.GrossDenominator,
+			CurrencyCode: currency,
+			Decimal:      dto.GrossDecimal,
+		},
+	}
+}
+
+// dtoToProtoBundle converts a BundleDTO priced in currency to a proto Bundle
+func dtoToProtoBundle(dto *contracts.BundleDTO, currency string) *productv1.Bundle {
+	b := &productv1.Bundle{}
+
+	for _, c := range dto.Components {
+		b.Components = append(b.Components, &productv1.BundleComponent{
+			ProductId: c.ProductID,
+			Quantity:  c.Quantity,
+		})
+	}
+
+	if dto.DiscountPercent != nil {
+		b.DiscountPercentExact = domain.FormatPercentage(dto.DiscountPercent)
+	}
+	if dto.DiscountAmountNumerator != nil && dto.DiscountAmountDenominator != nil {
+		b.DiscountAmount = &productv1.Money{
+			Numerator:    *dto.DiscountAmountNumerator,
+			Denominator:  *dto.DiscountAmountDenominator,
+			CurrencyCode: currency,
+		}
+	}
+	if dto.PriceOverrideNumerator != nil && dto.PriceOverrideDenominator != nil {
+		b.PriceOverride = &productv1.Money{
+			Numerator:    *dto.PriceOverrideNumerator,
+			Denominator:  *dto.PriceOverrideDenominator,
+			CurrencyCode: currency,
+		}
+	}
+
+	return b
+}
+
+// dtoToProtoAttributeDefinition converts an AttributeDefinitionDTO to a proto AttributeDefinition
+func dtoToProtoAttributeDefinition(dto *contracts.AttributeDefinitionDTO) *productv1.AttributeDefinition {
+	return &productv1.AttributeDefinition{
+		Category:      dto.Category,
+		Name:          dto.Name,
+		Type:          dto.Type,
+		Unit:          dto.Unit,
+		Required:      dto.Required,
+		AllowedValues: dto.AllowedValues,
+	}
+}
+
+// protoToAttributeFilters converts proto attribute filters to read model filters
+func protoToAttributeFilters(filters []*productv1.AttributeFilter) []contracts.AttributeFilter {
+	result := make([]contracts.AttributeFilter, 0, len(filters))
+	for _, f := range filters {
+		result = append(result, contracts.AttributeFilter{
+			Name:   f.Name,
+			Equals: f.Equals,
+			Min:    f.Min,
+			Max:    f.Max,
+		})
+	}
+	return result
+}
+
+// dtoToProtoCategory converts a CategoryDTO to a proto Category
+func dtoToProtoCategory(dto *contracts.CategoryDTO) *productv1.Category {
+	return &productv1.Category{
+		CategoryId:       dto.CategoryID,
+		Name:             dto.Name,
+		ParentId:         dto.ParentID,
+		AncestorIds:      dto.AncestorIDs,
+		Status:           dto.Status,
+		CreatedAtSeconds: dto.CreatedAtSec,
+		UpdatedAtSeconds: dto.UpdatedAtSec,
+	}
+}
+
+// dtoToProtoPriceInterval converts a PriceIntervalDTO to a proto PriceInterval
+func dtoToProtoPriceInterval(dto *contracts.PriceIntervalDTO) *productv1.PriceInterval {
+	p := &productv1.PriceInterval{
+		StartSeconds: dto.StartSec,
+		EndSeconds:   dto.EndSec,
+		BasePrice: &productv1.Money{
+			Numerator:    dto.BasePriceNumerator,
+			Denominator:  dto.BasePriceDenominator,
+			CurrencyCode: dto.Currency,
+		},
+		EffectivePrice: &productv1.Money{
+			Numerator:    dto.EffectivePriceNumerator,
+			Denominator:  dto.EffectivePriceDenominator,
+			CurrencyCode: dto.Currency,
+		},
+	}
+
+	if dto.HasDiscount {
+		p.Discount = dtoToProtoDiscount(
+			dto.DiscountKind,
+			dto.DiscountPercent,
+			dto.DiscountAmountNumerator,
+			dto.DiscountAmountDenominator,
+			dto.Currency,
+			*dto.DiscountStartDate,
+			*dto.DiscountEndDate,
+		)
+	}
+
+	return p
+}
+
+// dtoToProtoScheduledDiscount converts a ScheduledDiscountDTO to a proto ScheduledDiscount
+func dtoToProtoScheduledDiscount(dto *contracts.ScheduledDiscountDTO) *productv1.ScheduledDiscount {
+	return &productv1.ScheduledDiscount{
+		DiscountId: dto.DiscountID,
+		Discount: dtoToProtoDiscount(
+			dto.DiscountKind,
+			dto.DiscountPercent,
+			dto.DiscountAmountNumerator,
+			dto.DiscountAmountDenominator,
+			dto.Currency,
+			dto.StartSec,
+			dto.EndSec,
+		),
+	}
+}
+
+// dtoToProtoDiscount converts discount fields of a DTO to a proto Discount
+func dtoToProtoDiscount(kind string, percent *big.Rat, amountNum, amountDenom *int64, currency string, startSec, endSec int64) *productv1.Discount {
+	d := &productv1.Discount{
+		Kind:             kind,
+		StartDateSeconds: startSec,
+		EndDateSeconds:   endSec,
+	}
+
+	if percent != nil {
+		d.PercentExact = domain.FormatPercentage(percent)
+		if percent.IsInt() {
+			d.Percent = percent.Num().Int64()
+		}
+	}
+
+	if amountNum != nil && amountDenom != nil {
+		d.Amount = &productv1.Money{
+			Numerator:    *amountNum,
+			Denominator:  *amountDenom,
+			CurrencyCode: currency,
+		}
+	}
+
+	return d
+}
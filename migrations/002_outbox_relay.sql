@@ -0,0 +1,8 @@
+-- Outbox relay leasing
+
+-- Relay replicas claim pending events by writing a lease before publishing,
+-- so two replicas never publish the same event concurrently.
+ALTER TABLE outbox_events ADD COLUMN lease_owner STRING(64);
+ALTER TABLE outbox_events ADD COLUMN lease_expires_at TIMESTAMP;
+
+ALTER INDEX idx_outbox_status ADD STORED COLUMN lease_expires_at;
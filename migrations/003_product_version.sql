@@ -0,0 +1,4 @@
+-- Optimistic concurrency for products
+
+-- Incremented on every update; writers require the version they loaded.
+ALTER TABLE products ADD COLUMN version INT64 NOT NULL DEFAULT (0);
@@ -0,0 +1,15 @@
+-- Product price history
+
+-- One row per pricing state change, written in the same commit as the change.
+-- A row applies from effective_from until the next row for the same product.
+CREATE TABLE product_price_history (
+    product_id STRING(36) NOT NULL,
+    effective_from TIMESTAMP NOT NULL,
+    base_price_numerator INT64 NOT NULL,
+    base_price_denominator INT64 NOT NULL,
+    discount_percent NUMERIC,
+    discount_start_date TIMESTAMP,
+    discount_end_date TIMESTAMP,
+    recorded_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (product_id, effective_from),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+-- Multi-currency prices
+
+-- ISO-4217 currency code of the product's prices. Existing rows are USD.
+ALTER TABLE products ADD COLUMN currency STRING(3) NOT NULL DEFAULT ('USD');
+ALTER TABLE product_price_history ADD COLUMN currency STRING(3) NOT NULL DEFAULT ('USD');
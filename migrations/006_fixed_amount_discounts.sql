@@ -0,0 +1,11 @@
+-- Fixed-amount discounts
+
+-- 'percentage' or 'fixed_amount'. Existing discounts (NULL) are percentages.
+-- Fixed amounts are in the product's currency.
+ALTER TABLE products ADD COLUMN discount_kind STRING(20);
+ALTER TABLE products ADD COLUMN discount_amount_numerator INT64;
+ALTER TABLE products ADD COLUMN discount_amount_denominator INT64;
+
+ALTER TABLE product_price_history ADD COLUMN discount_kind STRING(20);
+ALTER TABLE product_price_history ADD COLUMN discount_amount_numerator INT64;
+ALTER TABLE product_price_history ADD COLUMN discount_amount_denominator INT64;
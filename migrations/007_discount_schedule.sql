@@ -0,0 +1,15 @@
+-- Scheduled discounts
+
+-- Discounts queued on a product for their validity window, alongside the
+-- discount stored on the product row. Windows of a product never overlap.
+CREATE TABLE product_discounts (
+    product_id STRING(36) NOT NULL,
+    discount_id STRING(36) NOT NULL,
+    discount_kind STRING(20) NOT NULL,
+    discount_percent NUMERIC,
+    discount_amount_numerator INT64,
+    discount_amount_denominator INT64,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, discount_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,13 @@
+-- Discount lifecycle
+
+-- Which lifecycle events have been emitted for a discount window: 'pending',
+-- 'started' or 'ended'. The phase is written in the same commit as the outbox
+-- events, so the scheduler emits each event once. Existing discounts (NULL on
+-- products) are pending.
+ALTER TABLE products ADD COLUMN discount_phase STRING(10);
+
+ALTER TABLE product_discounts ADD COLUMN phase STRING(10) NOT NULL DEFAULT ('pending');
+
+CREATE INDEX idx_products_discount_phase ON products(discount_phase, discount_start_date);
+
+CREATE INDEX idx_product_discounts_phase ON product_discounts(phase, start_date);
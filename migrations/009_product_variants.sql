@@ -0,0 +1,19 @@
+-- Product variants
+
+-- Sellable versions of a product (size, colour, storage...). A variant without
+-- a price override sells at the product's base price, in the product's
+-- currency. Retired variants are kept so their SKUs stay reserved.
+CREATE TABLE product_variants (
+    product_id STRING(36) NOT NULL,
+    variant_id STRING(36) NOT NULL,
+    sku STRING(64) NOT NULL,
+    options JSON,
+    price_override_numerator INT64,
+    price_override_denominator INT64,
+    status STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, variant_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_product_variants_sku ON product_variants(sku);
@@ -0,0 +1,27 @@
+-- Product attributes
+
+-- Typed attributes the products of a category may carry. Values of string
+-- attributes with allowed values are restricted to those values.
+CREATE TABLE attribute_definitions (
+    category STRING(100) NOT NULL,
+    name STRING(64) NOT NULL,
+    attribute_type STRING(20) NOT NULL,
+    unit STRING(20),
+    required BOOL NOT NULL,
+    allowed_values ARRAY<STRING(MAX)>,
+) PRIMARY KEY (category, name);
+
+-- Attribute values of a product. value holds the canonical text of every
+-- value; number_value is also set for number attributes so ranges can be
+-- filtered numerically.
+CREATE TABLE product_attributes (
+    product_id STRING(36) NOT NULL,
+    name STRING(64) NOT NULL,
+    attribute_type STRING(20) NOT NULL,
+    value STRING(MAX) NOT NULL,
+    number_value NUMERIC,
+) PRIMARY KEY (product_id, name),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_attributes_value ON product_attributes(name, value);
+CREATE INDEX idx_product_attributes_number ON product_attributes(name, number_value);
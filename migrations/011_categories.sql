@@ -0,0 +1,27 @@
+-- Category taxonomy
+
+-- Categories form a tree. path lists the IDs from the root to the category,
+-- e.g. "/<root id>/<child id>/", so a subtree is selected by path prefix.
+-- name_key is the lower-cased, trimmed name; sibling names are unique by it.
+CREATE TABLE categories (
+    category_id STRING(36) NOT NULL,
+    name STRING(100) NOT NULL,
+    name_key STRING(100) NOT NULL,
+    parent_id STRING(36),
+    path STRING(MAX) NOT NULL,
+    status STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    archived_at TIMESTAMP,
+    version INT64 NOT NULL DEFAULT (0),
+) PRIMARY KEY (category_id);
+
+-- Root categories have a NULL parent_id, which the unique index treats as one value
+CREATE UNIQUE INDEX idx_categories_sibling_name ON categories(parent_id, name_key);
+CREATE INDEX idx_categories_path ON categories(path);
+
+-- products.category now holds a category ID. Products created before this
+-- migration hold free-text category names; after applying it, run
+-- `backfill categories` (cmd/backfill) to create the matching root categories,
+-- point products.category at their IDs and move attribute definitions keyed
+-- by the names.
@@ -0,0 +1,16 @@
+-- Product stock
+
+-- One stock record per product. available = on_hand - reserved; a product
+-- without a stock record has nothing available. A product is reported as
+-- low stock when available is at or below low_stock_threshold.
+CREATE TABLE product_stock (
+    product_id STRING(36) NOT NULL,
+    on_hand INT64 NOT NULL,
+    reserved INT64 NOT NULL,
+    low_stock_threshold INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INT64 NOT NULL DEFAULT (0),
+    CONSTRAINT ck_product_stock_levels CHECK (reserved >= 0 AND reserved <= on_hand),
+    CONSTRAINT ck_product_stock_threshold CHECK (low_stock_threshold >= 0),
+) PRIMARY KEY (product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,24 @@
+-- Product bundles
+
+-- A bundle is a product sold as a set of other products. Its price is the
+-- override if set, otherwise the sum of the components' effective prices less
+-- the percentage or fixed-amount bundle discount, if any. The bundle's own
+-- base_price holds the price derived when the bundle was last defined.
+CREATE TABLE product_bundles (
+    product_id STRING(36) NOT NULL,
+    discount_percent NUMERIC,
+    discount_amount_numerator INT64,
+    discount_amount_denominator INT64,
+    price_override_numerator INT64,
+    price_override_denominator INT64,
+) PRIMARY KEY (product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+-- Component products of a bundle and the units of each one bundle contains
+CREATE TABLE bundle_components (
+    product_id STRING(36) NOT NULL,
+    component_product_id STRING(36) NOT NULL,
+    quantity INT64 NOT NULL,
+    CONSTRAINT ck_bundle_components_quantity CHECK (quantity > 0),
+) PRIMARY KEY (product_id, component_product_id),
+  INTERLEAVE IN PARENT product_bundles ON DELETE CASCADE;
@@ -0,0 +1,14 @@
+-- Quantity price tiers
+
+-- Unit prices for buying at least min_quantity units of a product at once, in
+-- the product's currency. Quantities below the lowest tier sell at the base
+-- price. Tier prices never rise as the minimum quantity grows, and the
+-- product's discount applies on top of the tier price.
+CREATE TABLE product_price_tiers (
+    product_id STRING(36) NOT NULL,
+    min_quantity INT64 NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+    CONSTRAINT ck_product_price_tiers_min_quantity CHECK (min_quantity > 1),
+) PRIMARY KEY (product_id, min_quantity),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
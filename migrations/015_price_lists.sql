@@ -0,0 +1,32 @@
+-- Price lists
+
+-- A price list replaces products' default base prices for a sales channel,
+-- a customer segment or both, within its validity window. valid_to is NULL
+-- for open-ended lists. Discounts and price tiers still apply on top of the
+-- list price.
+CREATE TABLE price_lists (
+    price_list_id STRING(36) NOT NULL,
+    name STRING(100) NOT NULL,
+    currency STRING(3) NOT NULL,
+    channel STRING(50),
+    segment STRING(50),
+    valid_from TIMESTAMP NOT NULL,
+    valid_to TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INT64 NOT NULL DEFAULT (0),
+    CONSTRAINT ck_price_lists_target CHECK (channel IS NOT NULL OR segment IS NOT NULL),
+) PRIMARY KEY (price_list_id);
+
+-- The price of a product in a list, in the list's currency. Products without
+-- an entry sell at their default price.
+CREATE TABLE price_list_entries (
+    price_list_id STRING(36) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (price_list_id, product_id),
+  INTERLEAVE IN PARENT price_lists ON DELETE CASCADE;
+
+CREATE INDEX idx_price_list_entries_product ON price_list_entries(product_id);
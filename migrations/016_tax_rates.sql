@@ -0,0 +1,17 @@
+-- Tax classes and rates
+
+-- A product's tax class selects its tax rate in each region, e.g. "standard",
+-- "reduced" or "zero". Existing products use the standard class.
+ALTER TABLE products ADD COLUMN tax_class STRING(50) NOT NULL DEFAULT ('standard');
+
+-- The tax rate levied on a tax class in a region, as an exact percentage of
+-- the net price, e.g. 19 for 19% VAT. Regions are ISO 3166 country codes with
+-- an optional subdivision, e.g. "DE" or "US-CA". Prices stored on products
+-- and in price lists are net of tax.
+CREATE TABLE tax_rates (
+    region STRING(10) NOT NULL,
+    tax_class STRING(50) NOT NULL,
+    rate_percent NUMERIC NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT ck_tax_rates_rate CHECK (rate_percent >= 0 AND rate_percent <= 100),
+) PRIMARY KEY (region, tax_class);
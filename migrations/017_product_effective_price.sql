@@ -0,0 +1,14 @@
+-- Materialised effective price
+
+-- The product's effective price at its last write: the base price less the
+-- applied or scheduled discount active then. Writers refresh it whenever the
+-- base price or a discount changes, and the discount lifecycle scheduler
+-- writes the product when a discount window starts or ends, so listings can
+-- sort and filter by it. Existing products start at zero until
+-- `go run ./cmd/backfill effective-prices` stores their price with the
+-- discount active at that time.
+ALTER TABLE products ADD COLUMN effective_price NUMERIC NOT NULL DEFAULT (0);
+
+CREATE INDEX idx_products_effective_price ON products(effective_price);
+
+CREATE INDEX idx_products_name ON products(name);
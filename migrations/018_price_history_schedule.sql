@@ -0,0 +1,17 @@
+-- Scheduled discounts in price history
+
+-- The scheduled discount windows of a price history row, so that history
+-- reports scheduled promotions. Windows of a row never overlap, so their start
+-- date identifies them. Rows recorded before this table existed have no
+-- scheduled windows.
+CREATE TABLE product_price_history_discounts (
+    product_id STRING(36) NOT NULL,
+    effective_from TIMESTAMP NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    discount_kind STRING(20) NOT NULL,
+    discount_percent NUMERIC,
+    discount_amount_numerator INT64,
+    discount_amount_denominator INT64,
+) PRIMARY KEY (product_id, effective_from, start_date),
+  INTERLEAVE IN PARENT product_price_history ON DELETE CASCADE;
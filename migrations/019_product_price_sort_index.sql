@@ -0,0 +1,7 @@
+-- Sorting by effective price within a currency
+
+-- Listings sorted by effective price group products by currency first, as
+-- amounts in different currencies do not compare.
+DROP INDEX idx_products_effective_price;
+
+CREATE INDEX idx_products_currency_effective_price ON products(currency, effective_price);
@@ -0,0 +1,18 @@
+-- Product full-text search
+
+-- Spanner search indexes are not supported by the emulator, so this migration
+-- lives outside the migrations applied there and is applied to production
+-- databases only; on the emulator SearchProducts searches in process instead.
+
+-- Hidden token lists of the searched text; Spanner keeps them and the search
+-- index up to date as products are written, and backfills existing products
+-- when the index is created.
+ALTER TABLE products ADD COLUMN name_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(name)) HIDDEN;
+
+ALTER TABLE products ADD COLUMN description_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(description)) HIDDEN;
+
+ALTER TABLE products ADD COLUMN search_tokens TOKENLIST AS (TOKENLIST_CONCAT([TOKENIZE_FULLTEXT(name), TOKENIZE_FULLTEXT(description)])) HIDDEN;
+
+-- Stores the columns listings filter by, so filtered searches stay in the index
+CREATE SEARCH INDEX idx_products_search ON products(search_tokens, name_tokens, description_tokens)
+STORING (category, status, currency, effective_price);
@@ -6,14 +6,19 @@ package productv1
 // Message stubs
 
 type Money struct {
-	Numerator   int64 `json:"numerator,omitempty"`
-	Denominator int64 `json:"denominator,omitempty"`
+	Numerator    int64  `json:"numerator,omitempty"`
+	Denominator  int64  `json:"denominator,omitempty"`
+	CurrencyCode string `json:"currency_code,omitempty"`
+	Decimal      string `json:"decimal,omitempty"`
 }
 
 type Discount struct {
 	Percent         int64 `json:"percent,omitempty"`
 	StartDateSeconds int64 `json:"start_date_seconds,omitempty"`
 	EndDateSeconds   int64 `json:"end_date_seconds,omitempty"`
+	Kind             string `json:"kind,omitempty"`
+	Amount           *Money `json:"amount,omitempty"`
+	PercentExact     string `json:"percent_exact,omitempty"`
 }
 
 type Product struct {
@@ -27,6 +32,16 @@ type Product struct {
 	Status           string     `json:"status,omitempty"`
 	CreatedAtSeconds int64       `json:"created_at_seconds,omitempty"`
 	UpdatedAtSeconds int64       `json:"updated_at_seconds,omitempty"`
+	Variants         []*Variant  `json:"variants,omitempty"`
+	Attributes       []*Attribute `json:"attributes,omitempty"`
+	Availability      string `json:"availability,omitempty"`
+	AvailableQuantity int64  `json:"available_quantity,omitempty"`
+	ProductType       string  `json:"product_type,omitempty"`
+	Bundle            *Bundle `json:"bundle,omitempty"`
+	PriceTiers        []*PriceTier `json:"price_tiers,omitempty"`
+	PriceListId       string       `json:"price_list_id,omitempty"`
+	TaxClass          string       `json:"tax_class,omitempty"`
+	Tax               *Tax         `json:"tax,omitempty"`
 }
 
 func (x *Product) GetBasePrice() *Money {
@@ -44,12 +59,102 @@ func (x *Product) GetDiscount() *Discount {
 	return nil
 }
 
+func (x *Product) GetVariants() []*Variant {
+	if x != nil { return x.Variants }
+	return nil
+}
+
+func (x *Product) GetAttributes() []*Attribute {
+	if x != nil { return x.Attributes }
+	return nil
+}
+
+func (x *Product) GetBundle() *Bundle {
+	if x != nil { return x.Bundle }
+	return nil
+}
+
+type Bundle struct {
+	Components           []*BundleComponent `json:"components,omitempty"`
+	DiscountPercentExact string             `json:"discount_percent_exact,omitempty"`
+	DiscountAmount       *Money             `json:"discount_amount,omitempty"`
+	PriceOverride        *Money             `json:"price_override,omitempty"`
+}
+
+func (x *Bundle) GetComponents() []*BundleComponent {
+	if x != nil { return x.Components }
+	return nil
+}
+
+func (x *Bundle) GetDiscountAmount() *Money {
+	if x != nil { return x.DiscountAmount }
+	return nil
+}
+
+func (x *Bundle) GetPriceOverride() *Money {
+	if x != nil { return x.PriceOverride }
+	return nil
+}
+
+type BundleComponent struct {
+	ProductId string `json:"product_id,omitempty"`
+	Quantity  int64  `json:"quantity,omitempty"`
+}
+
+type Attribute struct {
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type AttributeDefinition struct {
+	Category      string   `json:"category,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	Unit          string   `json:"unit,omitempty"`
+	Required      bool     `json:"required,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+type Category struct {
+	CategoryId       string   `json:"category_id,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	ParentId         string   `json:"parent_id,omitempty"`
+	AncestorIds      []string `json:"ancestor_ids,omitempty"`
+	Status           string   `json:"status,omitempty"`
+	CreatedAtSeconds int64    `json:"created_at_seconds,omitempty"`
+	UpdatedAtSeconds int64    `json:"updated_at_seconds,omitempty"`
+}
+
+type Variant struct {
+	VariantId        string            `json:"variant_id,omitempty"`
+	Sku              string            `json:"sku,omitempty"`
+	Options          map[string]string `json:"options,omitempty"`
+	Price            *Money            `json:"price,omitempty"`
+	HasPriceOverride bool              `json:"has_price_override,omitempty"`
+	EffectivePrice   *Money            `json:"effective_price,omitempty"`
+	Status           string            `json:"status,omitempty"`
+	CreatedAtSeconds int64             `json:"created_at_seconds,omitempty"`
+	UpdatedAtSeconds int64             `json:"updated_at_seconds,omitempty"`
+}
+
+func (x *Variant) GetPrice() *Money {
+	if x != nil { return x.Price }
+	return nil
+}
+
+func (x *Variant) GetEffectivePrice() *Money {
+	if x != nil { return x.EffectivePrice }
+	return nil
+}
+
 type CreateProductRequest struct {
 	Name                 string `json:"name,omitempty"`
 	Description          string `json:"description,omitempty"`
 	Category             string `json:"category,omitempty"`
 	BasePriceNumerator   int64  `json:"base_price_numerator,omitempty"`
 	BasePriceDenominator int64  `json:"base_price_denominator,omitempty"`
+	CurrencyCode         string `json:"currency_code,omitempty"`
 }
 
 type CreateProductReply struct {
@@ -61,6 +166,18 @@ type UpdateProductRequest struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
 	Category    string `json:"category,omitempty"`
+	SetAttributes   map[string]string `json:"set_attributes,omitempty"`
+	ClearAttributes []string          `json:"clear_attributes,omitempty"`
+}
+
+func (x *UpdateProductRequest) GetSetAttributes() map[string]string {
+	if x != nil { return x.SetAttributes }
+	return nil
+}
+
+func (x *UpdateProductRequest) GetClearAttributes() []string {
+	if x != nil { return x.ClearAttributes }
+	return nil
 }
 
 type UpdateProductReply struct{}
@@ -78,10 +195,18 @@ type DeactivateProductRequest struct {
 type DeactivateProductReply struct{}
 
 type ApplyDiscountRequest struct {
-	ProductId         string `json:"product_id,omitempty"`
-	DiscountPercent   int64  `json:"discount_percent,omitempty"`
-	StartDateSeconds  int64  `json:"start_date_seconds,omitempty"`
-	EndDateSeconds    int64  `json:"end_date_seconds,omitempty"`
+	ProductId            string `json:"product_id,omitempty"`
+	DiscountPercent      int64  `json:"discount_percent,omitempty"`
+	StartDateSeconds     int64  `json:"start_date_seconds,omitempty"`
+	EndDateSeconds       int64  `json:"end_date_seconds,omitempty"`
+	DiscountKind         string `json:"discount_kind,omitempty"`
+	DiscountAmount       *Money `json:"discount_amount,omitempty"`
+	DiscountPercentExact string `json:"discount_percent_exact,omitempty"`
+}
+
+func (x *ApplyDiscountRequest) GetDiscountAmount() *Money {
+	if x != nil { return x.DiscountAmount }
+	return nil
 }
 
 type ApplyDiscountReply struct{}
@@ -98,8 +223,541 @@ type ArchiveProductRequest struct {
 
 type ArchiveProductReply struct{}
 
-type GetProductRequest struct {
+type ChangePriceRequest struct {
+	ProductId            string `json:"product_id,omitempty"`
+	BasePriceNumerator   int64  `json:"base_price_numerator,omitempty"`
+	BasePriceDenominator int64  `json:"base_price_denominator,omitempty"`
+	ReasonCode           string `json:"reason_code,omitempty"`
+	CurrencyCode         string `json:"currency_code,omitempty"`
+}
+
+type ChangePriceReply struct{}
+
+type ScheduleDiscountRequest struct {
+	ProductId            string `json:"product_id,omitempty"`
+	DiscountKind         string `json:"discount_kind,omitempty"`
+	DiscountPercentExact string `json:"discount_percent_exact,omitempty"`
+	DiscountAmount       *Money `json:"discount_amount,omitempty"`
+	StartDateSeconds     int64  `json:"start_date_seconds,omitempty"`
+	EndDateSeconds       int64  `json:"end_date_seconds,omitempty"`
+}
+
+func (x *ScheduleDiscountRequest) GetDiscountAmount() *Money {
+	if x != nil { return x.DiscountAmount }
+	return nil
+}
+
+type ScheduleDiscountReply struct {
+	DiscountId string `json:"discount_id,omitempty"`
+}
+
+type CancelScheduledDiscountRequest struct {
+	ProductId  string `json:"product_id,omitempty"`
+	DiscountId string `json:"discount_id,omitempty"`
+}
+
+type CancelScheduledDiscountReply struct{}
+
+type AddVariantRequest struct {
+	ProductId     string            `json:"product_id,omitempty"`
+	Sku           string            `json:"sku,omitempty"`
+	Options       map[string]string `json:"options,omitempty"`
+	PriceOverride *Money            `json:"price_override,omitempty"`
+}
+
+func (x *AddVariantRequest) GetPriceOverride() *Money {
+	if x != nil { return x.PriceOverride }
+	return nil
+}
+
+type AddVariantReply struct {
+	VariantId string `json:"variant_id,omitempty"`
+}
+
+type UpdateVariantRequest struct {
+	ProductId     string            `json:"product_id,omitempty"`
+	VariantId     string            `json:"variant_id,omitempty"`
+	Sku           string            `json:"sku,omitempty"`
+	Options       map[string]string `json:"options,omitempty"`
+	PriceOverride *Money            `json:"price_override,omitempty"`
+}
+
+func (x *UpdateVariantRequest) GetPriceOverride() *Money {
+	if x != nil { return x.PriceOverride }
+	return nil
+}
+
+type UpdateVariantReply struct{}
+
+type RetireVariantRequest struct {
+	ProductId string `json:"product_id,omitempty"`
+	VariantId string `json:"variant_id,omitempty"`
+}
+
+type RetireVariantReply struct{}
+
+type DefineAttributeRequest struct {
+	Category      string   `json:"category,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	Unit          string   `json:"unit,omitempty"`
+	Required      bool     `json:"required,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+type DefineAttributeReply struct{}
+
+type RemoveAttributeRequest struct {
+	Category string `json:"category,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+type RemoveAttributeReply struct{}
+
+type CreateCategoryRequest struct {
+	Name     string `json:"name,omitempty"`
+	ParentId string `json:"parent_id,omitempty"`
+}
+
+type CreateCategoryReply struct {
+	CategoryId string `json:"category_id,omitempty"`
+}
+
+type UpdateCategoryRequest struct {
+	CategoryId string `json:"category_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+type UpdateCategoryReply struct{}
+
+type MoveCategoryRequest struct {
+	CategoryId string `json:"category_id,omitempty"`
+	ParentId   string `json:"parent_id,omitempty"`
+}
+
+type MoveCategoryReply struct{}
+
+type ArchiveCategoryRequest struct {
+	CategoryId string `json:"category_id,omitempty"`
+}
+
+type ArchiveCategoryReply struct{}
+
+type ReserveStockRequest struct {
+	ProductId string `json:"product_id,omitempty"`
+	Quantity  int64  `json:"quantity,omitempty"`
+}
+
+type ReserveStockReply struct {
+	OnHand   int64 `json:"on_hand,omitempty"`
+	Reserved int64 `json:"reserved,omitempty"`
+}
+
+type ReleaseStockRequest struct {
+	ProductId string `json:"product_id,omitempty"`
+	Quantity  int64  `json:"quantity,omitempty"`
+}
+
+type ReleaseStockReply struct {
+	OnHand   int64 `json:"on_hand,omitempty"`
+	Reserved int64 `json:"reserved,omitempty"`
+}
+
+type AdjustStockRequest struct {
+	ProductId  string `json:"product_id,omitempty"`
+	Delta      int64  `json:"delta,omitempty"`
+	ReasonCode string `json:"reason_code,omitempty"`
+}
+
+type AdjustStockReply struct {
+	OnHand   int64 `json:"on_hand,omitempty"`
+	Reserved int64 `json:"reserved,omitempty"`
+}
+
+type SetStockThresholdRequest struct {
+	ProductId         string `json:"product_id,omitempty"`
+	LowStockThreshold int64  `json:"low_stock_threshold,omitempty"`
+}
+
+type SetStockThresholdReply struct{}
+
+type CreateBundleRequest struct {
+	Name                 string             `json:"name,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Category             string             `json:"category,omitempty"`
+	Components           []*BundleComponent `json:"components,omitempty"`
+	DiscountPercentExact string             `json:"discount_percent_exact,omitempty"`
+	DiscountAmount       *Money             `json:"discount_amount,omitempty"`
+	PriceOverride        *Money             `json:"price_override,omitempty"`
+}
+
+func (x *CreateBundleRequest) GetComponents() []*BundleComponent {
+	if x != nil { return x.Components }
+	return nil
+}
+
+func (x *CreateBundleRequest) GetDiscountAmount() *Money {
+	if x != nil { return x.DiscountAmount }
+	return nil
+}
+
+func (x *CreateBundleRequest) GetPriceOverride() *Money {
+	if x != nil { return x.PriceOverride }
+	return nil
+}
+
+type CreateBundleReply struct {
+	ProductId string `json:"product_id,omitempty"`
+}
+
+type UpdateBundleRequest struct {
+	ProductId            string             `json:"product_id,omitempty"`
+	Components           []*BundleComponent `json:"components,omitempty"`
+	DiscountPercentExact string             `json:"discount_percent_exact,omitempty"`
+	DiscountAmount       *Money             `json:"discount_amount,omitempty"`
+	PriceOverride        *Money             `json:"price_override,omitempty"`
+}
+
+func (x *UpdateBundleRequest) GetComponents() []*BundleComponent {
+	if x != nil { return x.Components }
+	return nil
+}
+
+func (x *UpdateBundleRequest) GetDiscountAmount() *Money {
+	if x != nil { return x.DiscountAmount }
+	return nil
+}
+
+func (x *UpdateBundleRequest) GetPriceOverride() *Money {
+	if x != nil { return x.PriceOverride }
+	return nil
+}
+
+type UpdateBundleReply struct{}
+
+type SetPriceTiersRequest struct {
+	ProductId string       `json:"product_id,omitempty"`
+	Tiers     []*PriceTier `json:"tiers,omitempty"`
+}
+
+func (x *SetPriceTiersRequest) GetTiers() []*PriceTier {
+	if x != nil { return x.Tiers }
+	return nil
+}
+
+type SetPriceTiersReply struct{}
+
+type CreatePriceListRequest struct {
+	Name             string `json:"name,omitempty"`
+	CurrencyCode     string `json:"currency_code,omitempty"`
+	Channel          string `json:"channel,omitempty"`
+	Segment          string `json:"segment,omitempty"`
+	ValidFromSeconds int64  `json:"valid_from_seconds,omitempty"`
+	ValidToSeconds   int64  `json:"valid_to_seconds,omitempty"`
+}
+
+type CreatePriceListReply struct {
+	PriceListId          string `json:"price_list_id,omitempty"`
+}
+
+type SetListPriceRequest struct {
+	PriceListId string `json:"price_list_id,omitempty"`
+	ProductId   string `json:"product_id,omitempty"`
+	Price       *Money `json:"price,omitempty"`
+}
+
+func (x *SetListPriceRequest) GetPrice() *Money {
+	if x != nil { return x.Price }
+	return nil
+}
+
+type SetListPriceReply struct{}
+
+type RemoveListPriceRequest struct {
+	PriceListId string `json:"price_list_id,omitempty"`
+	ProductId   string `json:"product_id,omitempty"`
+}
+
+type RemoveListPriceReply struct{}
+
+type SetTaxClassRequest struct {
+	ProductId string `json:"product_id,omitempty"`
+	TaxClass  string `json:"tax_class,omitempty"`
+}
+
+type SetTaxClassReply struct{}
+
+type SetTaxRateRequest struct {
+	Region           string `json:"region,omitempty"`
+	TaxClass         string `json:"tax_class,omitempty"`
+	RatePercentExact string `json:"rate_percent_exact,omitempty"`
+}
+
+type SetTaxRateReply struct{}
+
+type Tax struct {
+	Region           string `json:"region,omitempty"`
+	TaxClass         string `json:"tax_class,omitempty"`
+	RatePercentExact string `json:"rate_percent_exact,omitempty"`
+	Net              *Money `json:"net,omitempty"`
+	Tax              *Money `json:"tax,omitempty"`
+	Gross            *Money `json:"gross,omitempty"`
+}
+
+func (x *Tax) GetNet() *Money {
+	if x != nil { return x.Net }
+	return nil
+}
+
+func (x *Tax) GetTax() *Money {
+	if x != nil { return x.Tax }
+	return nil
+}
+
+func (x *Tax) GetGross() *Money {
+	if x != nil { return x.Gross }
+	return nil
+}
+
+type PriceTier struct {
+	MinQuantity    int64  `json:"min_quantity,omitempty"`
+	Price          *Money `json:"price,omitempty"`
+	EffectivePrice *Money `json:"effective_price,omitempty"`
+}
+
+func (x *PriceTier) GetPrice() *Money {
+	if x != nil { return x.Price }
+	return nil
+}
+
+func (x *PriceTier) GetEffectivePrice() *Money {
+	if x != nil { return x.EffectivePrice }
+	return nil
+}
+
+type GetPriceRequest struct {
+	ProductId   string `json:"product_id,omitempty"`
+	Quantity    int64  `json:"quantity,omitempty"`
+	AsOfSeconds int64  `json:"as_of_seconds,omitempty"`
+	PriceListId string `json:"price_list_id,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type GetPriceReply struct {
+	ProductId          string    `json:"product_id,omitempty"`
+	Quantity           int64     `json:"quantity,omitempty"`
+	TierMinQuantity    int64     `json:"tier_min_quantity,omitempty"`
+	UnitPrice          *Money    `json:"unit_price,omitempty"`
+	EffectiveUnitPrice *Money    `json:"effective_unit_price,omitempty"`
+	LineTotal          *Money    `json:"line_total,omitempty"`
+	Discount           *Discount `json:"discount,omitempty"`
+	Tax                *Tax      `json:"tax,omitempty"`
+}
+
+func (x *GetPriceReply) GetUnitPrice() *Money {
+	if x != nil { return x.UnitPrice }
+	return nil
+}
+
+func (x *GetPriceReply) GetEffectiveUnitPrice() *Money {
+	if x != nil { return x.EffectiveUnitPrice }
+	return nil
+}
+
+func (x *GetPriceReply) GetLineTotal() *Money {
+	if x != nil { return x.LineTotal }
+	return nil
+}
+
+func (x *GetPriceReply) GetDiscount() *Discount {
+	if x != nil { return x.Discount }
+	return nil
+}
+
+type QuotePricesRequest struct {
+	Items       []*QuoteItem `json:"items,omitempty"`
+	AsOfSeconds int64        `json:"as_of_seconds,omitempty"`
+	PriceListId string       `json:"price_list_id,omitempty"`
+}
+
+func (x *QuotePricesRequest) GetItems() []*QuoteItem {
+	if x != nil { return x.Items }
+	return nil
+}
+
+type QuoteItem struct {
 	ProductId string `json:"product_id,omitempty"`
+	Quantity  int64  `json:"quantity,omitempty"`
+}
+
+type QuotePricesReply struct {
+	Lines []*QuoteLine `json:"lines,omitempty"`
+	Total *Money       `json:"total,omitempty"`
+}
+
+func (x *QuotePricesReply) GetLines() []*QuoteLine {
+	if x != nil { return x.Lines }
+	return nil
+}
+
+func (x *QuotePricesReply) GetTotal() *Money {
+	if x != nil { return x.Total }
+	return nil
+}
+
+type QuoteLine struct {
+	ProductId          string    `json:"product_id,omitempty"`
+	Quantity           int64     `json:"quantity,omitempty"`
+	RejectionReason    string    `json:"rejection_reason,omitempty"`
+	UnitPrice          *Money    `json:"unit_price,omitempty"`
+	EffectiveUnitPrice *Money    `json:"effective_unit_price,omitempty"`
+	LineTotal          *Money    `json:"line_total,omitempty"`
+	Discount           *Discount `json:"discount,omitempty"`
+	TierMinQuantity    int64     `json:"tier_min_quantity,omitempty"`
+}
+
+func (x *QuoteLine) GetUnitPrice() *Money {
+	if x != nil { return x.UnitPrice }
+	return nil
+}
+
+func (x *QuoteLine) GetEffectiveUnitPrice() *Money {
+	if x != nil { return x.EffectiveUnitPrice }
+	return nil
+}
+
+func (x *QuoteLine) GetLineTotal() *Money {
+	if x != nil { return x.LineTotal }
+	return nil
+}
+
+func (x *QuoteLine) GetDiscount() *Discount {
+	if x != nil { return x.Discount }
+	return nil
+}
+
+type GetCatalogFacetsRequest struct {
+	Category             string             `json:"category,omitempty"`
+	IncludeSubcategories bool               `json:"include_subcategories,omitempty"`
+	AttributeFilters     []*AttributeFilter `json:"attribute_filters,omitempty"`
+	Statuses             []string           `json:"statuses,omitempty"`
+	IncludeArchived      bool               `json:"include_archived,omitempty"`
+	MinPrice             *Money             `json:"min_price,omitempty"`
+	MaxPrice             *Money             `json:"max_price,omitempty"`
+	AsOfSeconds          int64              `json:"as_of_seconds,omitempty"`
+	PriceBandBounds      []*Money           `json:"price_band_bounds,omitempty"`
+}
+
+func (x *GetCatalogFacetsRequest) GetAttributeFilters() []*AttributeFilter {
+	if x != nil { return x.AttributeFilters }
+	return nil
+}
+
+func (x *GetCatalogFacetsRequest) GetMinPrice() *Money {
+	if x != nil { return x.MinPrice }
+	return nil
+}
+
+func (x *GetCatalogFacetsRequest) GetMaxPrice() *Money {
+	if x != nil { return x.MaxPrice }
+	return nil
+}
+
+func (x *GetCatalogFacetsRequest) GetPriceBandBounds() []*Money {
+	if x != nil { return x.PriceBandBounds }
+	return nil
+}
+
+type GetCatalogFacetsReply struct {
+	Total             int64         `json:"total,omitempty"`
+	Categories        []*FacetCount `json:"categories,omitempty"`
+	Statuses          []*FacetCount `json:"statuses,omitempty"`
+	HasActiveDiscount []*FacetCount `json:"has_active_discount,omitempty"`
+	PriceBands        []*PriceBand  `json:"price_bands,omitempty"`
+}
+
+func (x *GetCatalogFacetsReply) GetCategories() []*FacetCount {
+	if x != nil { return x.Categories }
+	return nil
+}
+
+func (x *GetCatalogFacetsReply) GetStatuses() []*FacetCount {
+	if x != nil { return x.Statuses }
+	return nil
+}
+
+func (x *GetCatalogFacetsReply) GetHasActiveDiscount() []*FacetCount {
+	if x != nil { return x.HasActiveDiscount }
+	return nil
+}
+
+func (x *GetCatalogFacetsReply) GetPriceBands() []*PriceBand {
+	if x != nil { return x.PriceBands }
+	return nil
+}
+
+type FacetCount struct {
+	Value string `json:"value,omitempty"`
+	Count int64  `json:"count,omitempty"`
+}
+
+type PriceBand struct {
+	Min   *Money `json:"min,omitempty"`
+	Max   *Money `json:"max,omitempty"`
+	Count int64  `json:"count,omitempty"`
+}
+
+func (x *PriceBand) GetMin() *Money {
+	if x != nil { return x.Min }
+	return nil
+}
+
+func (x *PriceBand) GetMax() *Money {
+	if x != nil { return x.Max }
+	return nil
+}
+
+type SearchProductsRequest struct {
+	Query                string   `json:"query,omitempty"`
+	Category             string   `json:"category,omitempty"`
+	IncludeSubcategories bool     `json:"include_subcategories,omitempty"`
+	Statuses             []string `json:"statuses,omitempty"`
+	IncludeArchived      bool     `json:"include_archived,omitempty"`
+	PageSize             int32    `json:"page_size,omitempty"`
+	PageToken            string   `json:"page_token,omitempty"`
+	AsOfSeconds          int64    `json:"as_of_seconds,omitempty"`
+	PriceListId          string   `json:"price_list_id,omitempty"`
+	Region               string   `json:"region,omitempty"`
+}
+
+type SearchProductsReply struct {
+	Results       []*SearchResult `json:"results,omitempty"`
+	NextPageToken string          `json:"next_page_token,omitempty"`
+}
+
+func (x *SearchProductsReply) GetResults() []*SearchResult {
+	if x != nil { return x.Results }
+	return nil
+}
+
+type SearchResult struct {
+	Product              *Product `json:"product,omitempty"`
+	Score                float64  `json:"score,omitempty"`
+	NameHighlight        string   `json:"name_highlight,omitempty"`
+	DescriptionHighlight string   `json:"description_highlight,omitempty"`
+}
+
+func (x *SearchResult) GetProduct() *Product {
+	if x != nil { return x.Product }
+	return nil
+}
+
+type GetProductRequest struct {
+	ProductId       string `json:"product_id,omitempty"`
+	AsOfSeconds     int64  `json:"as_of_seconds,omitempty"`
+	ReadStoredState bool   `json:"read_stored_state,omitempty"`
+	PriceListId     string `json:"price_list_id,omitempty"`
+	Region          string `json:"region,omitempty"`
 }
 
 type GetProductReply struct {
@@ -112,9 +770,42 @@ func (x *GetProductReply) GetProduct() *Product {
 }
 
 type ListProductsRequest struct {
-	Category  string `json:"category,omitempty"`
-	PageSize  int32  `json:"page_size,omitempty"`
-	PageToken string `json:"page_token,omitempty"`
+	Category        string `json:"category,omitempty"`
+	PageSize        int32  `json:"page_size,omitempty"`
+	PageToken       string `json:"page_token,omitempty"`
+	AsOfSeconds     int64  `json:"as_of_seconds,omitempty"`
+	ReadStoredState bool   `json:"read_stored_state,omitempty"`
+	AttributeFilters []*AttributeFilter `json:"attribute_filters,omitempty"`
+	IncludeSubcategories bool `json:"include_subcategories,omitempty"`
+	PriceListId          string `json:"price_list_id,omitempty"`
+	Region               string `json:"region,omitempty"`
+	OrderBy              string `json:"order_by,omitempty"`
+	MinPrice             *Money `json:"min_price,omitempty"`
+	MaxPrice             *Money `json:"max_price,omitempty"`
+	Statuses             []string `json:"statuses,omitempty"`
+	IncludeArchived      bool     `json:"include_archived,omitempty"`
+}
+
+func (x *ListProductsRequest) GetAttributeFilters() []*AttributeFilter {
+	if x != nil { return x.AttributeFilters }
+	return nil
+}
+
+func (x *ListProductsRequest) GetMinPrice() *Money {
+	if x != nil { return x.MinPrice }
+	return nil
+}
+
+func (x *ListProductsRequest) GetMaxPrice() *Money {
+	if x != nil { return x.MaxPrice }
+	return nil
+}
+
+type AttributeFilter struct {
+	Name   string `json:"name,omitempty"`
+	Equals string `json:"equals,omitempty"`
+	Min    string `json:"min,omitempty"`
+	Max    string `json:"max,omitempty"`
 }
 
 type ListProductsReply struct {
@@ -126,3 +817,84 @@ func (x *ListProductsReply) GetProducts() []*Product {
 	if x != nil { return x.Products }
 	return nil
 }
+
+type GetPriceHistoryRequest struct {
+	ProductId   string `json:"product_id,omitempty"`
+	FromSeconds int64  `json:"from_seconds,omitempty"`
+	ToSeconds   int64  `json:"to_seconds,omitempty"`
+}
+
+type GetPriceHistoryReply struct {
+	Intervals []*PriceInterval `json:"intervals,omitempty"`
+}
+
+func (x *GetPriceHistoryReply) GetIntervals() []*PriceInterval {
+	if x != nil { return x.Intervals }
+	return nil
+}
+
+type PriceInterval struct {
+	StartSeconds   int64     `json:"start_seconds,omitempty"`
+	EndSeconds     int64     `json:"end_seconds,omitempty"`
+	BasePrice      *Money    `json:"base_price,omitempty"`
+	EffectivePrice *Money    `json:"effective_price,omitempty"`
+	Discount       *Discount `json:"discount,omitempty"`
+}
+
+type ListDiscountsRequest struct {
+	ProductId string `json:"product_id,omitempty"`
+}
+
+type ListDiscountsReply struct {
+	Discounts []*ScheduledDiscount `json:"discounts,omitempty"`
+}
+
+func (x *ListDiscountsReply) GetDiscounts() []*ScheduledDiscount {
+	if x != nil { return x.Discounts }
+	return nil
+}
+
+type ListAttributeDefinitionsRequest struct {
+	Category string `json:"category,omitempty"`
+}
+
+type ListAttributeDefinitionsReply struct {
+	Definitions []*AttributeDefinition `json:"definitions,omitempty"`
+}
+
+func (x *ListAttributeDefinitionsReply) GetDefinitions() []*AttributeDefinition {
+	if x != nil { return x.Definitions }
+	return nil
+}
+
+type GetCategoryRequest struct {
+	CategoryId string `json:"category_id,omitempty"`
+}
+
+type GetCategoryReply struct {
+	Category *Category `json:"category,omitempty"`
+}
+
+func (x *GetCategoryReply) GetCategory() *Category {
+	if x != nil { return x.Category }
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	ParentId        string `json:"parent_id,omitempty"`
+	IncludeArchived bool   `json:"include_archived,omitempty"`
+}
+
+type ListCategoriesReply struct {
+	Categories []*Category `json:"categories,omitempty"`
+}
+
+func (x *ListCategoriesReply) GetCategories() []*Category {
+	if x != nil { return x.Categories }
+	return nil
+}
+
+type ScheduledDiscount struct {
+	DiscountId string    `json:"discount_id,omitempty"`
+	Discount   *Discount `json:"discount,omitempty"`
+}
@@ -19,8 +19,41 @@ type ProductServiceClient interface {
 	ApplyDiscount(ctx context.Context, in *ApplyDiscountRequest, opts ...grpc.CallOption) (*ApplyDiscountReply, error)
 	RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountReply, error)
 	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error)
+	ChangePrice(ctx context.Context, in *ChangePriceRequest, opts ...grpc.CallOption) (*ChangePriceReply, error)
+	ScheduleDiscount(ctx context.Context, in *ScheduleDiscountRequest, opts ...grpc.CallOption) (*ScheduleDiscountReply, error)
+	CancelScheduledDiscount(ctx context.Context, in *CancelScheduledDiscountRequest, opts ...grpc.CallOption) (*CancelScheduledDiscountReply, error)
+	AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantReply, error)
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantReply, error)
+	RetireVariant(ctx context.Context, in *RetireVariantRequest, opts ...grpc.CallOption) (*RetireVariantReply, error)
+	DefineAttribute(ctx context.Context, in *DefineAttributeRequest, opts ...grpc.CallOption) (*DefineAttributeReply, error)
+	RemoveAttribute(ctx context.Context, in *RemoveAttributeRequest, opts ...grpc.CallOption) (*RemoveAttributeReply, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryReply, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryReply, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryReply, error)
+	ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*ArchiveCategoryReply, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockReply, error)
+	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockReply, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockReply, error)
+	SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*SetStockThresholdReply, error)
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleReply, error)
+	UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*UpdateBundleReply, error)
+	SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*SetPriceTiersReply, error)
+	CreatePriceList(ctx context.Context, in *CreatePriceListRequest, opts ...grpc.CallOption) (*CreatePriceListReply, error)
+	SetListPrice(ctx context.Context, in *SetListPriceRequest, opts ...grpc.CallOption) (*SetListPriceReply, error)
+	RemoveListPrice(ctx context.Context, in *RemoveListPriceRequest, opts ...grpc.CallOption) (*RemoveListPriceReply, error)
+	SetTaxClass(ctx context.Context, in *SetTaxClassRequest, opts ...grpc.CallOption) (*SetTaxClassReply, error)
+	SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateReply, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error)
+	ListDiscounts(ctx context.Context, in *ListDiscountsRequest, opts ...grpc.CallOption) (*ListDiscountsReply, error)
+	ListAttributeDefinitions(ctx context.Context, in *ListAttributeDefinitionsRequest, opts ...grpc.CallOption) (*ListAttributeDefinitionsReply, error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryReply, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesReply, error)
+	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceReply, error)
+	QuotePrices(ctx context.Context, in *QuotePricesRequest, opts ...grpc.CallOption) (*QuotePricesReply, error)
+	GetCatalogFacets(ctx context.Context, in *GetCatalogFacetsRequest, opts ...grpc.CallOption) (*GetCatalogFacetsReply, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsReply, error)
 }
 
 type productServiceClient struct {
@@ -80,6 +113,174 @@ func (c *productServiceClient) ArchiveProduct(ctx context.Context, in *ArchivePr
 	return out, nil
 }
 
+func (c *productServiceClient) ChangePrice(ctx context.Context, in *ChangePriceRequest, opts ...grpc.CallOption) (*ChangePriceReply, error) {
+	out := new(ChangePriceReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ChangePrice", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ScheduleDiscount(ctx context.Context, in *ScheduleDiscountRequest, opts ...grpc.CallOption) (*ScheduleDiscountReply, error) {
+	out := new(ScheduleDiscountReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ScheduleDiscount", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) CancelScheduledDiscount(ctx context.Context, in *CancelScheduledDiscountRequest, opts ...grpc.CallOption) (*CancelScheduledDiscountReply, error) {
+	out := new(CancelScheduledDiscountReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/CancelScheduledDiscount", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantReply, error) {
+	out := new(AddVariantReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/AddVariant", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantReply, error) {
+	out := new(UpdateVariantReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/UpdateVariant", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) RetireVariant(ctx context.Context, in *RetireVariantRequest, opts ...grpc.CallOption) (*RetireVariantReply, error) {
+	out := new(RetireVariantReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/RetireVariant", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) DefineAttribute(ctx context.Context, in *DefineAttributeRequest, opts ...grpc.CallOption) (*DefineAttributeReply, error) {
+	out := new(DefineAttributeReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/DefineAttribute", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveAttribute(ctx context.Context, in *RemoveAttributeRequest, opts ...grpc.CallOption) (*RemoveAttributeReply, error) {
+	out := new(RemoveAttributeReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/RemoveAttribute", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryReply, error) {
+	out := new(CreateCategoryReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/CreateCategory", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryReply, error) {
+	out := new(UpdateCategoryReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/UpdateCategory", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryReply, error) {
+	out := new(MoveCategoryReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/MoveCategory", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*ArchiveCategoryReply, error) {
+	out := new(ArchiveCategoryReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ArchiveCategory", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockReply, error) {
+	out := new(ReserveStockReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ReserveStock", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockReply, error) {
+	out := new(ReleaseStockReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ReleaseStock", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockReply, error) {
+	out := new(AdjustStockReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/AdjustStock", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*SetStockThresholdReply, error) {
+	out := new(SetStockThresholdReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/SetStockThreshold", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleReply, error) {
+	out := new(CreateBundleReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/CreateBundle", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*UpdateBundleReply, error) {
+	out := new(UpdateBundleReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/UpdateBundle", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*SetPriceTiersReply, error) {
+	out := new(SetPriceTiersReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/SetPriceTiers", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) CreatePriceList(ctx context.Context, in *CreatePriceListRequest, opts ...grpc.CallOption) (*CreatePriceListReply, error) {
+	out := new(CreatePriceListReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/CreatePriceList", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) SetListPrice(ctx context.Context, in *SetListPriceRequest, opts ...grpc.CallOption) (*SetListPriceReply, error) {
+	out := new(SetListPriceReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/SetListPrice", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveListPrice(ctx context.Context, in *RemoveListPriceRequest, opts ...grpc.CallOption) (*RemoveListPriceReply, error) {
+	out := new(RemoveListPriceReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/RemoveListPrice", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) SetTaxClass(ctx context.Context, in *SetTaxClassRequest, opts ...grpc.CallOption) (*SetTaxClassReply, error) {
+	out := new(SetTaxClassReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/SetTaxClass", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateReply, error) {
+	out := new(SetTaxRateReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/SetTaxRate", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
 func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error) {
 	out := new(GetProductReply)
 	err := c.cc.Invoke(ctx, "/product.v1.ProductService/GetProduct", in, out, opts...)
@@ -94,6 +295,69 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error) {
+	out := new(GetPriceHistoryReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/GetPriceHistory", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ListDiscounts(ctx context.Context, in *ListDiscountsRequest, opts ...grpc.CallOption) (*ListDiscountsReply, error) {
+	out := new(ListDiscountsReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ListDiscounts", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ListAttributeDefinitions(ctx context.Context, in *ListAttributeDefinitionsRequest, opts ...grpc.CallOption) (*ListAttributeDefinitionsReply, error) {
+	out := new(ListAttributeDefinitionsReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ListAttributeDefinitions", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryReply, error) {
+	out := new(GetCategoryReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/GetCategory", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesReply, error) {
+	out := new(ListCategoriesReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/ListCategories", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceReply, error) {
+	out := new(GetPriceReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/GetPrice", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) QuotePrices(ctx context.Context, in *QuotePricesRequest, opts ...grpc.CallOption) (*QuotePricesReply, error) {
+	out := new(QuotePricesReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/QuotePrices", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) GetCatalogFacets(ctx context.Context, in *GetCatalogFacetsRequest, opts ...grpc.CallOption) (*GetCatalogFacetsReply, error) {
+	out := new(GetCatalogFacetsReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/GetCatalogFacets", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsReply, error) {
+	out := new(SearchProductsReply)
+	err := c.cc.Invoke(ctx, "/product.v1.ProductService/SearchProducts", in, out, opts...)
+	if err != nil { return nil, err }
+	return out, nil
+}
+
 type ProductServiceServer interface {
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductReply, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductReply, error)
@@ -102,8 +366,41 @@ type ProductServiceServer interface {
 	ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error)
 	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error)
 	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error)
+	ChangePrice(context.Context, *ChangePriceRequest) (*ChangePriceReply, error)
+	ScheduleDiscount(context.Context, *ScheduleDiscountRequest) (*ScheduleDiscountReply, error)
+	CancelScheduledDiscount(context.Context, *CancelScheduledDiscountRequest) (*CancelScheduledDiscountReply, error)
+	AddVariant(context.Context, *AddVariantRequest) (*AddVariantReply, error)
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantReply, error)
+	RetireVariant(context.Context, *RetireVariantRequest) (*RetireVariantReply, error)
+	DefineAttribute(context.Context, *DefineAttributeRequest) (*DefineAttributeReply, error)
+	RemoveAttribute(context.Context, *RemoveAttributeRequest) (*RemoveAttributeReply, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryReply, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryReply, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryReply, error)
+	ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*ArchiveCategoryReply, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockReply, error)
+	ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockReply, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockReply, error)
+	SetStockThreshold(context.Context, *SetStockThresholdRequest) (*SetStockThresholdReply, error)
+	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleReply, error)
+	UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleReply, error)
+	SetPriceTiers(context.Context, *SetPriceTiersRequest) (*SetPriceTiersReply, error)
+	CreatePriceList(context.Context, *CreatePriceListRequest) (*CreatePriceListReply, error)
+	SetListPrice(context.Context, *SetListPriceRequest) (*SetListPriceReply, error)
+	RemoveListPrice(context.Context, *RemoveListPriceRequest) (*RemoveListPriceReply, error)
+	SetTaxClass(context.Context, *SetTaxClassRequest) (*SetTaxClassReply, error)
+	SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateReply, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error)
+	ListDiscounts(context.Context, *ListDiscountsRequest) (*ListDiscountsReply, error)
+	ListAttributeDefinitions(context.Context, *ListAttributeDefinitionsRequest) (*ListAttributeDefinitionsReply, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryReply, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesReply, error)
+	GetPrice(context.Context, *GetPriceRequest) (*GetPriceReply, error)
+	QuotePrices(context.Context, *QuotePricesRequest) (*QuotePricesReply, error)
+	GetCatalogFacets(context.Context, *GetCatalogFacetsRequest) (*GetCatalogFacetsReply, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsReply, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -130,12 +427,111 @@ func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *Remove
 func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProduct not implemented")
 }
+func (UnimplementedProductServiceServer) ChangePrice(context.Context, *ChangePriceRequest) (*ChangePriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePrice not implemented")
+}
+func (UnimplementedProductServiceServer) ScheduleDiscount(context.Context, *ScheduleDiscountRequest) (*ScheduleDiscountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) CancelScheduledDiscount(context.Context, *CancelScheduledDiscountRequest) (*CancelScheduledDiscountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) AddVariant(context.Context, *AddVariantRequest) (*AddVariantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) RetireVariant(context.Context, *RetireVariantRequest) (*RetireVariantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireVariant not implemented")
+}
+func (UnimplementedProductServiceServer) DefineAttribute(context.Context, *DefineAttributeRequest) (*DefineAttributeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveAttribute(context.Context, *RemoveAttributeRequest) (*RemoveAttributeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedProductServiceServer) ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*ArchiveCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCategory not implemented")
+}
+func (UnimplementedProductServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedProductServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedProductServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedProductServiceServer) SetStockThreshold(context.Context, *SetStockThresholdRequest) (*SetStockThresholdReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockThreshold not implemented")
+}
+func (UnimplementedProductServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBundle not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceTiers(context.Context, *SetPriceTiersRequest) (*SetPriceTiersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceTiers not implemented")
+}
+func (UnimplementedProductServiceServer) CreatePriceList(context.Context, *CreatePriceListRequest) (*CreatePriceListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceList not implemented")
+}
+func (UnimplementedProductServiceServer) SetListPrice(context.Context, *SetListPriceRequest) (*SetListPriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetListPrice not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveListPrice(context.Context, *RemoveListPriceRequest) (*RemoveListPriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListPrice not implemented")
+}
+func (UnimplementedProductServiceServer) SetTaxClass(context.Context, *SetTaxClassRequest) (*SetTaxClassReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxClass not implemented")
+}
+func (UnimplementedProductServiceServer) SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxRate not implemented")
+}
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
 func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedProductServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) ListDiscounts(context.Context, *ListDiscountsRequest) (*ListDiscountsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiscounts not implemented")
+}
+func (UnimplementedProductServiceServer) ListAttributeDefinitions(context.Context, *ListAttributeDefinitionsRequest) (*ListAttributeDefinitionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttributeDefinitions not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServiceServer) GetPrice(context.Context, *GetPriceRequest) (*GetPriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+}
+func (UnimplementedProductServiceServer) QuotePrices(context.Context, *QuotePricesRequest) (*QuotePricesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrices not implemented")
+}
+func (UnimplementedProductServiceServer) GetCatalogFacets(context.Context, *GetCatalogFacetsRequest) (*GetCatalogFacetsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogFacets not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 
 func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
@@ -7,22 +7,59 @@ package e2e
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"product-catalog-service/internal/app/product/activate_product"
-	"product-catalog-service/internal/app/product/apply_discount"
-	"product-catalog-service/internal/app/product/create_product"
-	"product-catalog-service/internal/app/product/deactivate_product"
+	"product-catalog-service/internal/app/product/contracts"
 	"product-catalog-service/internal/app/product/domain"
-	"product-catalog-service/internal/app/product/get_product"
-	"product-catalog-service/internal/app/product/list_products"
+	"product-catalog-service/internal/app/product/domain/services"
+	"product-catalog-service/internal/app/product/queries/get_catalog_facets"
+	"product-catalog-service/internal/app/product/queries/get_category"
+	"product-catalog-service/internal/app/product/queries/get_price"
+	"product-catalog-service/internal/app/product/queries/get_price_history"
+	"product-catalog-service/internal/app/product/queries/get_product"
+	"product-catalog-service/internal/app/product/queries/list_discounts"
+	"product-catalog-service/internal/app/product/queries/list_products"
+	"product-catalog-service/internal/app/product/queries/quote_prices"
+	"product-catalog-service/internal/app/product/queries/search_products"
 	"product-catalog-service/internal/app/product/repo"
+	"product-catalog-service/internal/app/product/usecases/activate_product"
+	"product-catalog-service/internal/app/product/usecases/add_variant"
+	"product-catalog-service/internal/app/product/usecases/adjust_stock"
+	"product-catalog-service/internal/app/product/usecases/advance_discount_lifecycle"
+	"product-catalog-service/internal/app/product/usecases/apply_discount"
+	"product-catalog-service/internal/app/product/usecases/archive_category"
+	"product-catalog-service/internal/app/product/usecases/archive_product"
+	"product-catalog-service/internal/app/product/usecases/cancel_scheduled_discount"
+	"product-catalog-service/internal/app/product/usecases/change_price"
+	"product-catalog-service/internal/app/product/usecases/create_bundle"
+	"product-catalog-service/internal/app/product/usecases/create_category"
+	"product-catalog-service/internal/app/product/usecases/create_price_list"
+	"product-catalog-service/internal/app/product/usecases/create_product"
+	"product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"product-catalog-service/internal/app/product/usecases/define_attribute"
+	"product-catalog-service/internal/app/product/usecases/move_category"
+	"product-catalog-service/internal/app/product/usecases/release_stock"
+	"product-catalog-service/internal/app/product/usecases/remove_list_price"
+	"product-catalog-service/internal/app/product/usecases/reserve_stock"
+	"product-catalog-service/internal/app/product/usecases/retire_variant"
+	"product-catalog-service/internal/app/product/usecases/schedule_discount"
+	"product-catalog-service/internal/app/product/usecases/set_list_price"
+	"product-catalog-service/internal/app/product/usecases/set_price_tiers"
+	"product-catalog-service/internal/app/product/usecases/set_stock_threshold"
+	"product-catalog-service/internal/app/product/usecases/set_tax_class"
+	"product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"product-catalog-service/internal/app/product/usecases/update_bundle"
+	"product-catalog-service/internal/app/product/usecases/update_product"
+	"product-catalog-service/internal/app/product/usecases/update_variant"
 	"product-catalog-service/internal/pkg/clock"
+	"product-catalog-service/internal/pkg/commitplan"
 	"product-catalog-service/internal/pkg/committer"
 )
 
@@ -59,13 +96,15 @@ func TestProductCreationFlow(t *testing.T) {
 	outboxRepo := repo.NewOutboxRepo(client)
 
 	// Create usecase
-	createProduct := create_product.NewInteractor(productRepo, outboxRepo, committer, clk)
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+
+	category := createTestCategory(t, ctx, client, clk, "Electronics")
 
 	// Test: Create product
 	req := create_product.Request{
 		Name:                 "Test Product",
 		Description:          "A test product",
-		Category:             "electronics",
+		Category:             category,
 		BasePriceNumerator:   1999,
 		BasePriceDenominator: 100,
 	}
@@ -75,15 +114,16 @@ func TestProductCreationFlow(t *testing.T) {
 	assert.NotEmpty(t, resp.ProductID, "Product ID should be returned")
 
 	// Verify: Query returns correct data
-	readModel := repo.NewProductReadModel(client)
-	getProduct := get_product.NewQuery(readModel)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getProduct := get_product.NewQuery(readModel, clk)
 
 	getResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: resp.ProductID})
 	require.NoError(t, err, "GetProduct should succeed")
 	assert.Equal(t, "Test Product", getResp.Product.Name)
-	assert.Equal(t, "electronics", getResp.Product.Category)
+	assert.Equal(t, category, getResp.Product.Category)
 	assert.Equal(t, int64(1999), getResp.Product.BasePriceNumerator)
 	assert.Equal(t, int64(100), getResp.Product.BasePriceDenominator)
+	assert.Equal(t, "USD", getResp.Product.Currency)
 	assert.Equal(t, "active", getResp.Product.Status)
 
 	t.Logf("✓ Product created and retrieved successfully")
@@ -106,11 +146,11 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	enricher := &testEventEnricher{}
 
 	// Create product first
-	createProduct := create_product.NewInteractor(productRepo, outboxRepo, committer, clk)
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
 	createReq := create_product.Request{
 		Name:                 "Premium Product",
 		Description:          "High quality product",
-		Category:             "electronics",
+		Category:             createTestCategory(t, ctx, client, clk, "Electronics"),
 		BasePriceNumerator:   10000,
 		BasePriceDenominator: 100,
 	}
@@ -124,8 +164,8 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	endTime := fixedTime.Add(24 * time.Hour).Unix()
 
 	applyReq := apply_discount.Request{
-		ProductID:       createResp.ProductID,
-		DiscountPercent: 20,
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "20",
 		DiscountStartSec: startTime,
 		DiscountEndSec:   endTime,
 	}
@@ -134,22 +174,22 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	require.NoError(t, err, "ApplyDiscount should succeed")
 
 	// Verify: Effective price is calculated correctly
-	readModel := repo.NewProductReadModel(client)
-	getProduct := get_product.NewQuery(readModel)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getProduct := get_product.NewQuery(readModel, clk)
 
 	getResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
 	require.NoError(t, err)
 
 	// Price: 100.00 - 20% = 80.00
 	assert.True(t, getResp.Product.HasDiscount)
-	assert.Equal(t, int64(20), *getResp.Product.DiscountPercent)
-	assert.Equal(t, int64(10000*80), getResp.Product.EffectivePriceNumerator) // 10000 * 0.80 = 8000
-	assert.Equal(t, int64(100*100), getResp.Product.EffectivePriceDenominator)
+	assert.Equal(t, "20", getResp.Product.DiscountPercent.RatString())
+	assert.Equal(t, int64(80), getResp.Product.EffectivePriceNumerator) // 10000/100 * 0.80 = 80/1
+	assert.Equal(t, int64(1), getResp.Product.EffectivePriceDenominator)
 
 	t.Logf("✓ Discount applied and effective price calculated correctly")
 }
 
-func TestBusinessRuleValidation(t *testing.T) {
+func TestFractionalDiscountFlow(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping E2E test in short mode")
 	}
@@ -165,39 +205,94 @@ func TestBusinessRuleValidation(t *testing.T) {
 	outboxRepo := repo.NewOutboxRepo(client)
 	enricher := &testEventEnricher{}
 
-	// Create product
-	createProduct := create_product.NewInteractor(productRepo, outboxRepo, committer, clk)
-	createReq := create_product.Request{
-		Name:                 "Test Product",
-		Description:          "Description",
-		Category:             "category",
-		BasePriceNumerator:   100,
-		BasePriceDenominator: 1,
-	}
-	createResp, err := createProduct.Execute(ctx, createReq)
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Desk Lamp",
+		Category:             createTestCategory(t, ctx, client, clk, "Home"),
+		BasePriceNumerator:   4000,
+		BasePriceDenominator: 100,
+	})
 	require.NoError(t, err)
 
-	// Test: Cannot apply discount to inactive product
-	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
-	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: createResp.ProductID})
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "12.5",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	})
 	require.NoError(t, err)
 
-	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getResp, err := get_product.NewQuery(readModel, clk).Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Price: 40.00 - 12.5% = 35.00
+	assert.Equal(t, "25/2", getResp.Product.DiscountPercent.RatString())
+	assert.Equal(t, int64(35), getResp.Product.EffectivePriceNumerator)
+	assert.Equal(t, int64(1), getResp.Product.EffectivePriceDenominator)
+
+	t.Logf("✓ Fractional discount applied and effective price calculated exactly")
+}
+
+func TestFixedAmountDiscountFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Gift Card Holder",
+		Category:             createTestCategory(t, ctx, client, clk, "Accessories"),
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
 
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
 	applyReq := apply_discount.Request{
-		ProductID:       createResp.ProductID,
-		DiscountPercent: 10,
-		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
-		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+		ProductID:         createResp.ProductID,
+		DiscountKind:      string(domain.DiscountKindFixedAmount),
+		DiscountStartSec:  fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:    fixedTime.Add(24 * time.Hour).Unix(),
+		AmountNumerator:   2500,
+		AmountDenominator: 100,
 	}
 
+	// $25 off a $20 product is rejected
 	_, err = applyDiscount.Execute(ctx, applyReq)
-	assert.ErrorIs(t, err, domain.ErrProductNotActive, "Should not allow discount on inactive product")
+	assert.ErrorIs(t, err, domain.ErrDiscountExceedsPrice)
 
-	t.Logf("✓ Business rule validated correctly")
+	// $5 off is applied
+	applyReq.AmountNumerator = 500
+	_, err = applyDiscount.Execute(ctx, applyReq)
+	require.NoError(t, err)
+
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getResp, err := get_product.NewQuery(readModel, clk).Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Price: 20.00 - 5.00 = 15.00
+	assert.True(t, getResp.Product.HasDiscount)
+	assert.Equal(t, string(domain.DiscountKindFixedAmount), getResp.Product.DiscountKind)
+	assert.Nil(t, getResp.Product.DiscountPercent)
+	assert.Equal(t, "15.00", getResp.Product.EffectivePriceDecimal)
+
+	t.Logf("✓ Fixed-amount discount applied and effective price calculated correctly")
 }
 
-func TestProductActivationFlow(t *testing.T) {
+func TestScheduledDiscountFlow(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping E2E test in short mode")
 	}
@@ -211,42 +306,335 @@ func TestProductActivationFlow(t *testing.T) {
 	committer := committer.NewCommitter(client)
 	productRepo := repo.NewProductRepo(client)
 	outboxRepo := repo.NewOutboxRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
 	enricher := &testEventEnricher{}
 
-	// Create inactive product
-	createProduct := create_product.NewInteractor(productRepo, outboxRepo, committer, clk)
-	createReq := create_product.Request{
-		Name:                 "New Product",
-		Description:          "Description",
-		Category:             "category",
-		BasePriceNumerator:   100,
-		BasePriceDenominator: 1,
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Armchair",
+		Category:             createTestCategory(t, ctx, client, clk, "Furniture"),
+		BasePriceNumerator:   10000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	// Today's sale and next week's sale coexist
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "10",
+		DiscountStartSec: fixedTime.Unix(),
+		DiscountEndSec:   fixedTime.AddDate(0, 0, 2).Unix(),
+	})
+	require.NoError(t, err)
+
+	scheduleDiscount := schedule_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	scheduleReq := schedule_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "30",
+		DiscountStartSec: fixedTime.AddDate(0, 0, 7).Unix(),
+		DiscountEndSec:   fixedTime.AddDate(0, 0, 9).Unix(),
 	}
-	createResp, err := createProduct.Execute(ctx, createReq)
+	scheduleResp, err := scheduleDiscount.Execute(ctx, scheduleReq)
 	require.NoError(t, err)
 
-	// Deactivate
-	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
-	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: createResp.ProductID})
+	// Overlapping windows are rejected
+	_, err = scheduleDiscount.Execute(ctx, scheduleReq)
+	assert.ErrorIs(t, err, domain.ErrDiscountOverlap)
+
+	listResp, err := list_discounts.NewQuery(readModel).Execute(ctx, list_discounts.Request{ProductID: createResp.ProductID})
 	require.NoError(t, err)
+	require.Len(t, listResp.Discounts, 1)
+	assert.Equal(t, scheduleResp.DiscountID, listResp.Discounts[0].DiscountID)
 
-	// Activate
-	activate := activate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
-	_, err = activate.Execute(ctx, activate_product.Request{ProductID: createResp.ProductID})
+	// The scheduled discount applies inside its window
+	getProduct := get_product.NewQuery(readModel, clk)
+	getResp, err := getProduct.Execute(ctx, get_product.Request{
+		ProductID: createResp.ProductID,
+		AsOfSec:   fixedTime.AddDate(0, 0, 8).Unix(),
+	})
 	require.NoError(t, err)
+	assert.Equal(t, "70.00", getResp.Product.EffectivePriceDecimal)
 
-	// Verify status
-	readModel := repo.NewProductReadModel(client)
-	getProduct := get_product.NewQuery(readModel)
+	// Price history reports the scheduled window
+	getHistory := get_price_history.NewQuery(readModel, services.NewPricingCalculator(), clk)
+	historyPrices := func() []float64 {
+		resp, err := getHistory.Execute(ctx, get_price_history.Request{
+			ProductID: createResp.ProductID,
+			FromSec:   fixedTime.Unix(),
+			ToSec:     fixedTime.AddDate(0, 0, 10).Unix(),
+		})
+		require.NoError(t, err)
 
-	getResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+		var prices []float64
+		for _, interval := range resp.Intervals {
+			prices = append(prices, float64(interval.EffectivePriceNumerator)/float64(interval.EffectivePriceDenominator))
+		}
+		return prices
+	}
+	assert.Equal(t, []float64{90, 100, 70, 100}, historyPrices())
+
+	cancelDiscount := cancel_scheduled_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = cancelDiscount.Execute(ctx, cancel_scheduled_discount.Request{
+		ProductID:  createResp.ProductID,
+		DiscountID: scheduleResp.DiscountID,
+	})
 	require.NoError(t, err)
-	assert.Equal(t, "active", getResp.Product.Status)
 
-	t.Logf("✓ Product activation flow working correctly")
+	// Cancelling at the same instant replaces the recorded window
+	assert.Equal(t, []float64{90, 100}, historyPrices())
+
+	getResp, err = getProduct.Execute(ctx, get_product.Request{
+		ProductID: createResp.ProductID,
+		AsOfSec:   fixedTime.AddDate(0, 0, 8).Unix(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "100.00", getResp.Product.EffectivePriceDecimal)
+
+	t.Logf("✓ Scheduled discounts queue alongside the applied discount")
 }
 
-func TestListProductsWithPagination(t *testing.T) {
+func TestDiscountLifecycleFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Footstool",
+		Category:             createTestCategory(t, ctx, client, clk, "Furniture"),
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "10",
+		DiscountStartSec: fixedTime.AddDate(0, 0, 1).Unix(),
+		DiscountEndSec:   fixedTime.AddDate(0, 0, 2).Unix(),
+	})
+	require.NoError(t, err)
+
+	scheduleDiscount := schedule_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = scheduleDiscount.Execute(ctx, schedule_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "30",
+		DiscountStartSec: fixedTime.AddDate(0, 0, 7).Unix(),
+		DiscountEndSec:   fixedTime.AddDate(0, 0, 9).Unix(),
+	})
+	require.NoError(t, err)
+
+	advance := advance_discount_lifecycle.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	req := advance_discount_lifecycle.Request{ProductID: createResp.ProductID}
+
+	// Nothing is due before the first window opens
+	due, err := productRepo.FindDueDiscounts(ctx, clk.Now(), 1000)
+	require.NoError(t, err)
+	assert.NotContains(t, due, createResp.ProductID)
+
+	// The applied discount starts
+	clk.FixedTime = fixedTime.AddDate(0, 0, 1)
+	due, err = productRepo.FindDueDiscounts(ctx, clk.Now(), 1000)
+	require.NoError(t, err)
+	assert.Contains(t, due, createResp.ProductID)
+
+	resp, err := advance.Execute(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.EventCount)
+
+	// Running again at the same instant is a no-op
+	resp, err = advance.Execute(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.EventCount)
+
+	due, err = productRepo.FindDueDiscounts(ctx, clk.Now(), 1000)
+	require.NoError(t, err)
+	assert.NotContains(t, due, createResp.ProductID)
+
+	// After both windows: the applied discount ends, the scheduled one starts and ends
+	clk.FixedTime = fixedTime.AddDate(0, 0, 10)
+	resp, err = advance.Execute(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.EventCount)
+
+	product, err := productRepo.FindByID(ctx, createResp.ProductID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DiscountPhaseEnded, product.DiscountPhase())
+	assert.Equal(t, domain.DiscountPhaseEnded, product.DiscountSchedule()[0].Phase())
+
+	t.Logf("✓ Discount lifecycle events emitted once per boundary")
+}
+
+func TestVariantFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "T-Shirt",
+		Category:             createTestCategory(t, ctx, client, clk, "Apparel"),
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	// SKUs are unique across products, so derive them from the product ID
+	sku := func(size string) string { return createResp.ProductID[:8] + "-" + size }
+
+	addVariant := add_variant.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	medium, err := addVariant.Execute(ctx, add_variant.Request{
+		ProductID: createResp.ProductID,
+		SKU:       sku("M"),
+		Options:   map[string]string{"size": "M"},
+	})
+	require.NoError(t, err)
+
+	large, err := addVariant.Execute(ctx, add_variant.Request{
+		ProductID:                createResp.ProductID,
+		SKU:                      sku("XL"),
+		Options:                  map[string]string{"size": "XL"},
+		PriceOverrideNumerator:   2400,
+		PriceOverrideDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	_, err = addVariant.Execute(ctx, add_variant.Request{ProductID: createResp.ProductID, SKU: sku("M")})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+
+	updateVariant := update_variant.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = updateVariant.Execute(ctx, update_variant.Request{
+		ProductID: createResp.ProductID,
+		VariantID: medium.VariantID,
+		SKU:       sku("M"),
+		Options:   map[string]string{"size": "M", "color": "navy"},
+	})
+	require.NoError(t, err)
+
+	retireVariant := retire_variant.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = retireVariant.Execute(ctx, retire_variant.Request{ProductID: createResp.ProductID, VariantID: large.VariantID})
+	require.NoError(t, err)
+
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "25",
+		DiscountStartSec: fixedTime.Unix(),
+		DiscountEndSec:   fixedTime.AddDate(0, 0, 7).Unix(),
+	})
+	require.NoError(t, err)
+
+	// Variants inherit the product's discount
+	getResp, err := get_product.NewQuery(readModel, clk).Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, getResp.Product.Variants, 2)
+
+	m, xl := getResp.Product.Variants[0], getResp.Product.Variants[1]
+	assert.Equal(t, "navy", m.Options["color"])
+	assert.Equal(t, "15.00", m.EffectivePriceDecimal)
+	assert.True(t, xl.HasPriceOverride)
+	assert.Equal(t, "18.00", xl.EffectivePriceDecimal)
+	assert.Equal(t, string(domain.VariantStatusRetired), xl.Status)
+
+	t.Logf("✓ Variants priced with the product discount")
+}
+
+func TestAttributeFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	schemas := repo.NewAttributeSchemaRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
+
+	// Attribute definitions are shared per category, so use a fresh one
+	category := createTestCategory(t, ctx, client, clk, "Laptops")
+
+	defineAttribute := define_attribute.NewInteractor(schemas, committer)
+	for _, req := range []define_attribute.Request{
+		{Category: category, Name: "screen_size", Type: "number", Unit: "in", Required: true},
+		{Category: category, Name: "panel", Type: "string", AllowedValues: []string{"ips", "oled"}},
+		{Category: category, Name: "touchscreen", Type: "boolean"},
+	} {
+		_, err := defineAttribute.Execute(ctx, req)
+		require.NoError(t, err)
+	}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Laptop",
+		Category:             category,
+		BasePriceNumerator:   99900,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	updateProduct := update_product.NewInteractor(productRepo, productRepo, schemas, repo.NewCategoryRepo(client), outboxRepo, committer, clk, enricher)
+
+	// Test: Values are validated against the category schema
+	_, err = updateProduct.Execute(ctx, update_product.Request{
+		ProductID:     createResp.ProductID,
+		Name:          "Laptop",
+		Category:      category,
+		SetAttributes: map[string]string{"panel": "tn", "screen_size": "15.6"},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidAttributeValue)
+
+	_, err = updateProduct.Execute(ctx, update_product.Request{
+		ProductID:     createResp.ProductID,
+		Name:          "Laptop",
+		Category:      category,
+		SetAttributes: map[string]string{"panel": "oled", "screen_size": "15.60", "touchscreen": "1"},
+	})
+	require.NoError(t, err)
+
+	getResp, err := get_product.NewQuery(readModel, clk).Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, []*contracts.AttributeDTO{
+		{Name: "panel", Type: "string", Value: "oled"},
+		{Name: "screen_size", Type: "number", Value: "15.6"},
+		{Name: "touchscreen", Type: "boolean", Value: "true"},
+	}, getResp.Product.Attributes)
+
+	t.Logf("✓ Attributes validated and stored in canonical form")
+}
+
+func TestCategoryFlow(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping E2E test in short mode")
 	}
@@ -259,51 +647,1489 @@ func TestListProductsWithPagination(t *testing.T) {
 	committer := committer.NewCommitter(client)
 	productRepo := repo.NewProductRepo(client)
 	outboxRepo := repo.NewOutboxRepo(client)
+	categoryRepo := repo.NewCategoryRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
 
-	// Create multiple products
-	createProduct := create_product.NewInteractor(productRepo, outboxRepo, committer, clk)
+	createCategory := create_category.NewInteractor(categoryRepo, categoryRepo, outboxRepo, committer, clk, enricher)
+	moveCategory := move_category.NewInteractor(categoryRepo, categoryRepo, outboxRepo, committer, clk, enricher)
+	archiveCategory := archive_category.NewInteractor(categoryRepo, categoryRepo, outboxRepo, committer, clk, enricher)
+	createProduct := create_product.NewInteractor(productRepo, categoryRepo, outboxRepo, committer, clk)
 
-	for i := 0; i < 5; i++ {
-		req := create_product.Request{
-			Name:                 "Product " + string(rune('A'+i)),
-			Description:          "Test product",
-			Category:             "test",
+	// Build electronics > computers > laptops
+	electronics := createTestCategory(t, ctx, client, clk, "Electronics")
+	computers, err := createCategory.Execute(ctx, create_category.Request{Name: "Computers", ParentID: electronics})
+	require.NoError(t, err)
+	laptops, err := createCategory.Execute(ctx, create_category.Request{Name: "Laptops", ParentID: computers.CategoryID})
+	require.NoError(t, err)
+
+	// Test: Sibling names are unique regardless of case
+	_, err = createCategory.Execute(ctx, create_category.Request{Name: "computers", ParentID: electronics})
+	assert.ErrorIs(t, err, domain.ErrDuplicateCategoryName)
+
+	for _, category := range []string{computers.CategoryID, laptops.CategoryID} {
+		_, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 "Product",
+			Category:             category,
 			BasePriceNumerator:   100,
 			BasePriceDenominator: 1,
-		}
-		_, err := createProduct.Execute(ctx, req)
+		})
 		require.NoError(t, err)
 	}
 
-	// List products with pagination
-	readModel := repo.NewProductReadModel(client)
-	listProducts := list_products.NewQuery(readModel)
+	// Test: Products are matched by subtree
+	listProducts := list_products.NewQuery(readModel, clk)
+	listResp, err := listProducts.Execute(ctx, list_products.Request{Category: electronics, IncludeSubcategories: true, PageSize: 10})
+	require.NoError(t, err)
+	assert.Len(t, listResp.Products, 2)
 
-	listResp, err := listProducts.Execute(ctx, list_products.Request{
-		PageSize: 3,
-	})
+	listResp, err = listProducts.Execute(ctx, list_products.Request{Category: electronics, PageSize: 10})
 	require.NoError(t, err)
-	assert.Len(t, listResp.Products, 3, "Should return page size of 3")
-	assert.NotEmpty(t, listResp.NextPageToken, "Should have next page token")
+	assert.Empty(t, listResp.Products, "exact match excludes subcategories")
 
-	// Get next page
-	page2Resp, err := listProducts.Execute(ctx, list_products.Request{
-		PageSize:  3,
-		PageToken: listResp.NextPageToken,
-	})
+	// Test: A category cannot move below its own descendant
+	_, err = moveCategory.Execute(ctx, move_category.Request{CategoryID: computers.CategoryID, ParentID: laptops.CategoryID})
+	assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+
+	// Moving laptops to the root takes its products out of the electronics subtree
+	_, err = moveCategory.Execute(ctx, move_category.Request{CategoryID: laptops.CategoryID})
 	require.NoError(t, err)
-	assert.Len(t, page2Resp.Products, 2, "Should return remaining 2 products")
-	assert.Empty(t, page2Resp.NextPageToken, "Should have no more pages")
 
-	t.Logf("✓ Pagination working correctly")
+	getCategory := get_category.NewQuery(readModel)
+	getResp, err := getCategory.Execute(ctx, get_category.Request{CategoryID: laptops.CategoryID})
+	require.NoError(t, err)
+	assert.Empty(t, getResp.Category.ParentID)
+	assert.Empty(t, getResp.Category.AncestorIDs)
+
+	listResp, err = listProducts.Execute(ctx, list_products.Request{Category: electronics, IncludeSubcategories: true, PageSize: 10})
+	require.NoError(t, err)
+	assert.Len(t, listResp.Products, 1)
+
+	// Test: Archiving requires archived subcategories and closes the category to products
+	_, err = archiveCategory.Execute(ctx, archive_category.Request{CategoryID: electronics})
+	assert.ErrorIs(t, err, domain.ErrCategoryHasChildren)
+
+	_, err = archiveCategory.Execute(ctx, archive_category.Request{CategoryID: computers.CategoryID})
+	require.NoError(t, err)
+
+	_, err = createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Product",
+		Category:             computers.CategoryID,
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+	})
+	assert.ErrorIs(t, err, domain.ErrCategoryArchived)
+
+	t.Logf("✓ Category tree maintained and subtree filters applied")
+}
+
+func TestStockFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	stockRepo := repo.NewStockRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Stocked Product",
+		Category:             createTestCategory(t, ctx, client, clk, "Stocked"),
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	getProduct := get_product.NewQuery(readModel, clk)
+	availability := func() (string, int64) {
+		resp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+		require.NoError(t, err)
+		return resp.Product.Availability, resp.Product.AvailableQuantity
+	}
+
+	// Test: A product without stock is out of stock
+	state, _ := availability()
+	assert.Equal(t, "out_of_stock", state)
+
+	reserveStock := reserve_stock.NewInteractor(stockRepo, stockRepo, outboxRepo, committer, clk, enricher)
+	_, err = reserveStock.Execute(ctx, reserve_stock.Request{ProductID: createResp.ProductID, Quantity: 1})
+	assert.ErrorIs(t, err, domain.ErrStockNotFound)
+
+	adjustStock := adjust_stock.NewInteractor(productRepo, stockRepo, stockRepo, outboxRepo, committer, clk, enricher)
+	_, err = adjustStock.Execute(ctx, adjust_stock.Request{ProductID: createResp.ProductID, Delta: 10, ReasonCode: "delivery"})
+	require.NoError(t, err)
+
+	setThreshold := set_stock_threshold.NewInteractor(productRepo, stockRepo, stockRepo, outboxRepo, committer, clk, enricher)
+	_, err = setThreshold.Execute(ctx, set_stock_threshold.Request{ProductID: createResp.ProductID, LowStockThreshold: 3})
+	require.NoError(t, err)
+
+	// Test: Reservations reduce availability and cannot oversell
+	reserveResp, err := reserveStock.Execute(ctx, reserve_stock.Request{ProductID: createResp.ProductID, Quantity: 8})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), reserveResp.Reserved)
+
+	state, available := availability()
+	assert.Equal(t, "low_stock", state)
+	assert.Equal(t, int64(2), available)
+
+	_, err = reserveStock.Execute(ctx, reserve_stock.Request{ProductID: createResp.ProductID, Quantity: 3})
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+
+	releaseStock := release_stock.NewInteractor(stockRepo, stockRepo, outboxRepo, committer, clk, enricher)
+	_, err = releaseStock.Execute(ctx, release_stock.Request{ProductID: createResp.ProductID, Quantity: 5})
+	require.NoError(t, err)
+
+	state, available = availability()
+	assert.Equal(t, "in_stock", state)
+	assert.Equal(t, int64(7), available)
+
+	t.Logf("✓ Stock reserved, released and reported correctly")
+}
+
+func TestBundleFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	categoryRepo := repo.NewCategoryRepo(client)
+	stockRepo := repo.NewStockRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	calculator := services.NewPricingCalculator()
+	enricher := &testEventEnricher{}
+	categoryID := createTestCategory(t, ctx, client, clk, "Bundles")
+
+	createProduct := create_product.NewInteractor(productRepo, categoryRepo, outboxRepo, committer, clk)
+	adjustStock := adjust_stock.NewInteractor(productRepo, stockRepo, stockRepo, outboxRepo, committer, clk, enricher)
+	componentIDs := make([]string, 0, 2)
+	for _, c := range []struct {
+		name  string
+		price int64
+		stock int64
+	}{
+		{"Camera", 500, 10},
+		{"Battery", 40, 7},
+	} {
+		resp, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 c.name,
+			Category:             categoryID,
+			BasePriceNumerator:   c.price,
+			BasePriceDenominator: 1,
+		})
+		require.NoError(t, err)
+		_, err = adjustStock.Execute(ctx, adjust_stock.Request{ProductID: resp.ProductID, Delta: c.stock})
+		require.NoError(t, err)
+		componentIDs = append(componentIDs, resp.ProductID)
+	}
+	cameraID, batteryID := componentIDs[0], componentIDs[1]
+
+	// Test: The bundle is priced at its components' total less the bundle discount
+	createBundle := create_bundle.NewInteractor(productRepo, productRepo, categoryRepo, outboxRepo, committer, clk, enricher, calculator)
+	bundleResp, err := createBundle.Execute(ctx, create_bundle.Request{
+		Name:     "Camera Kit",
+		Category: categoryID,
+		Components: []create_bundle.Component{
+			{ProductID: cameraID, Quantity: 1},
+			{ProductID: batteryID, Quantity: 2},
+		},
+		DiscountPercent: "10",
+	})
+	require.NoError(t, err)
+
+	getProduct := get_product.NewQuery(readModel, clk)
+	getBundle := func() *contracts.ProductDTO {
+		resp, err := getProduct.Execute(ctx, get_product.Request{ProductID: bundleResp.ProductID})
+		require.NoError(t, err)
+		return resp.Product
+	}
+
+	bundle := getBundle()
+	assert.Equal(t, "bundle", bundle.ProductType)
+	assert.Equal(t, "522.00", bundle.EffectivePriceDecimal) // (500 + 2*40) * 0.9
+	assert.Equal(t, "in_stock", bundle.Availability)
+	assert.Equal(t, int64(3), bundle.AvailableQuantity) // 7 batteries fill 3 kits
+
+	// Test: Component price changes flow into the bundle price
+	changePrice := change_price.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = changePrice.Execute(ctx, change_price.Request{ProductID: cameraID, BasePriceNumerator: 600, BasePriceDenominator: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "612.00", getBundle().EffectivePriceDecimal)
+
+	// Test: Bundles cannot be repriced directly or nested
+	_, err = changePrice.Execute(ctx, change_price.Request{ProductID: bundleResp.ProductID, BasePriceNumerator: 1, BasePriceDenominator: 1})
+	assert.ErrorIs(t, err, domain.ErrBundlePriceDerived)
+
+	_, err = createBundle.Execute(ctx, create_bundle.Request{
+		Name:       "Kit of Kits",
+		Category:   categoryID,
+		Components: []create_bundle.Component{{ProductID: bundleResp.ProductID, Quantity: 1}},
+	})
+	assert.ErrorIs(t, err, domain.ErrNestedBundle)
+
+	// Test: An explicit override replaces the derived price
+	updateBundle := update_bundle.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher, calculator)
+	_, err = updateBundle.Execute(ctx, update_bundle.Request{
+		ProductID: bundleResp.ProductID,
+		Components: []update_bundle.Component{
+			{ProductID: cameraID, Quantity: 1},
+			{ProductID: batteryID, Quantity: 2},
+		},
+		PriceOverrideNumerator:   550,
+		PriceOverrideDenominator: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "550.00", getBundle().EffectivePriceDecimal)
+
+	// Test: Deactivating a component makes the bundle unavailable
+	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: batteryID})
+	require.NoError(t, err)
+
+	bundle = getBundle()
+	assert.Equal(t, "unavailable", bundle.Availability)
+	assert.Zero(t, bundle.AvailableQuantity)
+
+	t.Logf("✓ Bundle priced and made unavailable from its components")
+}
+
+func TestPriceTiersFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Wood Screws",
+		Category:             createTestCategory(t, ctx, client, clk, "Hardware"),
+		BasePriceNumerator:   10,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	// Test: Tiers must not raise the unit price with the quantity
+	setPriceTiers := set_price_tiers.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = setPriceTiers.Execute(ctx, set_price_tiers.Request{
+		ProductID: createResp.ProductID,
+		Tiers: []set_price_tiers.Tier{
+			{MinQuantity: 10, PriceNumerator: 8, PriceDenominator: 1},
+			{MinQuantity: 50, PriceNumerator: 9, PriceDenominator: 1},
+		},
+	})
+	assert.ErrorIs(t, err, domain.ErrPriceTiersNotMonotonic)
+
+	// Test: 1-9 units at $10, 10+ at $8.50
+	_, err = setPriceTiers.Execute(ctx, set_price_tiers.Request{
+		ProductID: createResp.ProductID,
+		Tiers:     []set_price_tiers.Tier{{MinQuantity: 10, PriceNumerator: 850, PriceDenominator: 100}},
+	})
+	require.NoError(t, err)
+
+	getProduct := get_product.NewQuery(readModel, clk)
+	productResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, productResp.Product.PriceTiers, 1)
+	assert.Equal(t, "8.50", productResp.Product.PriceTiers[0].PriceDecimal)
+
+	getPrice := get_price.NewQuery(readModel, services.NewPricingCalculator(), domain.DefaultRoundingMode, clk)
+	priceResp, err := getPrice.Execute(ctx, get_price.Request{ProductID: createResp.ProductID, Quantity: 9})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), priceResp.Price.TierMinQuantity)
+	assert.Equal(t, "90.00", priceResp.Price.LineTotalDecimal)
+
+	priceResp, err = getPrice.Execute(ctx, get_price.Request{ProductID: createResp.ProductID, Quantity: 12})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), priceResp.Price.TierMinQuantity)
+	assert.Equal(t, "8.50", priceResp.Price.UnitPriceDecimal)
+	assert.Equal(t, "102.00", priceResp.Price.LineTotalDecimal)
+
+	// Test: The active discount applies on top of the tier price
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "20",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	priceResp, err = getPrice.Execute(ctx, get_price.Request{ProductID: createResp.ProductID, Quantity: 12})
+	require.NoError(t, err)
+	assert.True(t, priceResp.Price.HasDiscount)
+	assert.Equal(t, "6.80", priceResp.Price.EffectiveUnitPriceDecimal)
+	assert.Equal(t, "81.60", priceResp.Price.LineTotalDecimal)
+
+	// Test: After the discount window only the tier price applies
+	priceResp, err = getPrice.Execute(ctx, get_price.Request{
+		ProductID: createResp.ProductID,
+		Quantity:  12,
+		AsOfSec:   fixedTime.Add(48 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	assert.False(t, priceResp.Price.HasDiscount)
+	assert.Equal(t, "102.00", priceResp.Price.LineTotalDecimal)
+
+	t.Logf("✓ Quantity priced from its tier with the active discount")
+}
+
+func TestPriceListFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	priceListRepo := repo.NewPriceListRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Office Chair",
+		Category:             createTestCategory(t, ctx, client, clk, "Furniture"),
+		BasePriceNumerator:   200,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	// Test: A wholesale list valid for one week
+	createPriceList := create_price_list.NewInteractor(priceListRepo, outboxRepo, committer, clk, enricher)
+	listResp, err := createPriceList.Execute(ctx, create_price_list.Request{
+		Name:       "Wholesale 2026",
+		Currency:   "USD",
+		Channel:    "wholesale",
+		ValidToSec: fixedTime.Add(7 * 24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	setListPrice := set_list_price.NewInteractor(productRepo, productRepo, priceListRepo, priceListRepo, outboxRepo, committer, clk, enricher)
+	_, err = setListPrice.Execute(ctx, set_list_price.Request{
+		PriceListID:      listResp.PriceListID,
+		ProductID:        createResp.ProductID,
+		PriceNumerator:   150,
+		PriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	// Test: The list price replaces the base price, and the discount applies on top
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "10",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	getProduct := get_product.NewQuery(readModel, clk)
+	productResp, err := getProduct.Execute(ctx, get_product.Request{
+		ProductID:   createResp.ProductID,
+		PriceListID: listResp.PriceListID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, listResp.PriceListID, productResp.Product.PriceListID)
+	assert.Equal(t, "150.00", productResp.Product.BasePriceDecimal)
+	assert.Equal(t, "135.00", productResp.Product.EffectivePriceDecimal)
+
+	// Test: Without the list the default price applies
+	productResp, err = getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Empty(t, productResp.Product.PriceListID)
+	assert.Equal(t, "200.00", productResp.Product.BasePriceDecimal)
+
+	// Test: After the validity window the default price applies
+	productResp, err = getProduct.Execute(ctx, get_product.Request{
+		ProductID:   createResp.ProductID,
+		AsOfSec:     fixedTime.Add(30 * 24 * time.Hour).Unix(),
+		PriceListID: listResp.PriceListID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "200.00", productResp.Product.BasePriceDecimal)
+
+	// Test: Unknown lists are rejected
+	_, err = getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID, PriceListID: "missing"})
+	assert.ErrorIs(t, err, domain.ErrPriceListNotFound)
+
+	// Test: Removing the entry falls back to the default price
+	removeListPrice := remove_list_price.NewInteractor(priceListRepo, priceListRepo, outboxRepo, committer, clk, enricher)
+	_, err = removeListPrice.Execute(ctx, remove_list_price.Request{
+		PriceListID: listResp.PriceListID,
+		ProductID:   createResp.ProductID,
+	})
+	require.NoError(t, err)
+
+	productResp, err = getProduct.Execute(ctx, get_product.Request{
+		ProductID:   createResp.ProductID,
+		PriceListID: listResp.PriceListID,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, productResp.Product.PriceListID)
+	assert.Equal(t, "200.00", productResp.Product.BasePriceDecimal)
+
+	t.Logf("✓ Product priced from the price list within its validity window")
+}
+
+func TestTaxFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Desk Lamp",
+		Category:             createTestCategory(t, ctx, client, clk, "Lighting"),
+		BasePriceNumerator:   200,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	setTaxRate := set_tax_rate.NewInteractor(repo.NewTaxRateRepo(client), committer, clk)
+	_, err = setTaxRate.Execute(ctx, set_tax_rate.Request{Region: "us-ca", RatePercent: "7.25"})
+	require.NoError(t, err)
+
+	// Test: New products use the standard class
+	getProduct := get_product.NewQuery(readModel, clk)
+	productResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID, Region: "US-CA"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultTaxClass, productResp.Product.TaxClass)
+	require.NotNil(t, productResp.Product.Tax)
+	assert.Equal(t, "US-CA", productResp.Product.Tax.Region)
+	assert.Equal(t, "200.00", productResp.Product.Tax.NetDecimal)
+	assert.Equal(t, "14.50", productResp.Product.Tax.TaxDecimal)
+	assert.Equal(t, "214.50", productResp.Product.Tax.GrossDecimal)
+
+	// Test: Without a region no tax is calculated
+	productResp, err = getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Nil(t, productResp.Product.Tax)
+
+	// Test: Tax on a line total
+	getPrice := get_price.NewQuery(readModel, services.NewPricingCalculator(), domain.DefaultRoundingMode, clk)
+	priceResp, err := getPrice.Execute(ctx, get_price.Request{ProductID: createResp.ProductID, Quantity: 3, Region: "US-CA"})
+	require.NoError(t, err)
+	require.NotNil(t, priceResp.Price.Tax)
+	assert.Equal(t, "43.50", priceResp.Price.Tax.TaxDecimal)
+	assert.Equal(t, "643.50", priceResp.Price.Tax.GrossDecimal)
+
+	// Test: A class without a rate in the region is rejected
+	setTaxClass := set_tax_class.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = setTaxClass.Execute(ctx, set_tax_class.Request{ProductID: createResp.ProductID, TaxClass: "reduced"})
+	require.NoError(t, err)
+
+	_, err = getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID, Region: "US-CA"})
+	assert.ErrorIs(t, err, domain.ErrTaxRateNotFound)
+
+	_, err = setTaxRate.Execute(ctx, set_tax_rate.Request{Region: "US-CA", TaxClass: "reduced", RatePercent: "0"})
+	require.NoError(t, err)
+
+	productResp, err = getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID, Region: "US-CA"})
+	require.NoError(t, err)
+	assert.Equal(t, "reduced", productResp.Product.TaxClass)
+	assert.Equal(t, "200.00", productResp.Product.Tax.GrossDecimal)
+
+	t.Logf("✓ Net, tax and gross amounts follow the product's tax class and the region's rate")
+}
+
+func TestQuotePricesFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+	category := createTestCategory(t, ctx, client, clk, "Kitchen")
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	create := func(name string, price int64) string {
+		resp, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Category:             category,
+			BasePriceNumerator:   price,
+			BasePriceDenominator: 1,
+		})
+		require.NoError(t, err)
+		return resp.ProductID
+	}
+
+	mugID := create("Mug", 10)
+	plateID := create("Plate", 5)
+	bowlID := create("Bowl", 7)
+
+	// Test: A discount scheduled for later applies to quotes as of its window
+	scheduleDiscount := schedule_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err := scheduleDiscount.Execute(ctx, schedule_discount.Request{
+		ProductID:        mugID,
+		DiscountPercent:  "20",
+		DiscountStartSec: fixedTime.Add(24 * time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(48 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: bowlID})
+	require.NoError(t, err)
+
+	quotePrices := quote_prices.NewQuery(productRepo, repo.NewPriceListRepo(client), services.NewPricingCalculator(), domain.DefaultRoundingMode, clk)
+	items := []quote_prices.Item{
+		{ProductID: mugID, Quantity: 3},
+		{ProductID: plateID, Quantity: 2},
+		{ProductID: bowlID, Quantity: 1},
+		{ProductID: "missing", Quantity: 1},
+	}
+
+	resp, err := quotePrices.Execute(ctx, quote_prices.Request{Items: items})
+	require.NoError(t, err)
+	require.Len(t, resp.Quote.Lines, 4)
+	assert.Equal(t, "30.00", resp.Quote.Lines[0].LineTotalDecimal)
+	assert.False(t, resp.Quote.Lines[0].HasDiscount)
+	assert.Equal(t, "10.00", resp.Quote.Lines[1].LineTotalDecimal)
+	assert.Equal(t, "40.00", resp.Quote.TotalDecimal)
+
+	// Test: Inactive and unknown products are rejected per line
+	assert.Equal(t, "inactive", resp.Quote.Lines[2].RejectionReason)
+	assert.Equal(t, "not_found", resp.Quote.Lines[3].RejectionReason)
+
+	resp, err = quotePrices.Execute(ctx, quote_prices.Request{
+		Items:   items,
+		AsOfSec: fixedTime.Add(36 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Quote.Lines[0].HasDiscount)
+	assert.Equal(t, "8.00", resp.Quote.Lines[0].EffectiveUnitPriceDecimal)
+	assert.Equal(t, "24.00", resp.Quote.Lines[0].LineTotalDecimal)
+	assert.Equal(t, "34.00", resp.Quote.TotalDecimal)
+
+	// Test: Lines are priced from the tier for their quantity
+	setPriceTiers := set_price_tiers.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = setPriceTiers.Execute(ctx, set_price_tiers.Request{
+		ProductID: plateID,
+		Tiers:     []set_price_tiers.Tier{{MinQuantity: 10, PriceNumerator: 4, PriceDenominator: 1}},
+	})
+	require.NoError(t, err)
+
+	// Test: Bundles are priced from their components and rejected if one cannot be sold
+	calculator := services.NewPricingCalculator()
+	createBundle := create_bundle.NewInteractor(productRepo, productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk, enricher, calculator)
+	dinnerSet, err := createBundle.Execute(ctx, create_bundle.Request{
+		Name:       "Dinner Set",
+		Category:   category,
+		Components: []create_bundle.Component{{ProductID: mugID, Quantity: 1}, {ProductID: plateID, Quantity: 2}},
+	})
+	require.NoError(t, err)
+	bowlSet, err := createBundle.Execute(ctx, create_bundle.Request{
+		Name:       "Bowl Set",
+		Category:   category,
+		Components: []create_bundle.Component{{ProductID: bowlID, Quantity: 1}, {ProductID: plateID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	resp, err = quotePrices.Execute(ctx, quote_prices.Request{
+		Items: []quote_prices.Item{
+			{ProductID: plateID, Quantity: 10},
+			{ProductID: dinnerSet.ProductID, Quantity: 1},
+			{ProductID: bowlSet.ProductID, Quantity: 1},
+		},
+		AsOfSec: fixedTime.Add(36 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), resp.Quote.Lines[0].TierMinQuantity)
+	assert.Equal(t, "40.00", resp.Quote.Lines[0].LineTotalDecimal)
+	assert.Equal(t, "18.00", resp.Quote.Lines[1].LineTotalDecimal, "discounted mug and two plates")
+	assert.Equal(t, "bundle_unavailable", resp.Quote.Lines[2].RejectionReason)
+
+	// Test: Empty quotes are rejected as a whole
+	_, err = quotePrices.Execute(ctx, quote_prices.Request{})
+	assert.ErrorIs(t, err, domain.ErrInvalidQuote)
+
+	t.Logf("✓ Cart quoted per line with the discounts active at the requested instant")
+}
+
+func TestChangePriceFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Repriced Product",
+		Category:             createTestCategory(t, ctx, client, clk, "Category"),
+		BasePriceNumerator:   1999,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	// Change price
+	changePrice := change_price.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = changePrice.Execute(ctx, change_price.Request{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   2499,
+		BasePriceDenominator: 100,
+		ReasonCode:           "daily_reprice",
+	})
+	require.NoError(t, err, "ChangePrice should succeed")
+
+	// Verify: New base price is returned
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getProduct := get_product.NewQuery(readModel, clk)
+
+	getResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2499), getResp.Product.BasePriceNumerator)
+	assert.Equal(t, int64(100), getResp.Product.BasePriceDenominator)
+
+	t.Logf("✓ Base price changed successfully")
+}
+
+func TestGetProductAsOfFutureInstant(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Campaign Product",
+		Category:             createTestCategory(t, ctx, client, clk, "Category"),
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	// Schedule a discount that starts tomorrow
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "10",
+		DiscountStartSec: fixedTime.Add(24 * time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(48 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getProduct := get_product.NewQuery(readModel, clk)
+
+	// Verify: No discount now, discount visible as of tomorrow
+	nowResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.False(t, nowResp.Product.HasDiscount)
+
+	tomorrowResp, err := getProduct.Execute(ctx, get_product.Request{
+		ProductID: createResp.ProductID,
+		AsOfSec:   fixedTime.Add(36 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	assert.True(t, tomorrowResp.Product.HasDiscount)
+	assert.Equal(t, int64(100*90), tomorrowResp.Product.EffectivePriceNumerator)
+	assert.Equal(t, int64(1*100), tomorrowResp.Product.EffectivePriceDenominator)
+
+	t.Logf("✓ Point-in-time pricing evaluated correctly")
+}
+
+func TestBusinessRuleValidation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	// Create product
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createReq := create_product.Request{
+		Name:                 "Test Product",
+		Description:          "Description",
+		Category:             createTestCategory(t, ctx, client, clk, "Category"),
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+	}
+	createResp, err := createProduct.Execute(ctx, createReq)
+	require.NoError(t, err)
+
+	// Test: Cannot apply discount to inactive product
+	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+
+	applyReq := apply_discount.Request{
+		ProductID:        createResp.ProductID,
+		DiscountPercent:  "10",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	}
+
+	_, err = applyDiscount.Execute(ctx, applyReq)
+	assert.ErrorIs(t, err, domain.ErrProductNotActive, "Should not allow discount on inactive product")
+
+	t.Logf("✓ Business rule validated correctly")
+}
+
+func TestProductActivationFlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	// Create inactive product
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	createReq := create_product.Request{
+		Name:                 "New Product",
+		Description:          "Description",
+		Category:             createTestCategory(t, ctx, client, clk, "Category"),
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+	}
+	createResp, err := createProduct.Execute(ctx, createReq)
+	require.NoError(t, err)
+
+	// Deactivate
+	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Activate
+	activate := activate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = activate.Execute(ctx, activate_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify status
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	getProduct := get_product.NewQuery(readModel, clk)
+
+	getResp, err := getProduct.Execute(ctx, get_product.Request{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "active", getResp.Product.Status)
+
+	t.Logf("✓ Product activation flow working correctly")
+}
+
+func TestListProductsWithPagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+
+	// Create multiple products
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+
+	category := createTestCategory(t, ctx, client, clk, "Test")
+
+	for i := 0; i < 5; i++ {
+		req := create_product.Request{
+			Name:                 "Product " + string(rune('A'+i)),
+			Description:          "Test product",
+			Category:             category,
+			BasePriceNumerator:   100,
+			BasePriceDenominator: 1,
+		}
+		_, err := createProduct.Execute(ctx, req)
+		require.NoError(t, err)
+	}
+
+	// List products with pagination
+	readModel := repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil)
+	listProducts := list_products.NewQuery(readModel, clk)
+
+	listResp, err := listProducts.Execute(ctx, list_products.Request{
+		PageSize: 3,
+	})
+	require.NoError(t, err)
+	assert.Len(t, listResp.Products, 3, "Should return page size of 3")
+	assert.NotEmpty(t, listResp.NextPageToken, "Should have next page token")
+
+	// Get next page
+	page2Resp, err := listProducts.Execute(ctx, list_products.Request{
+		PageSize:  3,
+		PageToken: listResp.NextPageToken,
+	})
+	require.NoError(t, err)
+	assert.Len(t, page2Resp.Products, 2, "Should return remaining 2 products")
+	assert.Empty(t, page2Resp.NextPageToken, "Should have no more pages")
+
+	seen := make(map[string]bool)
+	for _, p := range append(listResp.Products, page2Resp.Products...) {
+		assert.False(t, seen[p.ProductID], "product %s returned twice", p.ProductID)
+		seen[p.ProductID] = true
+	}
+
+	// Test: The token only continues the listing it was issued for
+	_, err = listProducts.Execute(ctx, list_products.Request{
+		Category:  category,
+		PageSize:  3,
+		PageToken: listResp.NextPageToken,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken, "token reused with other filters")
+
+	tampered := []byte(listResp.NextPageToken)
+	tampered[0] ^= 1
+	_, err = listProducts.Execute(ctx, list_products.Request{PageSize: 3, PageToken: string(tampered)})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken, "altered token")
+
+	otherKey := list_products.NewQuery(repo.NewProductReadModel(client, domain.DefaultRoundingMode, []byte("other-key")), clk)
+	_, err = otherKey.Execute(ctx, list_products.Request{PageSize: 3, PageToken: listResp.NextPageToken})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken, "token signed with another key")
+
+	t.Logf("✓ Pagination working correctly")
+}
+
+func TestListProductsSortedByEffectivePrice(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	category := createTestCategory(t, ctx, client, clk, "Sorted")
+
+	// Two products share a price so ties must break by product ID across pages
+	prices := map[string]int64{"A": 30, "B": 10, "C": 20, "D": 10, "E": 40}
+	ids := make(map[string]string)
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		resp, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 "Product " + name,
+			Category:             category,
+			BasePriceNumerator:   prices[name],
+			BasePriceDenominator: 1,
+		})
+		require.NoError(t, err)
+		ids[name] = resp.ProductID
+	}
+
+	// Prices in another currency do not compare, so F sorts with the EUR prices
+	_, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Product F",
+		Category:             category,
+		BasePriceNumerator:   50,
+		BasePriceDenominator: 1,
+		Currency:             "EUR",
+	})
+	require.NoError(t, err)
+
+	// Bundles are priced from their components when read, so price sorts leave them out
+	createBundle := create_bundle.NewInteractor(productRepo, productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk, enricher, services.NewPricingCalculator())
+	_, err = createBundle.Execute(ctx, create_bundle.Request{
+		Name:       "Product Kit",
+		Category:   category,
+		Components: []create_bundle.Component{{ProductID: ids["A"], Quantity: 1}, {ProductID: ids["B"], Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	// An active discount lowers E from 40 to 30, tying with A
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        ids["E"],
+		DiscountPercent:  "25",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	listProducts := list_products.NewQuery(repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil), clk)
+
+	listAll := func(req list_products.Request) []string {
+		var names []string
+		for {
+			resp, err := listProducts.Execute(ctx, req)
+			require.NoError(t, err)
+			for _, p := range resp.Products {
+				names = append(names, p.Name)
+			}
+			if resp.NextPageToken == "" {
+				return names
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+
+	// Expected tie order follows the product IDs
+	tie := func(x, y string) []string {
+		if ids[x] < ids[y] {
+			return []string{"Product " + x, "Product " + y}
+		}
+		return []string{"Product " + y, "Product " + x}
+	}
+
+	ascending := append(append(append([]string{"Product F"}, tie("B", "D")...), "Product C"), tie("A", "E")...)
+	assert.Equal(t, ascending, listAll(list_products.Request{Category: category, PageSize: 2, OrderBy: "effective_price"}))
+
+	descending := append(append(append(tie("A", "E"), "Product C"), tie("B", "D")...), "Product F")
+	assert.Equal(t, descending, listAll(list_products.Request{Category: category, PageSize: 1, OrderBy: "effective_price desc"}))
+
+	// Test: The price range matches the discounted price
+	inRange := listAll(list_products.Request{
+		Category:            category,
+		PageSize:            1,
+		OrderBy:             "effective_price",
+		MinPriceNumerator:   20,
+		MinPriceDenominator: 1,
+		MinPriceCurrency:    "USD",
+		MaxPriceNumerator:   30,
+		MaxPriceDenominator: 1,
+		MaxPriceCurrency:    "USD",
+	})
+	assert.Equal(t, append([]string{"Product C"}, tie("A", "E")...), inRange)
+
+	byName := listAll(list_products.Request{Category: category, PageSize: 2, OrderBy: "name desc"})
+	assert.Equal(t, []string{"Product Kit", "Product F", "Product E", "Product D", "Product C", "Product B", "Product A"}, byName)
+
+	// Test: Tokens only continue the sort order they were issued for
+	first, err := listProducts.Execute(ctx, list_products.Request{Category: category, PageSize: 2, OrderBy: "name"})
+	require.NoError(t, err)
+	_, err = listProducts.Execute(ctx, list_products.Request{Category: category, PageSize: 2, OrderBy: "created_at", PageToken: first.NextPageToken})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+
+	_, err = listProducts.Execute(ctx, list_products.Request{OrderBy: "price"})
+	assert.ErrorIs(t, err, domain.ErrUnsupportedSortOrder)
+
+	_, err = listProducts.Execute(ctx, list_products.Request{
+		MinPriceNumerator:   30,
+		MinPriceDenominator: 1,
+		MinPriceCurrency:    "USD",
+		MaxPriceNumerator:   20,
+		MaxPriceDenominator: 1,
+		MaxPriceCurrency:    "USD",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPriceRange)
+
+	t.Logf("✓ Sorted listing working correctly")
+}
+
+func TestListProductsByStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	clk := clock.NewMockClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	category := createTestCategory(t, ctx, client, clk, "Storefront")
+	otherCategory := createTestCategory(t, ctx, client, clk, "Backroom")
+
+	create := func(name, category string) string {
+		resp, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Category:             category,
+			BasePriceNumerator:   100,
+			BasePriceDenominator: 1,
+		})
+		require.NoError(t, err)
+		return resp.ProductID
+	}
+
+	create("Active", category)
+	inactive := create("Inactive", category)
+	archived := create("Archived", category)
+	create("Elsewhere", otherCategory)
+
+	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err := deactivate.Execute(ctx, deactivate_product.Request{ProductID: inactive})
+	require.NoError(t, err)
+
+	archive := archive_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = archive.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+
+	listProducts := list_products.NewQuery(repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil), clk)
+
+	names := func(req list_products.Request) []string {
+		resp, err := listProducts.Execute(ctx, req)
+		require.NoError(t, err)
+
+		var names []string
+		for _, p := range resp.Products {
+			names = append(names, p.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	// Test: Active products of a category, the storefront listing
+	assert.Equal(t, []string{"Active"}, names(list_products.Request{
+		Category: category,
+		Statuses: []string{"active"},
+	}))
+
+	// Test: Archived products are excluded unless requested
+	assert.Equal(t, []string{"Active", "Inactive"}, names(list_products.Request{Category: category}))
+	assert.Equal(t, []string{"Active", "Archived", "Inactive"}, names(list_products.Request{Category: category, IncludeArchived: true}))
+	assert.Equal(t, []string{"Archived", "Inactive"}, names(list_products.Request{
+		Category: category,
+		Statuses: []string{"inactive", "archived"},
+	}))
+
+	assert.Equal(t, []string{"Elsewhere"}, names(list_products.Request{
+		Category: otherCategory,
+		Statuses: []string{"active"},
+	}))
+
+	_, err = listProducts.Execute(ctx, list_products.Request{Statuses: []string{"deleted"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidProductStatus)
+
+	t.Logf("✓ Status filtering working correctly")
+}
+
+func TestCatalogFacets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	category := createTestCategory(t, ctx, client, clk, "Faceted")
+
+	create := func(name string, price int64, currency string) string {
+		resp, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Category:             category,
+			BasePriceNumerator:   price,
+			BasePriceDenominator: 1,
+			Currency:             currency,
+		})
+		require.NoError(t, err)
+		return resp.ProductID
+	}
+
+	create("Cheap", 10, "USD")
+	onSale := create("On Sale", 20, "USD")
+	inactive := create("Inactive", 30, "USD")
+	archived := create("Archived", 50, "USD")
+	create("Euro", 5, "EUR")
+
+	other := createTestCategory(t, ctx, client, clk, "Unfaceted")
+	_, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Elsewhere",
+		Category:             other,
+		BasePriceNumerator:   10,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        onSale,
+		DiscountPercent:  "25",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	deactivate := deactivate_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = deactivate.Execute(ctx, deactivate_product.Request{ProductID: inactive})
+	require.NoError(t, err)
+
+	archive := archive_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = archive.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+
+	getFacets := get_catalog_facets.NewQuery(repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil), clk)
+
+	counts := func(facets []*contracts.FacetCountDTO) map[string]int64 {
+		m := make(map[string]int64)
+		for _, f := range facets {
+			m[f.Value] = f.Count
+		}
+		return m
+	}
+	bandCounts := func(bands []*contracts.PriceBandDTO) []int64 {
+		var c []int64
+		for _, b := range bands {
+			c = append(c, b.Count)
+		}
+		return c
+	}
+	bounds := []get_catalog_facets.PriceBound{
+		{Numerator: 10, Denominator: 1, Currency: "USD"},
+		{Numerator: 20, Denominator: 1, Currency: "USD"},
+	}
+
+	// Test: Archived products are excluded from every facet but the status counts
+	resp, err := getFacets.Execute(ctx, get_catalog_facets.Request{
+		Products:        list_products.Request{Category: category},
+		PriceBandBounds: bounds,
+	})
+	require.NoError(t, err)
+
+	facets := resp.Facets
+	assert.Equal(t, int64(4), facets.Total)
+	// Category counts ignore the category filter, so other categories show too
+	assert.Equal(t, int64(4), counts(facets.Categories)[category])
+	assert.Equal(t, int64(1), counts(facets.Categories)[other])
+	assert.Equal(t, map[string]int64{"active": 3, "inactive": 1, "archived": 1}, counts(facets.Statuses))
+	assert.Equal(t, map[string]int64{"true": 1, "false": 3}, counts(facets.HasActiveDiscount))
+
+	// The discounted price of 15 falls in [10, 20); the EUR product in no band
+	assert.Equal(t, []int64{0, 2, 1}, bandCounts(facets.PriceBands))
+	require.Len(t, facets.PriceBands, 3)
+	assert.Nil(t, facets.PriceBands[0].MinNumerator)
+	assert.Equal(t, "10.00", facets.PriceBands[1].MinDecimal)
+	assert.Equal(t, "20.00", facets.PriceBands[1].MaxDecimal)
+	assert.Nil(t, facets.PriceBands[2].MaxNumerator)
+
+	// Test: Status and price counts ignore their own filters
+	resp, err = getFacets.Execute(ctx, get_catalog_facets.Request{
+		Products: list_products.Request{
+			Category:            category,
+			Statuses:            []string{"active"},
+			MinPriceNumerator:   12,
+			MinPriceDenominator: 1,
+			MinPriceCurrency:    "USD",
+		},
+		PriceBandBounds: bounds,
+	})
+	require.NoError(t, err)
+
+	facets = resp.Facets
+	assert.Equal(t, int64(1), facets.Total)
+	assert.Equal(t, map[string]int64{"active": 1, "inactive": 1, "archived": 1}, counts(facets.Statuses))
+	assert.Equal(t, []int64{0, 2, 0}, bandCounts(facets.PriceBands))
+
+	// Test: Bounds must ascend in one currency
+	_, err = getFacets.Execute(ctx, get_catalog_facets.Request{
+		PriceBandBounds: []get_catalog_facets.PriceBound{bounds[1], bounds[0]},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPriceBands)
+
+	t.Logf("✓ Catalog facets working correctly")
+}
+
+func TestSearchProducts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	category := createTestCategory(t, ctx, client, clk, "Searched")
+
+	create := func(name, description string, price int64) string {
+		resp, err := createProduct.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Description:          description,
+			Category:             category,
+			BasePriceNumerator:   price,
+			BasePriceDenominator: 1,
+			Currency:             "USD",
+		})
+		require.NoError(t, err)
+		return resp.ProductID
+	}
+
+	chair := create("Oak Chair", "A sturdy chair for the dining room", 100)
+	table := create("Walnut Table", "Pairs with any oak chair & bench", 200)
+	create("Oak Shelf", "Solid oak", 50)
+	bench := create("Pine Bench", "", 80)
+	archived := create("Old Oak Chair", "", 40)
+
+	applyDiscount := apply_discount.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err := applyDiscount.Execute(ctx, apply_discount.Request{
+		ProductID:        table,
+		DiscountPercent:  "25",
+		DiscountStartSec: fixedTime.Add(-time.Hour).Unix(),
+		DiscountEndSec:   fixedTime.Add(24 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	archive := archive_product.NewInteractor(productRepo, productRepo, outboxRepo, committer, clk, enricher)
+	_, err = archive.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+
+	productSearch := repo.NewProductSearch(repo.NewProductReadModel(client, domain.DefaultRoundingMode, nil), repo.NewMemorySearchIndex())
+	search := search_products.NewQuery(productSearch, clk)
+
+	ids := func(results []*contracts.SearchResultDTO) []string {
+		var ids []string
+		for _, r := range results {
+			ids = append(ids, r.Product.ProductID)
+		}
+		return ids
+	}
+
+	// Test: Every word must match, name matches rank first and archived products are excluded
+	resp, err := search.Execute(ctx, search_products.Request{
+		Query:    "oak CHAIR",
+		Products: list_products.Request{Category: category},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{chair, table}, ids(resp.Results))
+	assert.Empty(t, resp.NextPageToken)
+
+	assert.Equal(t, "<em>Oak</em> <em>Chair</em>", resp.Results[0].NameHighlight)
+	assert.Equal(t, "A sturdy <em>chair</em> for the dining room", resp.Results[0].DescriptionHighlight)
+	assert.Equal(t, "Walnut Table", resp.Results[1].NameHighlight)
+	assert.Equal(t, "Pairs with any <em>oak</em> <em>chair</em> &amp; bench", resp.Results[1].DescriptionHighlight)
+	assert.Greater(t, resp.Results[0].Score, resp.Results[1].Score)
+
+	// Results are priced like listings
+	assert.True(t, resp.Results[1].Product.HasDiscount)
+	assert.Equal(t, "150.00", resp.Results[1].Product.EffectivePriceDecimal)
+
+	// Test: The index follows product updates
+	updateProduct := update_product.NewInteractor(productRepo, productRepo, repo.NewAttributeSchemaRepo(client), repo.NewCategoryRepo(client), outboxRepo, committer, clk, enricher)
+	_, err = updateProduct.Execute(ctx, update_product.Request{
+		ProductID: bench,
+		Name:      "Oak Chair Bench",
+		Category:  category,
+	})
+	require.NoError(t, err)
+
+	// Test: Pages follow relevance order
+	firstPage, err := search.Execute(ctx, search_products.Request{
+		Query:    "oak chair",
+		Products: list_products.Request{Category: category, PageSize: 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{chair, bench}, ids(firstPage.Results))
+	require.NotEmpty(t, firstPage.NextPageToken)
+
+	secondPage, err := search.Execute(ctx, search_products.Request{
+		Query:    "oak chair",
+		Products: list_products.Request{Category: category, PageSize: 2, PageToken: firstPage.NextPageToken},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{table}, ids(secondPage.Results))
+	assert.Empty(t, secondPage.NextPageToken)
+
+	// Test: Tokens are only valid for the same query
+	_, err = search.Execute(ctx, search_products.Request{
+		Query:    "oak",
+		Products: list_products.Request{Category: category, PageSize: 2, PageToken: firstPage.NextPageToken},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+
+	// Test: Status filters combine with the search
+	resp, err = search.Execute(ctx, search_products.Request{
+		Query:    "oak chair",
+		Products: list_products.Request{Category: category, Statuses: []string{"archived"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{archived}, ids(resp.Results))
+
+	// Test: Queries without words are rejected
+	_, err = search.Execute(ctx, search_products.Request{Query: " & "})
+	assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+
+	t.Logf("✓ Product search working correctly")
+}
+
+func TestConcurrentModificationIsRejected(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	ctx, client, cleanup := setupTest(t)
+	defer cleanup()
+
+	// Setup
+	fixedTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewMockClock(fixedTime)
+	committer := committer.NewCommitter(client)
+	productRepo := repo.NewProductRepo(client)
+	outboxRepo := repo.NewOutboxRepo(client)
+	enricher := &testEventEnricher{}
+
+	createProduct := create_product.NewInteractor(productRepo, repo.NewCategoryRepo(client), outboxRepo, committer, clk)
+	category := createTestCategory(t, ctx, client, clk, "Contended")
+
+	createResp, err := createProduct.Execute(ctx, create_product.Request{
+		Name:                 "Contended Product",
+		Category:             category,
+		BasePriceNumerator:   100,
+		BasePriceDenominator: 1,
+	})
+	require.NoError(t, err)
+
+	// Load a stale copy before another writer updates the product
+	stale, err := productRepo.FindByID(ctx, createResp.ProductID)
+	require.NoError(t, err)
+
+	updateProduct := update_product.NewInteractor(productRepo, productRepo, repo.NewAttributeSchemaRepo(client), repo.NewCategoryRepo(client), outboxRepo, committer, clk, enricher)
+	_, err = updateProduct.Execute(ctx, update_product.Request{
+		ProductID: createResp.ProductID,
+		Name:      "Renamed Product",
+		Category:  category,
+	})
+	require.NoError(t, err)
+
+	// Test: Writing the stale copy fails the version check
+	require.NoError(t, stale.UpdateDetails("Stale Name", "", category, fixedTime))
+
+	plan := commitplan.NewPlan()
+	plan.Add(productRepo.UpdateMut(stale))
+	plan.Expect(productRepo.VersionPrecondition(stale))
+
+	err = committer.Apply(ctx, plan)
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification, "Stale write should be rejected")
+
+	t.Logf("✓ Concurrent modification detected correctly")
+}
+
+// createTestCategory creates a root category with a unique name and returns its ID
+func createTestCategory(t *testing.T, ctx context.Context, client *spanner.Client, clk *clock.MockClock, name string) string {
+	t.Helper()
+
+	createCategory := create_category.NewInteractor(
+		repo.NewCategoryRepo(client),
+		repo.NewCategoryRepo(client),
+		repo.NewOutboxRepo(client),
+		committer.NewCommitter(client),
+		clk,
+		&testEventEnricher{},
+	)
+
+	// Sibling names are unique, so suffix the name to keep test runs independent
+	resp, err := createCategory.Execute(ctx, create_category.Request{
+		Name: fmt.Sprintf("%s %d", name, time.Now().UnixNano()),
+	})
+	require.NoError(t, err)
+
+	return resp.CategoryID
 }
 
 // Test event enricher for usecases
 type testEventEnricher struct{}
 
-func (e *testEventEnricher) EnrichEvent(event interface{}) create_product.OutboxEvent {
+func (e *testEventEnricher) EnrichEvent(event domain.DomainEvent) contracts.OutboxEvent {
 	// Simple enricher - just return a basic outbox event
-	return create_product.OutboxEvent{
+	return contracts.OutboxEvent{
 		EventID:     "test-event-id",
 		EventType:   "test.event",
 		AggregateID: "test-aggregate",
@@ -315,7 +2141,7 @@ func (e *testEventEnricher) EnrichEvent(event interface{}) create_product.Outbox
 func TestMain(m *testing.M) {
 	// Check if we should skip E2E tests
 	if os.Getenv("SPANNER_EMULATOR_HOST") == "" {
-		m.Log("E2E tests skipped - set SPANNER_EMULATOR_HOST to run")
+		fmt.Println("E2E tests skipped - set SPANNER_EMULATOR_HOST to run")
 		return
 	}
 